@@ -29,8 +29,10 @@ import (
 	"github.com/celestiaorg/celestia-node/blob"
 	"github.com/celestiaorg/celestia-node/das"
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 	"github.com/celestiaorg/celestia-node/state"
 )
 
@@ -75,6 +77,7 @@ func init() {
 	add([]byte("byte array"))
 	add(time.Second)
 	add(node.Bridge)
+	add(byzantine.BadEncoding)
 	add(auth.Permission("admin"))
 
 	add(errors.New("error"))
@@ -113,6 +116,14 @@ func init() {
 	}
 	add(extendedHeader)
 
+	befp := fraud.Proof{Proof: byzantine.CreateBadEncodingProof(
+		extendedHeader.Hash(),
+		extendedHeader.Height(),
+		&byzantine.ErrByzantine{Axis: rsmt2d.Row},
+	)}
+	add(befp)
+	add(&befp)
+
 	var resourceMngrStats rcmgr.ResourceManagerStat
 	err = json.Unmarshal([]byte(exampleResourceMngrStats), &resourceMngrStats)
 	if err != nil {
@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	"github.com/celestiaorg/celestia-node/blob"
+	modblob "github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	"github.com/celestiaorg/celestia-node/state/txclient"
+)
+
+// blobServer serves the blob module over gRPC.
+type blobServer struct {
+	pb.UnimplementedBlobServer
+
+	mod modblob.Module
+}
+
+func (s *blobServer) Submit(ctx context.Context, req *pb.SubmitRequest) (*pb.SubmitResponse, error) {
+	blobs := make([]*blob.Blob, 0, len(req.GetBlobs()))
+	for _, b := range req.GetBlobs() {
+		ns, err := toNamespace(b.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		//nolint:gosec // share version is validated by the blob constructor
+		blb, err := blob.NewBlob(uint8(b.GetShareVersion()), ns, b.GetData(), b.GetSigner())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid blob: %s", err)
+		}
+		blobs = append(blobs, blb)
+	}
+
+	height, err := s.mod.Submit(ctx, blobs, toSubmitOptions(req.GetOptions()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitResponse{Height: height}, nil
+}
+
+func (s *blobServer) Get(ctx context.Context, req *pb.GetBlobRequest) (*pb.BlobData, error) {
+	ns, err := toNamespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.mod.Get(ctx, req.GetHeight(), ns, req.GetCommitment())
+	if err != nil {
+		return nil, err
+	}
+	return toPBBlob(b), nil
+}
+
+func (s *blobServer) GetAll(ctx context.Context, req *pb.GetAllRequest) (*pb.GetAllResponse, error) {
+	nss := make([]libshare.Namespace, 0, len(req.GetNamespaces()))
+	for _, data := range req.GetNamespaces() {
+		ns, err := toNamespace(data)
+		if err != nil {
+			return nil, err
+		}
+		nss = append(nss, ns)
+	}
+
+	blobs, err := s.mod.GetAll(ctx, req.GetHeight(), nss)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAllResponse{Blobs: toPBBlobs(blobs)}, nil
+}
+
+func (s *blobServer) GetProof(ctx context.Context, req *pb.GetProofRequest) (*pb.GetProofResponse, error) {
+	ns, err := toNamespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	proof, err := s.mod.GetProof(ctx, req.GetHeight(), ns, req.GetCommitment())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetProofResponse{Proofs: make([]*pb.NmtProof, 0, proof.Len())}
+	for _, p := range *proof {
+		resp.Proofs = append(resp.Proofs, toPBProof(p))
+	}
+	return resp, nil
+}
+
+func (s *blobServer) Included(ctx context.Context, req *pb.IncludedRequest) (*pb.IncludedResponse, error) {
+	ns, err := toNamespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	proof := make(blob.Proof, 0, len(req.GetProofs()))
+	for _, p := range req.GetProofs() {
+		proof = append(proof, fromPBProof(p))
+	}
+
+	included, err := s.mod.Included(ctx, req.GetHeight(), ns, &proof, req.GetCommitment())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IncludedResponse{Included: included}, nil
+}
+
+func (s *blobServer) Subscribe(req *pb.SubscribeBlobsRequest, stream pb.Blob_SubscribeServer) error {
+	ns, err := toNamespace(req.GetNamespace())
+	if err != nil {
+		return err
+	}
+	sub, err := s.mod.SubscribeFrom(stream.Context(), ns, &blob.SubscribeOptions{
+		FromHeight: req.GetFromHeight(),
+		Cursor:     req.GetCursor(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case resp, ok := <-sub:
+			if !ok {
+				return nil
+			}
+			err := stream.Send(&pb.SubscribeBlobsResponse{
+				Height: resp.Height,
+				Blobs:  toPBBlobs(resp.Blobs),
+				Cursor: resp.Cursor,
+			})
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func toPBBlobs(blobs []*blob.Blob) []*pb.BlobData {
+	out := make([]*pb.BlobData, 0, len(blobs))
+	for _, b := range blobs {
+		out = append(out, toPBBlob(b))
+	}
+	return out
+}
+
+func toPBBlob(b *blob.Blob) *pb.BlobData {
+	return &pb.BlobData{
+		Namespace:    b.Namespace().Bytes(),
+		Data:         b.Data(),
+		ShareVersion: uint32(b.ShareVersion()),
+		Commitment:   b.Commitment,
+		Signer:       b.Signer(),
+		Index:        int64(b.Index()),
+	}
+}
+
+func toSubmitOptions(opts *pb.SubmitOptions) *blob.SubmitOptions {
+	if opts == nil {
+		return txclient.NewTxConfig()
+	}
+
+	cfgOpts := []txclient.ConfigOption{
+		txclient.WithGas(opts.GetGas()),
+		txclient.WithKeyName(opts.GetKeyName()),
+		txclient.WithSignerAddress(opts.GetSignerAddress()),
+		txclient.WithFeeGranterAddress(opts.GetFeeGranterAddress()),
+		txclient.WithTxPriority(int(opts.GetTxPriority())),
+	}
+	if opts.GasPrice != nil {
+		cfgOpts = append(cfgOpts, txclient.WithGasPrice(opts.GetGasPrice()))
+	}
+	if opts.GetMaxGasPrice() != 0 {
+		cfgOpts = append(cfgOpts, txclient.WithMaxGasPrice(opts.GetMaxGasPrice()))
+	}
+	return txclient.NewTxConfig(cfgOpts...)
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	moddas "github.com/celestiaorg/celestia-node/nodebuilder/das"
+)
+
+// dasServer serves the das module over gRPC.
+type dasServer struct {
+	pb.UnimplementedDASServer
+
+	mod moddas.Module
+}
+
+func (s *dasServer) SamplingStats(
+	ctx context.Context,
+	_ *pb.SamplingStatsRequest,
+) (*pb.SamplingStatsResponse, error) {
+	stats, err := s.mod.SamplingStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SamplingStatsResponse{
+		HeadOfSampledChain: stats.SampledChainHead,
+		HeadOfCatchup:      stats.CatchupHead,
+		NetworkHeadHeight:  stats.NetworkHead,
+		Failed:             make(map[uint64]int64, len(stats.Failed)),
+		Workers:            make([]*pb.WorkerStats, 0, len(stats.Workers)),
+		Concurrency:        int64(stats.Concurrency),
+		CatchUpDone:        stats.CatchUpDone,
+		IsRunning:          stats.IsRunning,
+	}
+	for height, tries := range stats.Failed {
+		resp.Failed[height] = int64(tries)
+	}
+	for _, w := range stats.Workers {
+		resp.Workers = append(resp.Workers, &pb.WorkerStats{
+			JobType: string(w.JobType),
+			Current: w.Curr,
+			From:    w.From,
+			To:      w.To,
+			Error:   w.ErrMsg,
+		})
+	}
+	return resp, nil
+}
+
+func (s *dasServer) WaitCatchUp(ctx context.Context, _ *pb.WaitCatchUpRequest) (*pb.WaitCatchUpResponse, error) {
+	if err := s.mod.WaitCatchUp(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.WaitCatchUpResponse{}, nil
+}
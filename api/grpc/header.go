@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	"github.com/celestiaorg/celestia-node/header"
+	modhead "github.com/celestiaorg/celestia-node/nodebuilder/header"
+)
+
+// headerServer serves the header module over gRPC.
+type headerServer struct {
+	pb.UnimplementedHeaderServer
+
+	mod modhead.Module
+}
+
+func (s *headerServer) LocalHead(ctx context.Context, _ *pb.LocalHeadRequest) (*pb.ExtendedHeader, error) {
+	return headerResponse(s.mod.LocalHead(ctx))
+}
+
+func (s *headerServer) NetworkHead(ctx context.Context, _ *pb.NetworkHeadRequest) (*pb.ExtendedHeader, error) {
+	return headerResponse(s.mod.NetworkHead(ctx))
+}
+
+func (s *headerServer) Tail(ctx context.Context, _ *pb.TailRequest) (*pb.ExtendedHeader, error) {
+	return headerResponse(s.mod.Tail(ctx))
+}
+
+func (s *headerServer) GetByHeight(ctx context.Context, req *pb.GetByHeightRequest) (*pb.ExtendedHeader, error) {
+	return headerResponse(s.mod.GetByHeight(ctx, req.GetHeight()))
+}
+
+func (s *headerServer) GetByHash(ctx context.Context, req *pb.GetByHashRequest) (*pb.ExtendedHeader, error) {
+	hash, err := hex.DecodeString(req.GetHash())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %s", err)
+	}
+	return headerResponse(s.mod.GetByHash(ctx, libhead.Hash(hash)))
+}
+
+func (s *headerServer) WaitForHeight(ctx context.Context, req *pb.WaitForHeightRequest) (*pb.ExtendedHeader, error) {
+	return headerResponse(s.mod.WaitForHeight(ctx, req.GetHeight()))
+}
+
+func (s *headerServer) SyncState(ctx context.Context, _ *pb.SyncStateRequest) (*pb.SyncStateResponse, error) {
+	state, err := s.mod.SyncState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SyncStateResponse{
+		Id:            state.ID,
+		Height:        state.Height,
+		FromHeight:    state.FromHeight,
+		ToHeight:      state.ToHeight,
+		FromHash:      state.FromHash,
+		ToHash:        state.ToHash,
+		StartUnixNano: unixNano(state.Start),
+		EndUnixNano:   unixNano(state.End),
+		Error:         state.Error,
+	}, nil
+}
+
+func (s *headerServer) Subscribe(_ *pb.SubscribeHeadersRequest, stream pb.Header_SubscribeServer) error {
+	sub, err := s.mod.Subscribe(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case eh, ok := <-sub:
+			if !ok {
+				return nil
+			}
+			resp, err := toPBHeader(eh)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func headerResponse(eh *header.ExtendedHeader, err error) (*pb.ExtendedHeader, error) {
+	if err != nil {
+		return nil, err
+	}
+	return toPBHeader(eh)
+}
+
+func toPBHeader(eh *header.ExtendedHeader) (*pb.ExtendedHeader, error) {
+	raw, err := eh.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExtendedHeader{
+		Height:       eh.Height(),
+		Hash:         eh.Hash(),
+		DataHash:     eh.DataHash,
+		TimeUnixNano: unixNano(eh.Time()),
+		Raw:          raw,
+	}, nil
+}
+
+// unixNano returns the time in nanoseconds since the Unix epoch, or zero for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
@@ -0,0 +1,947 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.0
+// source: api/grpc/pb/blob.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlobData struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Namespace    []byte                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Data         []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ShareVersion uint32                 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	Commitment   []byte                 `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Signer       []byte                 `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// index of the blob's first share in the EDS. -1 for the blobs not retrieved from the chain.
+	Index         int64 `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlobData) Reset() {
+	*x = BlobData{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlobData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobData) ProtoMessage() {}
+
+func (x *BlobData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobData.ProtoReflect.Descriptor instead.
+func (*BlobData) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlobData) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *BlobData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BlobData) GetShareVersion() uint32 {
+	if x != nil {
+		return x.ShareVersion
+	}
+	return 0
+}
+
+func (x *BlobData) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *BlobData) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *BlobData) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SubmitOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// gas_price is the price per gas unit. Not set means the price is estimated.
+	GasPrice          *float64 `protobuf:"fixed64,1,opt,name=gas_price,json=gasPrice,proto3,oneof" json:"gas_price,omitempty"`
+	MaxGasPrice       float64  `protobuf:"fixed64,2,opt,name=max_gas_price,json=maxGasPrice,proto3" json:"max_gas_price,omitempty"`
+	Gas               uint64   `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	KeyName           string   `protobuf:"bytes,4,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	SignerAddress     string   `protobuf:"bytes,5,opt,name=signer_address,json=signerAddress,proto3" json:"signer_address,omitempty"`
+	FeeGranterAddress string   `protobuf:"bytes,6,opt,name=fee_granter_address,json=feeGranterAddress,proto3" json:"fee_granter_address,omitempty"`
+	TxPriority        int32    `protobuf:"varint,7,opt,name=tx_priority,json=txPriority,proto3" json:"tx_priority,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmitOptions) Reset() {
+	*x = SubmitOptions{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitOptions) ProtoMessage() {}
+
+func (x *SubmitOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitOptions.ProtoReflect.Descriptor instead.
+func (*SubmitOptions) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitOptions) GetGasPrice() float64 {
+	if x != nil && x.GasPrice != nil {
+		return *x.GasPrice
+	}
+	return 0
+}
+
+func (x *SubmitOptions) GetMaxGasPrice() float64 {
+	if x != nil {
+		return x.MaxGasPrice
+	}
+	return 0
+}
+
+func (x *SubmitOptions) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *SubmitOptions) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *SubmitOptions) GetSignerAddress() string {
+	if x != nil {
+		return x.SignerAddress
+	}
+	return ""
+}
+
+func (x *SubmitOptions) GetFeeGranterAddress() string {
+	if x != nil {
+		return x.FeeGranterAddress
+	}
+	return ""
+}
+
+func (x *SubmitOptions) GetTxPriority() int32 {
+	if x != nil {
+		return x.TxPriority
+	}
+	return 0
+}
+
+type SubmitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blobs         []*BlobData            `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Options       *SubmitOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRequest) Reset() {
+	*x = SubmitRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRequest) ProtoMessage() {}
+
+func (x *SubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitRequest) GetBlobs() []*BlobData {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *SubmitRequest) GetOptions() *SubmitOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SubmitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitResponse) Reset() {
+	*x = SubmitResponse{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitResponse) ProtoMessage() {}
+
+func (x *SubmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitResponse.ProtoReflect.Descriptor instead.
+func (*SubmitResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetBlobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace     []byte                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Commitment    []byte                 `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlobRequest) Reset() {
+	*x = GetBlobRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobRequest) ProtoMessage() {}
+
+func (x *GetBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobRequest.ProtoReflect.Descriptor instead.
+func (*GetBlobRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBlobRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetBlobRequest) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *GetBlobRequest) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+type GetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespaces    [][]byte               `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllRequest) Reset() {
+	*x = GetAllRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllRequest) ProtoMessage() {}
+
+func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllRequest.ProtoReflect.Descriptor instead.
+func (*GetAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAllRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetAllRequest) GetNamespaces() [][]byte {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type GetAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blobs         []*BlobData            `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllResponse) Reset() {
+	*x = GetAllResponse{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllResponse) ProtoMessage() {}
+
+func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAllResponse) GetBlobs() []*BlobData {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+type GetProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace     []byte                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Commitment    []byte                 `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProofRequest) Reset() {
+	*x = GetProofRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofRequest) ProtoMessage() {}
+
+func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofRequest.ProtoReflect.Descriptor instead.
+func (*GetProofRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProofRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetProofRequest) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *GetProofRequest) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+type GetProofResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*NmtProof            `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProofResponse) Reset() {
+	*x = GetProofResponse{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofResponse) ProtoMessage() {}
+
+func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofResponse.ProtoReflect.Descriptor instead.
+func (*GetProofResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProofResponse) GetProofs() []*NmtProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type IncludedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace     []byte                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Proofs        []*NmtProof            `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	Commitment    []byte                 `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncludedRequest) Reset() {
+	*x = IncludedRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncludedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncludedRequest) ProtoMessage() {}
+
+func (x *IncludedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncludedRequest.ProtoReflect.Descriptor instead.
+func (*IncludedRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IncludedRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *IncludedRequest) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *IncludedRequest) GetProofs() []*NmtProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *IncludedRequest) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+type IncludedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Included      bool                   `protobuf:"varint,1,opt,name=included,proto3" json:"included,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncludedResponse) Reset() {
+	*x = IncludedResponse{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncludedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncludedResponse) ProtoMessage() {}
+
+func (x *IncludedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncludedResponse.ProtoReflect.Descriptor instead.
+func (*IncludedResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IncludedResponse) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+type SubscribeBlobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     []byte                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FromHeight    uint64                 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBlobsRequest) Reset() {
+	*x = SubscribeBlobsRequest{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlobsRequest) ProtoMessage() {}
+
+func (x *SubscribeBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlobsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubscribeBlobsRequest) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *SubscribeBlobsRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *SubscribeBlobsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SubscribeBlobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Blobs         []*BlobData            `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBlobsResponse) Reset() {
+	*x = SubscribeBlobsResponse{}
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBlobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlobsResponse) ProtoMessage() {}
+
+func (x *SubscribeBlobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_blob_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlobsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBlobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_blob_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubscribeBlobsResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SubscribeBlobsResponse) GetBlobs() []*BlobData {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *SubscribeBlobsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+var File_api_grpc_pb_blob_proto protoreflect.FileDescriptor
+
+const file_api_grpc_pb_blob_proto_rawDesc = "" +
+	"\n" +
+	"\x16api/grpc/pb/blob.proto\x12\x10celestia.node.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17api/grpc/pb/types.proto\"\xaf\x01\n" +
+	"\bBlobData\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\fR\tnamespace\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12#\n" +
+	"\rshare_version\x18\x03 \x01(\rR\fshareVersion\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x04 \x01(\fR\n" +
+	"commitment\x12\x16\n" +
+	"\x06signer\x18\x05 \x01(\fR\x06signer\x12\x14\n" +
+	"\x05index\x18\x06 \x01(\x03R\x05index\"\x88\x02\n" +
+	"\rSubmitOptions\x12 \n" +
+	"\tgas_price\x18\x01 \x01(\x01H\x00R\bgasPrice\x88\x01\x01\x12\"\n" +
+	"\rmax_gas_price\x18\x02 \x01(\x01R\vmaxGasPrice\x12\x10\n" +
+	"\x03gas\x18\x03 \x01(\x04R\x03gas\x12\x19\n" +
+	"\bkey_name\x18\x04 \x01(\tR\akeyName\x12%\n" +
+	"\x0esigner_address\x18\x05 \x01(\tR\rsignerAddress\x12.\n" +
+	"\x13fee_granter_address\x18\x06 \x01(\tR\x11feeGranterAddress\x12\x1f\n" +
+	"\vtx_priority\x18\a \x01(\x05R\n" +
+	"txPriorityB\f\n" +
+	"\n" +
+	"_gas_price\"|\n" +
+	"\rSubmitRequest\x120\n" +
+	"\x05blobs\x18\x01 \x03(\v2\x1a.celestia.node.v1.BlobDataR\x05blobs\x129\n" +
+	"\aoptions\x18\x02 \x01(\v2\x1f.celestia.node.v1.SubmitOptionsR\aoptions\"(\n" +
+	"\x0eSubmitResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"f\n" +
+	"\x0eGetBlobRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\fR\tnamespace\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\fR\n" +
+	"commitment\"G\n" +
+	"\rGetAllRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x02 \x03(\fR\n" +
+	"namespaces\"B\n" +
+	"\x0eGetAllResponse\x120\n" +
+	"\x05blobs\x18\x01 \x03(\v2\x1a.celestia.node.v1.BlobDataR\x05blobs\"g\n" +
+	"\x0fGetProofRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\fR\tnamespace\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\fR\n" +
+	"commitment\"F\n" +
+	"\x10GetProofResponse\x122\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x1a.celestia.node.v1.NmtProofR\x06proofs\"\x9b\x01\n" +
+	"\x0fIncludedRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\fR\tnamespace\x122\n" +
+	"\x06proofs\x18\x03 \x03(\v2\x1a.celestia.node.v1.NmtProofR\x06proofs\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x04 \x01(\fR\n" +
+	"commitment\".\n" +
+	"\x10IncludedResponse\x12\x1a\n" +
+	"\bincluded\x18\x01 \x01(\bR\bincluded\"n\n" +
+	"\x15SubscribeBlobsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\fR\tnamespace\x12\x1f\n" +
+	"\vfrom_height\x18\x02 \x01(\x04R\n" +
+	"fromHeight\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"z\n" +
+	"\x16SubscribeBlobsResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x120\n" +
+	"\x05blobs\x18\x02 \x03(\v2\x1a.celestia.node.v1.BlobDataR\x05blobs\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor2\xde\x05\n" +
+	"\x04Blob\x12g\n" +
+	"\x06Submit\x12\x1f.celestia.node.v1.SubmitRequest\x1a .celestia.node.v1.SubmitResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/blob/submit\x12w\n" +
+	"\x03Get\x12 .celestia.node.v1.GetBlobRequest\x1a\x1a.celestia.node.v1.BlobData\"2\x82\xd3\xe4\x93\x02,\x12*/v1/blob/{height}/{namespace}/{commitment}\x12j\n" +
+	"\x06GetAll\x12\x1f.celestia.node.v1.GetAllRequest\x1a .celestia.node.v1.GetAllResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/blob/all/{height}\x12\x8b\x01\n" +
+	"\bGetProof\x12!.celestia.node.v1.GetProofRequest\x1a\".celestia.node.v1.GetProofResponse\"8\x82\xd3\xe4\x93\x022\x120/v1/blob/proof/{height}/{namespace}/{commitment}\x12o\n" +
+	"\bIncluded\x12!.celestia.node.v1.IncludedRequest\x1a\".celestia.node.v1.IncludedResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/blob/included\x12\x88\x01\n" +
+	"\tSubscribe\x12'.celestia.node.v1.SubscribeBlobsRequest\x1a(.celestia.node.v1.SubscribeBlobsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/blob/subscribe/{namespace}0\x01B2Z0github.com/celestiaorg/celestia-node/api/grpc/pbb\x06proto3"
+
+var (
+	file_api_grpc_pb_blob_proto_rawDescOnce sync.Once
+	file_api_grpc_pb_blob_proto_rawDescData []byte
+)
+
+func file_api_grpc_pb_blob_proto_rawDescGZIP() []byte {
+	file_api_grpc_pb_blob_proto_rawDescOnce.Do(func() {
+		file_api_grpc_pb_blob_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_grpc_pb_blob_proto_rawDesc), len(file_api_grpc_pb_blob_proto_rawDesc)))
+	})
+	return file_api_grpc_pb_blob_proto_rawDescData
+}
+
+var file_api_grpc_pb_blob_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_grpc_pb_blob_proto_goTypes = []any{
+	(*BlobData)(nil),               // 0: celestia.node.v1.BlobData
+	(*SubmitOptions)(nil),          // 1: celestia.node.v1.SubmitOptions
+	(*SubmitRequest)(nil),          // 2: celestia.node.v1.SubmitRequest
+	(*SubmitResponse)(nil),         // 3: celestia.node.v1.SubmitResponse
+	(*GetBlobRequest)(nil),         // 4: celestia.node.v1.GetBlobRequest
+	(*GetAllRequest)(nil),          // 5: celestia.node.v1.GetAllRequest
+	(*GetAllResponse)(nil),         // 6: celestia.node.v1.GetAllResponse
+	(*GetProofRequest)(nil),        // 7: celestia.node.v1.GetProofRequest
+	(*GetProofResponse)(nil),       // 8: celestia.node.v1.GetProofResponse
+	(*IncludedRequest)(nil),        // 9: celestia.node.v1.IncludedRequest
+	(*IncludedResponse)(nil),       // 10: celestia.node.v1.IncludedResponse
+	(*SubscribeBlobsRequest)(nil),  // 11: celestia.node.v1.SubscribeBlobsRequest
+	(*SubscribeBlobsResponse)(nil), // 12: celestia.node.v1.SubscribeBlobsResponse
+	(*NmtProof)(nil),               // 13: celestia.node.v1.NmtProof
+}
+var file_api_grpc_pb_blob_proto_depIdxs = []int32{
+	0,  // 0: celestia.node.v1.SubmitRequest.blobs:type_name -> celestia.node.v1.BlobData
+	1,  // 1: celestia.node.v1.SubmitRequest.options:type_name -> celestia.node.v1.SubmitOptions
+	0,  // 2: celestia.node.v1.GetAllResponse.blobs:type_name -> celestia.node.v1.BlobData
+	13, // 3: celestia.node.v1.GetProofResponse.proofs:type_name -> celestia.node.v1.NmtProof
+	13, // 4: celestia.node.v1.IncludedRequest.proofs:type_name -> celestia.node.v1.NmtProof
+	0,  // 5: celestia.node.v1.SubscribeBlobsResponse.blobs:type_name -> celestia.node.v1.BlobData
+	2,  // 6: celestia.node.v1.Blob.Submit:input_type -> celestia.node.v1.SubmitRequest
+	4,  // 7: celestia.node.v1.Blob.Get:input_type -> celestia.node.v1.GetBlobRequest
+	5,  // 8: celestia.node.v1.Blob.GetAll:input_type -> celestia.node.v1.GetAllRequest
+	7,  // 9: celestia.node.v1.Blob.GetProof:input_type -> celestia.node.v1.GetProofRequest
+	9,  // 10: celestia.node.v1.Blob.Included:input_type -> celestia.node.v1.IncludedRequest
+	11, // 11: celestia.node.v1.Blob.Subscribe:input_type -> celestia.node.v1.SubscribeBlobsRequest
+	3,  // 12: celestia.node.v1.Blob.Submit:output_type -> celestia.node.v1.SubmitResponse
+	0,  // 13: celestia.node.v1.Blob.Get:output_type -> celestia.node.v1.BlobData
+	6,  // 14: celestia.node.v1.Blob.GetAll:output_type -> celestia.node.v1.GetAllResponse
+	8,  // 15: celestia.node.v1.Blob.GetProof:output_type -> celestia.node.v1.GetProofResponse
+	10, // 16: celestia.node.v1.Blob.Included:output_type -> celestia.node.v1.IncludedResponse
+	12, // 17: celestia.node.v1.Blob.Subscribe:output_type -> celestia.node.v1.SubscribeBlobsResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_pb_blob_proto_init() }
+func file_api_grpc_pb_blob_proto_init() {
+	if File_api_grpc_pb_blob_proto != nil {
+		return
+	}
+	file_api_grpc_pb_types_proto_init()
+	file_api_grpc_pb_blob_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_grpc_pb_blob_proto_rawDesc), len(file_api_grpc_pb_blob_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_grpc_pb_blob_proto_goTypes,
+		DependencyIndexes: file_api_grpc_pb_blob_proto_depIdxs,
+		MessageInfos:      file_api_grpc_pb_blob_proto_msgTypes,
+	}.Build()
+	File_api_grpc_pb_blob_proto = out.File
+	file_api_grpc_pb_blob_proto_goTypes = nil
+	file_api_grpc_pb_blob_proto_depIdxs = nil
+}
@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: api/grpc/pb/blob.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Blob_Submit_FullMethodName    = "/celestia.node.v1.Blob/Submit"
+	Blob_Get_FullMethodName       = "/celestia.node.v1.Blob/Get"
+	Blob_GetAll_FullMethodName    = "/celestia.node.v1.Blob/GetAll"
+	Blob_GetProof_FullMethodName  = "/celestia.node.v1.Blob/GetProof"
+	Blob_Included_FullMethodName  = "/celestia.node.v1.Blob/Included"
+	Blob_Subscribe_FullMethodName = "/celestia.node.v1.Blob/Subscribe"
+)
+
+// BlobClient is the client API for Blob service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Blob mirrors the blob module of the JSON-RPC API.
+type BlobClient interface {
+	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
+	Get(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (*BlobData, error)
+	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	Included(ctx context.Context, in *IncludedRequest, opts ...grpc.CallOption) (*IncludedResponse, error)
+	// Subscribe streams the blobs of the namespace height by height.
+	Subscribe(ctx context.Context, in *SubscribeBlobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeBlobsResponse], error)
+}
+
+type blobClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlobClient(cc grpc.ClientConnInterface) BlobClient {
+	return &blobClient{cc}
+}
+
+func (c *blobClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitResponse)
+	err := c.cc.Invoke(ctx, Blob_Submit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobClient) Get(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (*BlobData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlobData)
+	err := c.cc.Invoke(ctx, Blob_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllResponse)
+	err := c.cc.Invoke(ctx, Blob_GetAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProofResponse)
+	err := c.cc.Invoke(ctx, Blob_GetProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobClient) Included(ctx context.Context, in *IncludedRequest, opts ...grpc.CallOption) (*IncludedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncludedResponse)
+	err := c.cc.Invoke(ctx, Blob_Included_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobClient) Subscribe(ctx context.Context, in *SubscribeBlobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeBlobsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Blob_ServiceDesc.Streams[0], Blob_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeBlobsRequest, SubscribeBlobsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Blob_SubscribeClient = grpc.ServerStreamingClient[SubscribeBlobsResponse]
+
+// BlobServer is the server API for Blob service.
+// All implementations must embed UnimplementedBlobServer
+// for forward compatibility.
+//
+// Blob mirrors the blob module of the JSON-RPC API.
+type BlobServer interface {
+	Submit(context.Context, *SubmitRequest) (*SubmitResponse, error)
+	Get(context.Context, *GetBlobRequest) (*BlobData, error)
+	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	Included(context.Context, *IncludedRequest) (*IncludedResponse, error)
+	// Subscribe streams the blobs of the namespace height by height.
+	Subscribe(*SubscribeBlobsRequest, grpc.ServerStreamingServer[SubscribeBlobsResponse]) error
+	mustEmbedUnimplementedBlobServer()
+}
+
+// UnimplementedBlobServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlobServer struct{}
+
+func (UnimplementedBlobServer) Submit(context.Context, *SubmitRequest) (*SubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedBlobServer) Get(context.Context, *GetBlobRequest) (*BlobData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedBlobServer) GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedBlobServer) GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
+}
+func (UnimplementedBlobServer) Included(context.Context, *IncludedRequest) (*IncludedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Included not implemented")
+}
+func (UnimplementedBlobServer) Subscribe(*SubscribeBlobsRequest, grpc.ServerStreamingServer[SubscribeBlobsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedBlobServer) mustEmbedUnimplementedBlobServer() {}
+func (UnimplementedBlobServer) testEmbeddedByValue()              {}
+
+// UnsafeBlobServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlobServer will
+// result in compilation errors.
+type UnsafeBlobServer interface {
+	mustEmbedUnimplementedBlobServer()
+}
+
+func RegisterBlobServer(s grpc.ServiceRegistrar, srv BlobServer) {
+	// If the following call pancis, it indicates UnimplementedBlobServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Blob_ServiceDesc, srv)
+}
+
+func _Blob_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blob_Submit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).Submit(ctx, req.(*SubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blob_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blob_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).Get(ctx, req.(*GetBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blob_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blob_GetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).GetAll(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blob_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blob_GetProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).GetProof(ctx, req.(*GetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blob_Included_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncludedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServer).Included(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blob_Included_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServer).Included(ctx, req.(*IncludedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blob_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobServer).Subscribe(m, &grpc.GenericServerStream[SubscribeBlobsRequest, SubscribeBlobsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Blob_SubscribeServer = grpc.ServerStreamingServer[SubscribeBlobsResponse]
+
+// Blob_ServiceDesc is the grpc.ServiceDesc for Blob service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Blob_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.node.v1.Blob",
+	HandlerType: (*BlobServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Submit",
+			Handler:    _Blob_Submit_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Blob_Get_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _Blob_GetAll_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _Blob_GetProof_Handler,
+		},
+		{
+			MethodName: "Included",
+			Handler:    _Blob_Included_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Blob_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/grpc/pb/blob.proto",
+}
@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.0
+// source: api/grpc/pb/das.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SamplingStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamplingStatsRequest) Reset() {
+	*x = SamplingStatsRequest{}
+	mi := &file_api_grpc_pb_das_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamplingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamplingStatsRequest) ProtoMessage() {}
+
+func (x *SamplingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_das_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamplingStatsRequest.ProtoReflect.Descriptor instead.
+func (*SamplingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_das_proto_rawDescGZIP(), []int{0}
+}
+
+type WorkerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobType       string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Current       uint64                 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	From          uint64                 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            uint64                 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerStats) Reset() {
+	*x = WorkerStats{}
+	mi := &file_api_grpc_pb_das_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerStats) ProtoMessage() {}
+
+func (x *WorkerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_das_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerStats.ProtoReflect.Descriptor instead.
+func (*WorkerStats) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_das_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkerStats) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *WorkerStats) GetCurrent() uint64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *WorkerStats) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SamplingStatsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HeadOfSampledChain uint64                 `protobuf:"varint,1,opt,name=head_of_sampled_chain,json=headOfSampledChain,proto3" json:"head_of_sampled_chain,omitempty"`
+	HeadOfCatchup      uint64                 `protobuf:"varint,2,opt,name=head_of_catchup,json=headOfCatchup,proto3" json:"head_of_catchup,omitempty"`
+	NetworkHeadHeight  uint64                 `protobuf:"varint,3,opt,name=network_head_height,json=networkHeadHeight,proto3" json:"network_head_height,omitempty"`
+	Failed             map[uint64]int64       `protobuf:"bytes,4,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Workers            []*WorkerStats         `protobuf:"bytes,5,rep,name=workers,proto3" json:"workers,omitempty"`
+	Concurrency        int64                  `protobuf:"varint,6,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CatchUpDone        bool                   `protobuf:"varint,7,opt,name=catch_up_done,json=catchUpDone,proto3" json:"catch_up_done,omitempty"`
+	IsRunning          bool                   `protobuf:"varint,8,opt,name=is_running,json=isRunning,proto3" json:"is_running,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SamplingStatsResponse) Reset() {
+	*x = SamplingStatsResponse{}
+	mi := &file_api_grpc_pb_das_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamplingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamplingStatsResponse) ProtoMessage() {}
+
+func (x *SamplingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_das_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamplingStatsResponse.ProtoReflect.Descriptor instead.
+func (*SamplingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_das_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SamplingStatsResponse) GetHeadOfSampledChain() uint64 {
+	if x != nil {
+		return x.HeadOfSampledChain
+	}
+	return 0
+}
+
+func (x *SamplingStatsResponse) GetHeadOfCatchup() uint64 {
+	if x != nil {
+		return x.HeadOfCatchup
+	}
+	return 0
+}
+
+func (x *SamplingStatsResponse) GetNetworkHeadHeight() uint64 {
+	if x != nil {
+		return x.NetworkHeadHeight
+	}
+	return 0
+}
+
+func (x *SamplingStatsResponse) GetFailed() map[uint64]int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+func (x *SamplingStatsResponse) GetWorkers() []*WorkerStats {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *SamplingStatsResponse) GetConcurrency() int64 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *SamplingStatsResponse) GetCatchUpDone() bool {
+	if x != nil {
+		return x.CatchUpDone
+	}
+	return false
+}
+
+func (x *SamplingStatsResponse) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+type WaitCatchUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitCatchUpRequest) Reset() {
+	*x = WaitCatchUpRequest{}
+	mi := &file_api_grpc_pb_das_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitCatchUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitCatchUpRequest) ProtoMessage() {}
+
+func (x *WaitCatchUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_das_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitCatchUpRequest.ProtoReflect.Descriptor instead.
+func (*WaitCatchUpRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_das_proto_rawDescGZIP(), []int{3}
+}
+
+type WaitCatchUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitCatchUpResponse) Reset() {
+	*x = WaitCatchUpResponse{}
+	mi := &file_api_grpc_pb_das_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitCatchUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitCatchUpResponse) ProtoMessage() {}
+
+func (x *WaitCatchUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_das_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitCatchUpResponse.ProtoReflect.Descriptor instead.
+func (*WaitCatchUpResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_das_proto_rawDescGZIP(), []int{4}
+}
+
+var File_api_grpc_pb_das_proto protoreflect.FileDescriptor
+
+const file_api_grpc_pb_das_proto_rawDesc = "" +
+	"\n" +
+	"\x15api/grpc/pb/das.proto\x12\x10celestia.node.v1\x1a\x1cgoogle/api/annotations.proto\"\x16\n" +
+	"\x14SamplingStatsRequest\"|\n" +
+	"\vWorkerStats\x12\x19\n" +
+	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x04R\acurrent\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x04R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x04R\x02to\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xc8\x03\n" +
+	"\x15SamplingStatsResponse\x121\n" +
+	"\x15head_of_sampled_chain\x18\x01 \x01(\x04R\x12headOfSampledChain\x12&\n" +
+	"\x0fhead_of_catchup\x18\x02 \x01(\x04R\rheadOfCatchup\x12.\n" +
+	"\x13network_head_height\x18\x03 \x01(\x04R\x11networkHeadHeight\x12K\n" +
+	"\x06failed\x18\x04 \x03(\v23.celestia.node.v1.SamplingStatsResponse.FailedEntryR\x06failed\x127\n" +
+	"\aworkers\x18\x05 \x03(\v2\x1d.celestia.node.v1.WorkerStatsR\aworkers\x12 \n" +
+	"\vconcurrency\x18\x06 \x01(\x03R\vconcurrency\x12\"\n" +
+	"\rcatch_up_done\x18\a \x01(\bR\vcatchUpDone\x12\x1d\n" +
+	"\n" +
+	"is_running\x18\b \x01(\bR\tisRunning\x1a9\n" +
+	"\vFailedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x14\n" +
+	"\x12WaitCatchUpRequest\"\x15\n" +
+	"\x13WaitCatchUpResponse2\x83\x02\n" +
+	"\x03DAS\x12\x80\x01\n" +
+	"\rSamplingStats\x12&.celestia.node.v1.SamplingStatsRequest\x1a'.celestia.node.v1.SamplingStatsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/das/sampling_stats\x12y\n" +
+	"\vWaitCatchUp\x12$.celestia.node.v1.WaitCatchUpRequest\x1a%.celestia.node.v1.WaitCatchUpResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/das/wait_catch_upB2Z0github.com/celestiaorg/celestia-node/api/grpc/pbb\x06proto3"
+
+var (
+	file_api_grpc_pb_das_proto_rawDescOnce sync.Once
+	file_api_grpc_pb_das_proto_rawDescData []byte
+)
+
+func file_api_grpc_pb_das_proto_rawDescGZIP() []byte {
+	file_api_grpc_pb_das_proto_rawDescOnce.Do(func() {
+		file_api_grpc_pb_das_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_grpc_pb_das_proto_rawDesc), len(file_api_grpc_pb_das_proto_rawDesc)))
+	})
+	return file_api_grpc_pb_das_proto_rawDescData
+}
+
+var file_api_grpc_pb_das_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_grpc_pb_das_proto_goTypes = []any{
+	(*SamplingStatsRequest)(nil),  // 0: celestia.node.v1.SamplingStatsRequest
+	(*WorkerStats)(nil),           // 1: celestia.node.v1.WorkerStats
+	(*SamplingStatsResponse)(nil), // 2: celestia.node.v1.SamplingStatsResponse
+	(*WaitCatchUpRequest)(nil),    // 3: celestia.node.v1.WaitCatchUpRequest
+	(*WaitCatchUpResponse)(nil),   // 4: celestia.node.v1.WaitCatchUpResponse
+	nil,                           // 5: celestia.node.v1.SamplingStatsResponse.FailedEntry
+}
+var file_api_grpc_pb_das_proto_depIdxs = []int32{
+	5, // 0: celestia.node.v1.SamplingStatsResponse.failed:type_name -> celestia.node.v1.SamplingStatsResponse.FailedEntry
+	1, // 1: celestia.node.v1.SamplingStatsResponse.workers:type_name -> celestia.node.v1.WorkerStats
+	0, // 2: celestia.node.v1.DAS.SamplingStats:input_type -> celestia.node.v1.SamplingStatsRequest
+	3, // 3: celestia.node.v1.DAS.WaitCatchUp:input_type -> celestia.node.v1.WaitCatchUpRequest
+	2, // 4: celestia.node.v1.DAS.SamplingStats:output_type -> celestia.node.v1.SamplingStatsResponse
+	4, // 5: celestia.node.v1.DAS.WaitCatchUp:output_type -> celestia.node.v1.WaitCatchUpResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_pb_das_proto_init() }
+func file_api_grpc_pb_das_proto_init() {
+	if File_api_grpc_pb_das_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_grpc_pb_das_proto_rawDesc), len(file_api_grpc_pb_das_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_grpc_pb_das_proto_goTypes,
+		DependencyIndexes: file_api_grpc_pb_das_proto_depIdxs,
+		MessageInfos:      file_api_grpc_pb_das_proto_msgTypes,
+	}.Build()
+	File_api_grpc_pb_das_proto = out.File
+	file_api_grpc_pb_das_proto_goTypes = nil
+	file_api_grpc_pb_das_proto_depIdxs = nil
+}
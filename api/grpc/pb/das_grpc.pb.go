@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: api/grpc/pb/das.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DAS_SamplingStats_FullMethodName = "/celestia.node.v1.DAS/SamplingStats"
+	DAS_WaitCatchUp_FullMethodName   = "/celestia.node.v1.DAS/WaitCatchUp"
+)
+
+// DASClient is the client API for DAS service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DAS mirrors the das module of the JSON-RPC API.
+type DASClient interface {
+	SamplingStats(ctx context.Context, in *SamplingStatsRequest, opts ...grpc.CallOption) (*SamplingStatsResponse, error)
+	WaitCatchUp(ctx context.Context, in *WaitCatchUpRequest, opts ...grpc.CallOption) (*WaitCatchUpResponse, error)
+}
+
+type dASClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDASClient(cc grpc.ClientConnInterface) DASClient {
+	return &dASClient{cc}
+}
+
+func (c *dASClient) SamplingStats(ctx context.Context, in *SamplingStatsRequest, opts ...grpc.CallOption) (*SamplingStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamplingStatsResponse)
+	err := c.cc.Invoke(ctx, DAS_SamplingStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) WaitCatchUp(ctx context.Context, in *WaitCatchUpRequest, opts ...grpc.CallOption) (*WaitCatchUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitCatchUpResponse)
+	err := c.cc.Invoke(ctx, DAS_WaitCatchUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DASServer is the server API for DAS service.
+// All implementations must embed UnimplementedDASServer
+// for forward compatibility.
+//
+// DAS mirrors the das module of the JSON-RPC API.
+type DASServer interface {
+	SamplingStats(context.Context, *SamplingStatsRequest) (*SamplingStatsResponse, error)
+	WaitCatchUp(context.Context, *WaitCatchUpRequest) (*WaitCatchUpResponse, error)
+	mustEmbedUnimplementedDASServer()
+}
+
+// UnimplementedDASServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDASServer struct{}
+
+func (UnimplementedDASServer) SamplingStats(context.Context, *SamplingStatsRequest) (*SamplingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SamplingStats not implemented")
+}
+func (UnimplementedDASServer) WaitCatchUp(context.Context, *WaitCatchUpRequest) (*WaitCatchUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitCatchUp not implemented")
+}
+func (UnimplementedDASServer) mustEmbedUnimplementedDASServer() {}
+func (UnimplementedDASServer) testEmbeddedByValue()             {}
+
+// UnsafeDASServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DASServer will
+// result in compilation errors.
+type UnsafeDASServer interface {
+	mustEmbedUnimplementedDASServer()
+}
+
+func RegisterDASServer(s grpc.ServiceRegistrar, srv DASServer) {
+	// If the following call pancis, it indicates UnimplementedDASServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DAS_ServiceDesc, srv)
+}
+
+func _DAS_SamplingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamplingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).SamplingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DAS_SamplingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).SamplingStats(ctx, req.(*SamplingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_WaitCatchUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitCatchUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).WaitCatchUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DAS_WaitCatchUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).WaitCatchUp(ctx, req.(*WaitCatchUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DAS_ServiceDesc is the grpc.ServiceDesc for DAS service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DAS_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.node.v1.DAS",
+	HandlerType: (*DASServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SamplingStats",
+			Handler:    _DAS_SamplingStats_Handler,
+		},
+		{
+			MethodName: "WaitCatchUp",
+			Handler:    _DAS_WaitCatchUp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpc/pb/das.proto",
+}
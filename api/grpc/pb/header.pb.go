@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.0
+// source: api/grpc/pb/header.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LocalHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalHeadRequest) Reset() {
+	*x = LocalHeadRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalHeadRequest) ProtoMessage() {}
+
+func (x *LocalHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalHeadRequest.ProtoReflect.Descriptor instead.
+func (*LocalHeadRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{0}
+}
+
+type NetworkHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkHeadRequest) Reset() {
+	*x = NetworkHeadRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkHeadRequest) ProtoMessage() {}
+
+func (x *NetworkHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkHeadRequest.ProtoReflect.Descriptor instead.
+func (*NetworkHeadRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{1}
+}
+
+type TailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailRequest) Reset() {
+	*x = TailRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailRequest) ProtoMessage() {}
+
+func (x *TailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailRequest.ProtoReflect.Descriptor instead.
+func (*TailRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{2}
+}
+
+type GetByHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByHeightRequest) Reset() {
+	*x = GetByHeightRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHeightRequest) ProtoMessage() {}
+
+func (x *GetByHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetByHeightRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetByHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetByHashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hash is hex encoded.
+	Hash          string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByHashRequest) Reset() {
+	*x = GetByHashRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHashRequest) ProtoMessage() {}
+
+func (x *GetByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetByHashRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetByHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type WaitForHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForHeightRequest) Reset() {
+	*x = WaitForHeightRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForHeightRequest) ProtoMessage() {}
+
+func (x *WaitForHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForHeightRequest.ProtoReflect.Descriptor instead.
+func (*WaitForHeightRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WaitForHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SyncStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStateRequest) Reset() {
+	*x = SyncStateRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStateRequest) ProtoMessage() {}
+
+func (x *SyncStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{6}
+}
+
+type SyncStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height        uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	FromHeight    uint64                 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight      uint64                 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	FromHash      []byte                 `protobuf:"bytes,5,opt,name=from_hash,json=fromHash,proto3" json:"from_hash,omitempty"`
+	ToHash        []byte                 `protobuf:"bytes,6,opt,name=to_hash,json=toHash,proto3" json:"to_hash,omitempty"`
+	StartUnixNano int64                  `protobuf:"varint,7,opt,name=start_unix_nano,json=startUnixNano,proto3" json:"start_unix_nano,omitempty"`
+	EndUnixNano   int64                  `protobuf:"varint,8,opt,name=end_unix_nano,json=endUnixNano,proto3" json:"end_unix_nano,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStateResponse) Reset() {
+	*x = SyncStateResponse{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStateResponse) ProtoMessage() {}
+
+func (x *SyncStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncStateResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SyncStateResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetToHeight() uint64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetFromHash() []byte {
+	if x != nil {
+		return x.FromHash
+	}
+	return nil
+}
+
+func (x *SyncStateResponse) GetToHash() []byte {
+	if x != nil {
+		return x.ToHash
+	}
+	return nil
+}
+
+func (x *SyncStateResponse) GetStartUnixNano() int64 {
+	if x != nil {
+		return x.StartUnixNano
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetEndUnixNano() int64 {
+	if x != nil {
+		return x.EndUnixNano
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubscribeHeadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeHeadersRequest) Reset() {
+	*x = SubscribeHeadersRequest{}
+	mi := &file_api_grpc_pb_header_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeHeadersRequest) ProtoMessage() {}
+
+func (x *SubscribeHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_header_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeHeadersRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_header_proto_rawDescGZIP(), []int{8}
+}
+
+var File_api_grpc_pb_header_proto protoreflect.FileDescriptor
+
+const file_api_grpc_pb_header_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/grpc/pb/header.proto\x12\x10celestia.node.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17api/grpc/pb/types.proto\"\x12\n" +
+	"\x10LocalHeadRequest\"\x14\n" +
+	"\x12NetworkHeadRequest\"\r\n" +
+	"\vTailRequest\",\n" +
+	"\x12GetByHeightRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"&\n" +
+	"\x10GetByHashRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\".\n" +
+	"\x14WaitForHeightRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"\x12\n" +
+	"\x10SyncStateRequest\"\x91\x02\n" +
+	"\x11SyncStateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x1f\n" +
+	"\vfrom_height\x18\x03 \x01(\x04R\n" +
+	"fromHeight\x12\x1b\n" +
+	"\tto_height\x18\x04 \x01(\x04R\btoHeight\x12\x1b\n" +
+	"\tfrom_hash\x18\x05 \x01(\fR\bfromHash\x12\x17\n" +
+	"\ato_hash\x18\x06 \x01(\fR\x06toHash\x12&\n" +
+	"\x0fstart_unix_nano\x18\a \x01(\x03R\rstartUnixNano\x12\"\n" +
+	"\rend_unix_nano\x18\b \x01(\x03R\vendUnixNano\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\x19\n" +
+	"\x17SubscribeHeadersRequest2\xae\a\n" +
+	"\x06Header\x12p\n" +
+	"\tLocalHead\x12\".celestia.node.v1.LocalHeadRequest\x1a .celestia.node.v1.ExtendedHeader\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/header/local_head\x12v\n" +
+	"\vNetworkHead\x12$.celestia.node.v1.NetworkHeadRequest\x1a .celestia.node.v1.ExtendedHeader\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/header/network_head\x12`\n" +
+	"\x04Tail\x12\x1d.celestia.node.v1.TailRequest\x1a .celestia.node.v1.ExtendedHeader\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/header/tail\x12y\n" +
+	"\vGetByHeight\x12$.celestia.node.v1.GetByHeightRequest\x1a .celestia.node.v1.ExtendedHeader\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/header/height/{height}\x12q\n" +
+	"\tGetByHash\x12\".celestia.node.v1.GetByHashRequest\x1a .celestia.node.v1.ExtendedHeader\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/header/hash/{hash}\x12{\n" +
+	"\rWaitForHeight\x12&.celestia.node.v1.WaitForHeightRequest\x1a .celestia.node.v1.ExtendedHeader\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/header/wait/{height}\x12s\n" +
+	"\tSyncState\x12\".celestia.node.v1.SyncStateRequest\x1a#.celestia.node.v1.SyncStateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/header/sync_state\x12x\n" +
+	"\tSubscribe\x12).celestia.node.v1.SubscribeHeadersRequest\x1a .celestia.node.v1.ExtendedHeader\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/header/subscribe0\x01B2Z0github.com/celestiaorg/celestia-node/api/grpc/pbb\x06proto3"
+
+var (
+	file_api_grpc_pb_header_proto_rawDescOnce sync.Once
+	file_api_grpc_pb_header_proto_rawDescData []byte
+)
+
+func file_api_grpc_pb_header_proto_rawDescGZIP() []byte {
+	file_api_grpc_pb_header_proto_rawDescOnce.Do(func() {
+		file_api_grpc_pb_header_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_grpc_pb_header_proto_rawDesc), len(file_api_grpc_pb_header_proto_rawDesc)))
+	})
+	return file_api_grpc_pb_header_proto_rawDescData
+}
+
+var file_api_grpc_pb_header_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_grpc_pb_header_proto_goTypes = []any{
+	(*LocalHeadRequest)(nil),        // 0: celestia.node.v1.LocalHeadRequest
+	(*NetworkHeadRequest)(nil),      // 1: celestia.node.v1.NetworkHeadRequest
+	(*TailRequest)(nil),             // 2: celestia.node.v1.TailRequest
+	(*GetByHeightRequest)(nil),      // 3: celestia.node.v1.GetByHeightRequest
+	(*GetByHashRequest)(nil),        // 4: celestia.node.v1.GetByHashRequest
+	(*WaitForHeightRequest)(nil),    // 5: celestia.node.v1.WaitForHeightRequest
+	(*SyncStateRequest)(nil),        // 6: celestia.node.v1.SyncStateRequest
+	(*SyncStateResponse)(nil),       // 7: celestia.node.v1.SyncStateResponse
+	(*SubscribeHeadersRequest)(nil), // 8: celestia.node.v1.SubscribeHeadersRequest
+	(*ExtendedHeader)(nil),          // 9: celestia.node.v1.ExtendedHeader
+}
+var file_api_grpc_pb_header_proto_depIdxs = []int32{
+	0, // 0: celestia.node.v1.Header.LocalHead:input_type -> celestia.node.v1.LocalHeadRequest
+	1, // 1: celestia.node.v1.Header.NetworkHead:input_type -> celestia.node.v1.NetworkHeadRequest
+	2, // 2: celestia.node.v1.Header.Tail:input_type -> celestia.node.v1.TailRequest
+	3, // 3: celestia.node.v1.Header.GetByHeight:input_type -> celestia.node.v1.GetByHeightRequest
+	4, // 4: celestia.node.v1.Header.GetByHash:input_type -> celestia.node.v1.GetByHashRequest
+	5, // 5: celestia.node.v1.Header.WaitForHeight:input_type -> celestia.node.v1.WaitForHeightRequest
+	6, // 6: celestia.node.v1.Header.SyncState:input_type -> celestia.node.v1.SyncStateRequest
+	8, // 7: celestia.node.v1.Header.Subscribe:input_type -> celestia.node.v1.SubscribeHeadersRequest
+	9, // 8: celestia.node.v1.Header.LocalHead:output_type -> celestia.node.v1.ExtendedHeader
+	9, // 9: celestia.node.v1.Header.NetworkHead:output_type -> celestia.node.v1.ExtendedHeader
+	9, // 10: celestia.node.v1.Header.Tail:output_type -> celestia.node.v1.ExtendedHeader
+	9, // 11: celestia.node.v1.Header.GetByHeight:output_type -> celestia.node.v1.ExtendedHeader
+	9, // 12: celestia.node.v1.Header.GetByHash:output_type -> celestia.node.v1.ExtendedHeader
+	9, // 13: celestia.node.v1.Header.WaitForHeight:output_type -> celestia.node.v1.ExtendedHeader
+	7, // 14: celestia.node.v1.Header.SyncState:output_type -> celestia.node.v1.SyncStateResponse
+	9, // 15: celestia.node.v1.Header.Subscribe:output_type -> celestia.node.v1.ExtendedHeader
+	8, // [8:16] is the sub-list for method output_type
+	0, // [0:8] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_pb_header_proto_init() }
+func file_api_grpc_pb_header_proto_init() {
+	if File_api_grpc_pb_header_proto != nil {
+		return
+	}
+	file_api_grpc_pb_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_grpc_pb_header_proto_rawDesc), len(file_api_grpc_pb_header_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_grpc_pb_header_proto_goTypes,
+		DependencyIndexes: file_api_grpc_pb_header_proto_depIdxs,
+		MessageInfos:      file_api_grpc_pb_header_proto_msgTypes,
+	}.Build()
+	File_api_grpc_pb_header_proto = out.File
+	file_api_grpc_pb_header_proto_goTypes = nil
+	file_api_grpc_pb_header_proto_depIdxs = nil
+}
@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: api/grpc/pb/header.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Header_LocalHead_FullMethodName     = "/celestia.node.v1.Header/LocalHead"
+	Header_NetworkHead_FullMethodName   = "/celestia.node.v1.Header/NetworkHead"
+	Header_Tail_FullMethodName          = "/celestia.node.v1.Header/Tail"
+	Header_GetByHeight_FullMethodName   = "/celestia.node.v1.Header/GetByHeight"
+	Header_GetByHash_FullMethodName     = "/celestia.node.v1.Header/GetByHash"
+	Header_WaitForHeight_FullMethodName = "/celestia.node.v1.Header/WaitForHeight"
+	Header_SyncState_FullMethodName     = "/celestia.node.v1.Header/SyncState"
+	Header_Subscribe_FullMethodName     = "/celestia.node.v1.Header/Subscribe"
+)
+
+// HeaderClient is the client API for Header service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Header mirrors the header module of the JSON-RPC API.
+type HeaderClient interface {
+	LocalHead(ctx context.Context, in *LocalHeadRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	NetworkHead(ctx context.Context, in *NetworkHeadRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	Tail(ctx context.Context, in *TailRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	WaitForHeight(ctx context.Context, in *WaitForHeightRequest, opts ...grpc.CallOption) (*ExtendedHeader, error)
+	SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error)
+	// Subscribe streams the headers as they are synced.
+	Subscribe(ctx context.Context, in *SubscribeHeadersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExtendedHeader], error)
+}
+
+type headerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeaderClient(cc grpc.ClientConnInterface) HeaderClient {
+	return &headerClient{cc}
+}
+
+func (c *headerClient) LocalHead(ctx context.Context, in *LocalHeadRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_LocalHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) NetworkHead(ctx context.Context, in *NetworkHeadRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_NetworkHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) Tail(ctx context.Context, in *TailRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_Tail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_GetByHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_GetByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) WaitForHeight(ctx context.Context, in *WaitForHeightRequest, opts ...grpc.CallOption) (*ExtendedHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendedHeader)
+	err := c.cc.Invoke(ctx, Header_WaitForHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncStateResponse)
+	err := c.cc.Invoke(ctx, Header_SyncState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) Subscribe(ctx context.Context, in *SubscribeHeadersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExtendedHeader], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Header_ServiceDesc.Streams[0], Header_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeHeadersRequest, ExtendedHeader]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Header_SubscribeClient = grpc.ServerStreamingClient[ExtendedHeader]
+
+// HeaderServer is the server API for Header service.
+// All implementations must embed UnimplementedHeaderServer
+// for forward compatibility.
+//
+// Header mirrors the header module of the JSON-RPC API.
+type HeaderServer interface {
+	LocalHead(context.Context, *LocalHeadRequest) (*ExtendedHeader, error)
+	NetworkHead(context.Context, *NetworkHeadRequest) (*ExtendedHeader, error)
+	Tail(context.Context, *TailRequest) (*ExtendedHeader, error)
+	GetByHeight(context.Context, *GetByHeightRequest) (*ExtendedHeader, error)
+	GetByHash(context.Context, *GetByHashRequest) (*ExtendedHeader, error)
+	WaitForHeight(context.Context, *WaitForHeightRequest) (*ExtendedHeader, error)
+	SyncState(context.Context, *SyncStateRequest) (*SyncStateResponse, error)
+	// Subscribe streams the headers as they are synced.
+	Subscribe(*SubscribeHeadersRequest, grpc.ServerStreamingServer[ExtendedHeader]) error
+	mustEmbedUnimplementedHeaderServer()
+}
+
+// UnimplementedHeaderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHeaderServer struct{}
+
+func (UnimplementedHeaderServer) LocalHead(context.Context, *LocalHeadRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalHead not implemented")
+}
+func (UnimplementedHeaderServer) NetworkHead(context.Context, *NetworkHeadRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkHead not implemented")
+}
+func (UnimplementedHeaderServer) Tail(context.Context, *TailRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tail not implemented")
+}
+func (UnimplementedHeaderServer) GetByHeight(context.Context, *GetByHeightRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHeight not implemented")
+}
+func (UnimplementedHeaderServer) GetByHash(context.Context, *GetByHashRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHash not implemented")
+}
+func (UnimplementedHeaderServer) WaitForHeight(context.Context, *WaitForHeightRequest) (*ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForHeight not implemented")
+}
+func (UnimplementedHeaderServer) SyncState(context.Context, *SyncStateRequest) (*SyncStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncState not implemented")
+}
+func (UnimplementedHeaderServer) Subscribe(*SubscribeHeadersRequest, grpc.ServerStreamingServer[ExtendedHeader]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedHeaderServer) mustEmbedUnimplementedHeaderServer() {}
+func (UnimplementedHeaderServer) testEmbeddedByValue()                {}
+
+// UnsafeHeaderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeaderServer will
+// result in compilation errors.
+type UnsafeHeaderServer interface {
+	mustEmbedUnimplementedHeaderServer()
+}
+
+func RegisterHeaderServer(s grpc.ServiceRegistrar, srv HeaderServer) {
+	// If the following call pancis, it indicates UnimplementedHeaderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Header_ServiceDesc, srv)
+}
+
+func _Header_LocalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).LocalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_LocalHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).LocalHead(ctx, req.(*LocalHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_NetworkHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).NetworkHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_NetworkHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).NetworkHead(ctx, req.(*NetworkHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_Tail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).Tail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_Tail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).Tail(ctx, req.(*TailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_GetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).GetByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_GetByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).GetByHeight(ctx, req.(*GetByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_GetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).GetByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_GetByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).GetByHash(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_WaitForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).WaitForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_WaitForHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).WaitForHeight(ctx, req.(*WaitForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_SyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).SyncState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Header_SyncState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).SyncState(ctx, req.(*SyncStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHeadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeaderServer).Subscribe(m, &grpc.GenericServerStream[SubscribeHeadersRequest, ExtendedHeader]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Header_SubscribeServer = grpc.ServerStreamingServer[ExtendedHeader]
+
+// Header_ServiceDesc is the grpc.ServiceDesc for Header service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Header_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.node.v1.Header",
+	HandlerType: (*HeaderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LocalHead",
+			Handler:    _Header_LocalHead_Handler,
+		},
+		{
+			MethodName: "NetworkHead",
+			Handler:    _Header_NetworkHead_Handler,
+		},
+		{
+			MethodName: "Tail",
+			Handler:    _Header_Tail_Handler,
+		},
+		{
+			MethodName: "GetByHeight",
+			Handler:    _Header_GetByHeight_Handler,
+		},
+		{
+			MethodName: "GetByHash",
+			Handler:    _Header_GetByHash_Handler,
+		},
+		{
+			MethodName: "WaitForHeight",
+			Handler:    _Header_WaitForHeight_Handler,
+		},
+		{
+			MethodName: "SyncState",
+			Handler:    _Header_SyncState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Header_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/grpc/pb/header.proto",
+}
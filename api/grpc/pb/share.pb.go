@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.0
+// source: api/grpc/pb/share.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SharesAvailableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharesAvailableRequest) Reset() {
+	*x = SharesAvailableRequest{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharesAvailableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharesAvailableRequest) ProtoMessage() {}
+
+func (x *SharesAvailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharesAvailableRequest.ProtoReflect.Descriptor instead.
+func (*SharesAvailableRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharesAvailableRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SharesAvailableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharesAvailableResponse) Reset() {
+	*x = SharesAvailableResponse{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharesAvailableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharesAvailableResponse) ProtoMessage() {}
+
+func (x *SharesAvailableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharesAvailableResponse.ProtoReflect.Descriptor instead.
+func (*SharesAvailableResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{1}
+}
+
+type GetShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Row           int64                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col           int64                  `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareRequest) Reset() {
+	*x = GetShareRequest{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareRequest) ProtoMessage() {}
+
+func (x *GetShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareRequest.ProtoReflect.Descriptor instead.
+func (*GetShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetShareRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetShareRequest) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *GetShareRequest) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+type GetShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         []byte                 `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareResponse) Reset() {
+	*x = GetShareResponse{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareResponse) ProtoMessage() {}
+
+func (x *GetShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareResponse.ProtoReflect.Descriptor instead.
+func (*GetShareResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetShareResponse) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type GetRowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Row           int64                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowRequest) Reset() {
+	*x = GetRowRequest{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowRequest) ProtoMessage() {}
+
+func (x *GetRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowRequest.ProtoReflect.Descriptor instead.
+func (*GetRowRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRowRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetRowRequest) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+type GetRowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shares of the whole extended row.
+	Shares        [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowResponse) Reset() {
+	*x = GetRowResponse{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowResponse) ProtoMessage() {}
+
+func (x *GetRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowResponse.ProtoReflect.Descriptor instead.
+func (*GetRowResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRowResponse) GetShares() [][]byte {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type GetNamespaceDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace     []byte                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceDataRequest) Reset() {
+	*x = GetNamespaceDataRequest{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceDataRequest) ProtoMessage() {}
+
+func (x *GetNamespaceDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceDataRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNamespaceDataRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetNamespaceDataRequest) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type RowNamespaceData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        [][]byte               `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	Proof         *NmtProof              `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowNamespaceData) Reset() {
+	*x = RowNamespaceData{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowNamespaceData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowNamespaceData) ProtoMessage() {}
+
+func (x *RowNamespaceData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowNamespaceData.ProtoReflect.Descriptor instead.
+func (*RowNamespaceData) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RowNamespaceData) GetShares() [][]byte {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *RowNamespaceData) GetProof() *NmtProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type GetNamespaceDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*RowNamespaceData    `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceDataResponse) Reset() {
+	*x = GetNamespaceDataResponse{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceDataResponse) ProtoMessage() {}
+
+func (x *GetNamespaceDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceDataResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNamespaceDataResponse) GetRows() []*RowNamespaceData {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type GetEDSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEDSRequest) Reset() {
+	*x = GetEDSRequest{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEDSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEDSRequest) ProtoMessage() {}
+
+func (x *GetEDSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEDSRequest.ProtoReflect.Descriptor instead.
+func (*GetEDSRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetEDSRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetEDSResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shares of the original data square in row-major order.
+	Shares        [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	OdsWidth      uint64   `protobuf:"varint,2,opt,name=ods_width,json=odsWidth,proto3" json:"ods_width,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEDSResponse) Reset() {
+	*x = GetEDSResponse{}
+	mi := &file_api_grpc_pb_share_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEDSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEDSResponse) ProtoMessage() {}
+
+func (x *GetEDSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_share_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEDSResponse.ProtoReflect.Descriptor instead.
+func (*GetEDSResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_share_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetEDSResponse) GetShares() [][]byte {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *GetEDSResponse) GetOdsWidth() uint64 {
+	if x != nil {
+		return x.OdsWidth
+	}
+	return 0
+}
+
+var File_api_grpc_pb_share_proto protoreflect.FileDescriptor
+
+const file_api_grpc_pb_share_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/grpc/pb/share.proto\x12\x10celestia.node.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17api/grpc/pb/types.proto\"0\n" +
+	"\x16SharesAvailableRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"\x19\n" +
+	"\x17SharesAvailableResponse\"M\n" +
+	"\x0fGetShareRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x03R\x03row\x12\x10\n" +
+	"\x03col\x18\x03 \x01(\x03R\x03col\"(\n" +
+	"\x10GetShareResponse\x12\x14\n" +
+	"\x05share\x18\x01 \x01(\fR\x05share\"9\n" +
+	"\rGetRowRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x03R\x03row\"(\n" +
+	"\x0eGetRowResponse\x12\x16\n" +
+	"\x06shares\x18\x01 \x03(\fR\x06shares\"O\n" +
+	"\x17GetNamespaceDataRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\fR\tnamespace\"\\\n" +
+	"\x10RowNamespaceData\x12\x16\n" +
+	"\x06shares\x18\x01 \x03(\fR\x06shares\x120\n" +
+	"\x05proof\x18\x02 \x01(\v2\x1a.celestia.node.v1.NmtProofR\x05proof\"R\n" +
+	"\x18GetNamespaceDataResponse\x126\n" +
+	"\x04rows\x18\x01 \x03(\v2\".celestia.node.v1.RowNamespaceDataR\x04rows\"'\n" +
+	"\rGetEDSRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"E\n" +
+	"\x0eGetEDSResponse\x12\x16\n" +
+	"\x06shares\x18\x01 \x03(\fR\x06shares\x12\x1b\n" +
+	"\tods_width\x18\x02 \x01(\x04R\bodsWidth2\x94\x05\n" +
+	"\x05Share\x12\x8c\x01\n" +
+	"\x0fSharesAvailable\x12(.celestia.node.v1.SharesAvailableRequest\x1a).celestia.node.v1.SharesAvailableResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/share/available/{height}\x12y\n" +
+	"\bGetShare\x12!.celestia.node.v1.GetShareRequest\x1a\".celestia.node.v1.GetShareResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/share/{height}/{row}/{col}\x12q\n" +
+	"\x06GetRow\x12\x1f.celestia.node.v1.GetRowRequest\x1a .celestia.node.v1.GetRowResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/share/row/{height}/{row}\x12\xa0\x01\n" +
+	"\x10GetNamespaceData\x12).celestia.node.v1.GetNamespaceDataRequest\x1a*.celestia.node.v1.GetNamespaceDataResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/share/namespace_data/{height}/{namespace}\x12k\n" +
+	"\x06GetEDS\x12\x1f.celestia.node.v1.GetEDSRequest\x1a .celestia.node.v1.GetEDSResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/share/eds/{height}B2Z0github.com/celestiaorg/celestia-node/api/grpc/pbb\x06proto3"
+
+var (
+	file_api_grpc_pb_share_proto_rawDescOnce sync.Once
+	file_api_grpc_pb_share_proto_rawDescData []byte
+)
+
+func file_api_grpc_pb_share_proto_rawDescGZIP() []byte {
+	file_api_grpc_pb_share_proto_rawDescOnce.Do(func() {
+		file_api_grpc_pb_share_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_grpc_pb_share_proto_rawDesc), len(file_api_grpc_pb_share_proto_rawDesc)))
+	})
+	return file_api_grpc_pb_share_proto_rawDescData
+}
+
+var file_api_grpc_pb_share_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_grpc_pb_share_proto_goTypes = []any{
+	(*SharesAvailableRequest)(nil),   // 0: celestia.node.v1.SharesAvailableRequest
+	(*SharesAvailableResponse)(nil),  // 1: celestia.node.v1.SharesAvailableResponse
+	(*GetShareRequest)(nil),          // 2: celestia.node.v1.GetShareRequest
+	(*GetShareResponse)(nil),         // 3: celestia.node.v1.GetShareResponse
+	(*GetRowRequest)(nil),            // 4: celestia.node.v1.GetRowRequest
+	(*GetRowResponse)(nil),           // 5: celestia.node.v1.GetRowResponse
+	(*GetNamespaceDataRequest)(nil),  // 6: celestia.node.v1.GetNamespaceDataRequest
+	(*RowNamespaceData)(nil),         // 7: celestia.node.v1.RowNamespaceData
+	(*GetNamespaceDataResponse)(nil), // 8: celestia.node.v1.GetNamespaceDataResponse
+	(*GetEDSRequest)(nil),            // 9: celestia.node.v1.GetEDSRequest
+	(*GetEDSResponse)(nil),           // 10: celestia.node.v1.GetEDSResponse
+	(*NmtProof)(nil),                 // 11: celestia.node.v1.NmtProof
+}
+var file_api_grpc_pb_share_proto_depIdxs = []int32{
+	11, // 0: celestia.node.v1.RowNamespaceData.proof:type_name -> celestia.node.v1.NmtProof
+	7,  // 1: celestia.node.v1.GetNamespaceDataResponse.rows:type_name -> celestia.node.v1.RowNamespaceData
+	0,  // 2: celestia.node.v1.Share.SharesAvailable:input_type -> celestia.node.v1.SharesAvailableRequest
+	2,  // 3: celestia.node.v1.Share.GetShare:input_type -> celestia.node.v1.GetShareRequest
+	4,  // 4: celestia.node.v1.Share.GetRow:input_type -> celestia.node.v1.GetRowRequest
+	6,  // 5: celestia.node.v1.Share.GetNamespaceData:input_type -> celestia.node.v1.GetNamespaceDataRequest
+	9,  // 6: celestia.node.v1.Share.GetEDS:input_type -> celestia.node.v1.GetEDSRequest
+	1,  // 7: celestia.node.v1.Share.SharesAvailable:output_type -> celestia.node.v1.SharesAvailableResponse
+	3,  // 8: celestia.node.v1.Share.GetShare:output_type -> celestia.node.v1.GetShareResponse
+	5,  // 9: celestia.node.v1.Share.GetRow:output_type -> celestia.node.v1.GetRowResponse
+	8,  // 10: celestia.node.v1.Share.GetNamespaceData:output_type -> celestia.node.v1.GetNamespaceDataResponse
+	10, // 11: celestia.node.v1.Share.GetEDS:output_type -> celestia.node.v1.GetEDSResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_pb_share_proto_init() }
+func file_api_grpc_pb_share_proto_init() {
+	if File_api_grpc_pb_share_proto != nil {
+		return
+	}
+	file_api_grpc_pb_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_grpc_pb_share_proto_rawDesc), len(file_api_grpc_pb_share_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_grpc_pb_share_proto_goTypes,
+		DependencyIndexes: file_api_grpc_pb_share_proto_depIdxs,
+		MessageInfos:      file_api_grpc_pb_share_proto_msgTypes,
+	}.Build()
+	File_api_grpc_pb_share_proto = out.File
+	file_api_grpc_pb_share_proto_goTypes = nil
+	file_api_grpc_pb_share_proto_depIdxs = nil
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: api/grpc/pb/share.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Share_SharesAvailable_FullMethodName  = "/celestia.node.v1.Share/SharesAvailable"
+	Share_GetShare_FullMethodName         = "/celestia.node.v1.Share/GetShare"
+	Share_GetRow_FullMethodName           = "/celestia.node.v1.Share/GetRow"
+	Share_GetNamespaceData_FullMethodName = "/celestia.node.v1.Share/GetNamespaceData"
+	Share_GetEDS_FullMethodName           = "/celestia.node.v1.Share/GetEDS"
+)
+
+// ShareClient is the client API for Share service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Share mirrors the share module of the JSON-RPC API.
+type ShareClient interface {
+	SharesAvailable(ctx context.Context, in *SharesAvailableRequest, opts ...grpc.CallOption) (*SharesAvailableResponse, error)
+	GetShare(ctx context.Context, in *GetShareRequest, opts ...grpc.CallOption) (*GetShareResponse, error)
+	GetRow(ctx context.Context, in *GetRowRequest, opts ...grpc.CallOption) (*GetRowResponse, error)
+	GetNamespaceData(ctx context.Context, in *GetNamespaceDataRequest, opts ...grpc.CallOption) (*GetNamespaceDataResponse, error)
+	// GetEDS returns the original data square, which the clients can extend to the full EDS.
+	GetEDS(ctx context.Context, in *GetEDSRequest, opts ...grpc.CallOption) (*GetEDSResponse, error)
+}
+
+type shareClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShareClient(cc grpc.ClientConnInterface) ShareClient {
+	return &shareClient{cc}
+}
+
+func (c *shareClient) SharesAvailable(ctx context.Context, in *SharesAvailableRequest, opts ...grpc.CallOption) (*SharesAvailableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharesAvailableResponse)
+	err := c.cc.Invoke(ctx, Share_SharesAvailable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetShare(ctx context.Context, in *GetShareRequest, opts ...grpc.CallOption) (*GetShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShareResponse)
+	err := c.cc.Invoke(ctx, Share_GetShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetRow(ctx context.Context, in *GetRowRequest, opts ...grpc.CallOption) (*GetRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRowResponse)
+	err := c.cc.Invoke(ctx, Share_GetRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetNamespaceData(ctx context.Context, in *GetNamespaceDataRequest, opts ...grpc.CallOption) (*GetNamespaceDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceDataResponse)
+	err := c.cc.Invoke(ctx, Share_GetNamespaceData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetEDS(ctx context.Context, in *GetEDSRequest, opts ...grpc.CallOption) (*GetEDSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEDSResponse)
+	err := c.cc.Invoke(ctx, Share_GetEDS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareServer is the server API for Share service.
+// All implementations must embed UnimplementedShareServer
+// for forward compatibility.
+//
+// Share mirrors the share module of the JSON-RPC API.
+type ShareServer interface {
+	SharesAvailable(context.Context, *SharesAvailableRequest) (*SharesAvailableResponse, error)
+	GetShare(context.Context, *GetShareRequest) (*GetShareResponse, error)
+	GetRow(context.Context, *GetRowRequest) (*GetRowResponse, error)
+	GetNamespaceData(context.Context, *GetNamespaceDataRequest) (*GetNamespaceDataResponse, error)
+	// GetEDS returns the original data square, which the clients can extend to the full EDS.
+	GetEDS(context.Context, *GetEDSRequest) (*GetEDSResponse, error)
+	mustEmbedUnimplementedShareServer()
+}
+
+// UnimplementedShareServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShareServer struct{}
+
+func (UnimplementedShareServer) SharesAvailable(context.Context, *SharesAvailableRequest) (*SharesAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharesAvailable not implemented")
+}
+func (UnimplementedShareServer) GetShare(context.Context, *GetShareRequest) (*GetShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShare not implemented")
+}
+func (UnimplementedShareServer) GetRow(context.Context, *GetRowRequest) (*GetRowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRow not implemented")
+}
+func (UnimplementedShareServer) GetNamespaceData(context.Context, *GetNamespaceDataRequest) (*GetNamespaceDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceData not implemented")
+}
+func (UnimplementedShareServer) GetEDS(context.Context, *GetEDSRequest) (*GetEDSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEDS not implemented")
+}
+func (UnimplementedShareServer) mustEmbedUnimplementedShareServer() {}
+func (UnimplementedShareServer) testEmbeddedByValue()               {}
+
+// UnsafeShareServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShareServer will
+// result in compilation errors.
+type UnsafeShareServer interface {
+	mustEmbedUnimplementedShareServer()
+}
+
+func RegisterShareServer(s grpc.ServiceRegistrar, srv ShareServer) {
+	// If the following call pancis, it indicates UnimplementedShareServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Share_ServiceDesc, srv)
+}
+
+func _Share_SharesAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharesAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).SharesAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_SharesAvailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).SharesAvailable(ctx, req.(*SharesAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).GetShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_GetShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).GetShare(ctx, req.(*GetShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).GetRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_GetRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).GetRow(ctx, req.(*GetRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetNamespaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).GetNamespaceData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_GetNamespaceData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).GetNamespaceData(ctx, req.(*GetNamespaceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetEDS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEDSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).GetEDS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_GetEDS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).GetEDS(ctx, req.(*GetEDSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Share_ServiceDesc is the grpc.ServiceDesc for Share service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Share_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.node.v1.Share",
+	HandlerType: (*ShareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SharesAvailable",
+			Handler:    _Share_SharesAvailable_Handler,
+		},
+		{
+			MethodName: "GetShare",
+			Handler:    _Share_GetShare_Handler,
+		},
+		{
+			MethodName: "GetRow",
+			Handler:    _Share_GetRow_Handler,
+		},
+		{
+			MethodName: "GetNamespaceData",
+			Handler:    _Share_GetNamespaceData_Handler,
+		},
+		{
+			MethodName: "GetEDS",
+			Handler:    _Share_GetEDS_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpc/pb/share.proto",
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.0
+// source: api/grpc/pb/types.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExtendedHeader carries the header in its binary encoding (header.pb.ExtendedHeader),
+// so it can be verified by the clients, along with the most commonly used fields.
+type ExtendedHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	DataHash      []byte                 `protobuf:"bytes,3,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	TimeUnixNano  int64                  `protobuf:"varint,4,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Raw           []byte                 `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendedHeader) Reset() {
+	*x = ExtendedHeader{}
+	mi := &file_api_grpc_pb_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendedHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedHeader) ProtoMessage() {}
+
+func (x *ExtendedHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedHeader.ProtoReflect.Descriptor instead.
+func (*ExtendedHeader) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExtendedHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExtendedHeader) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ExtendedHeader) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
+	}
+	return nil
+}
+
+func (x *ExtendedHeader) GetTimeUnixNano() int64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+func (x *ExtendedHeader) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+// NmtProof mirrors proof.pb.Proof of celestiaorg/nmt.
+type NmtProof struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Start                 int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                   int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Nodes                 [][]byte               `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	LeafHash              []byte                 `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	IsMaxNamespaceIgnored bool                   `protobuf:"varint,5,opt,name=is_max_namespace_ignored,json=isMaxNamespaceIgnored,proto3" json:"is_max_namespace_ignored,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *NmtProof) Reset() {
+	*x = NmtProof{}
+	mi := &file_api_grpc_pb_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NmtProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NmtProof) ProtoMessage() {}
+
+func (x *NmtProof) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_pb_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NmtProof.ProtoReflect.Descriptor instead.
+func (*NmtProof) Descriptor() ([]byte, []int) {
+	return file_api_grpc_pb_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NmtProof) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *NmtProof) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *NmtProof) GetNodes() [][]byte {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *NmtProof) GetLeafHash() []byte {
+	if x != nil {
+		return x.LeafHash
+	}
+	return nil
+}
+
+func (x *NmtProof) GetIsMaxNamespaceIgnored() bool {
+	if x != nil {
+		return x.IsMaxNamespaceIgnored
+	}
+	return false
+}
+
+var File_api_grpc_pb_types_proto protoreflect.FileDescriptor
+
+const file_api_grpc_pb_types_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/grpc/pb/types.proto\x12\x10celestia.node.v1\"\x91\x01\n" +
+	"\x0eExtendedHeader\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x1b\n" +
+	"\tdata_hash\x18\x03 \x01(\fR\bdataHash\x12$\n" +
+	"\x0etime_unix_nano\x18\x04 \x01(\x03R\ftimeUnixNano\x12\x10\n" +
+	"\x03raw\x18\x05 \x01(\fR\x03raw\"\x9e\x01\n" +
+	"\bNmtProof\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x03R\x03end\x12\x14\n" +
+	"\x05nodes\x18\x03 \x03(\fR\x05nodes\x12\x1b\n" +
+	"\tleaf_hash\x18\x04 \x01(\fR\bleafHash\x127\n" +
+	"\x18is_max_namespace_ignored\x18\x05 \x01(\bR\x15isMaxNamespaceIgnoredB2Z0github.com/celestiaorg/celestia-node/api/grpc/pbb\x06proto3"
+
+var (
+	file_api_grpc_pb_types_proto_rawDescOnce sync.Once
+	file_api_grpc_pb_types_proto_rawDescData []byte
+)
+
+func file_api_grpc_pb_types_proto_rawDescGZIP() []byte {
+	file_api_grpc_pb_types_proto_rawDescOnce.Do(func() {
+		file_api_grpc_pb_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_grpc_pb_types_proto_rawDesc), len(file_api_grpc_pb_types_proto_rawDesc)))
+	})
+	return file_api_grpc_pb_types_proto_rawDescData
+}
+
+var file_api_grpc_pb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_grpc_pb_types_proto_goTypes = []any{
+	(*ExtendedHeader)(nil), // 0: celestia.node.v1.ExtendedHeader
+	(*NmtProof)(nil),       // 1: celestia.node.v1.NmtProof
+}
+var file_api_grpc_pb_types_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_pb_types_proto_init() }
+func file_api_grpc_pb_types_proto_init() {
+	if File_api_grpc_pb_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_grpc_pb_types_proto_rawDesc), len(file_api_grpc_pb_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_grpc_pb_types_proto_goTypes,
+		DependencyIndexes: file_api_grpc_pb_types_proto_depIdxs,
+		MessageInfos:      file_api_grpc_pb_types_proto_msgTypes,
+	}.Build()
+	File_api_grpc_pb_types_proto = out.File
+	file_api_grpc_pb_types_proto_goTypes = nil
+	file_api_grpc_pb_types_proto_depIdxs = nil
+}
@@ -0,0 +1,401 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	logging "github.com/ipfs/go-log/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	modblob "github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	moddas "github.com/celestiaorg/celestia-node/nodebuilder/das"
+	modhead "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	modshare "github.com/celestiaorg/celestia-node/nodebuilder/share"
+)
+
+var log = logging.Logger("grpc")
+
+// maxMessageSize bounds the gRPC messages, so a blob.Submit carrying the largest PFB or a GetEDS
+// of the largest square fit.
+const maxMessageSize = 256 << 20 // 256 MiB
+
+// Authorizer authorizes the requests with the tokens of the JSON-RPC, so both share the auth,
+// permissions and token limits. It is implemented by rpc.Server.
+type Authorizer interface {
+	// Authorize returns the context carrying the permissions and the scope of the token.
+	Authorize(ctx context.Context, token string) (context.Context, error)
+	// RecordUsage accounts the bytes transferred by the authorized request. It returns
+	// authtoken.ErrQuotaExceeded once the daily bytes quota of the token is exhausted.
+	RecordUsage(ctx context.Context, bytes uint64) error
+}
+
+// Server serves the share, blob, header and das modules over gRPC and, optionally, over the
+// HTTP/REST gateway generated from the same service definitions.
+type Server struct {
+	srv        *grpc.Server
+	gateway    *http.Server
+	authorizer Authorizer
+
+	address     string
+	port        string
+	gatewayPort string
+
+	tlsEnabled  bool
+	tlsCertPath string
+	tlsKeyPath  string
+
+	started         atomic.Bool
+	listener        net.Listener
+	gatewayListener net.Listener
+	conn            *grpc.ClientConn
+}
+
+// NewServer creates a new Server listening on the given address and port. The gateway is served
+// on the gatewayPort, unless it is empty.
+func NewServer(
+	address, port, gatewayPort string,
+	tlsConfig rpc.TLSConfig,
+	authorizer Authorizer,
+) *Server {
+	s := &Server{
+		authorizer:  authorizer,
+		address:     address,
+		port:        port,
+		gatewayPort: gatewayPort,
+		tlsEnabled:  tlsConfig.Enabled,
+		tlsCertPath: tlsConfig.CertPath,
+		tlsKeyPath:  tlsConfig.KeyPath,
+	}
+	s.srv = grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(s.unaryAuth),
+		grpc.ChainStreamInterceptor(s.streamAuth),
+	)
+	return s
+}
+
+// RegisterServices registers the modules onto the Server. The modules are expected to be wrapped
+// with rpc.Server.PermissionedService, so their permissions are enforced the same way as over
+// the JSON-RPC.
+func (s *Server) RegisterServices(
+	headerMod modhead.Module,
+	shareMod modshare.Module,
+	blobMod modblob.Module,
+	dasMod moddas.Module,
+) {
+	pb.RegisterHeaderServer(s.srv, &headerServer{mod: headerMod})
+	pb.RegisterShareServer(s.srv, &shareServer{mod: shareMod})
+	pb.RegisterBlobServer(s.srv, &blobServer{mod: blobMod})
+	pb.RegisterDASServer(s.srv, &dasServer{mod: dasMod})
+}
+
+// Start starts the Server and the gateway.
+func (s *Server) Start(ctx context.Context) error {
+	if !s.started.CompareAndSwap(false, true) {
+		log.Warn("cannot start server: already started")
+		return nil
+	}
+
+	err := s.start(ctx)
+	if err != nil {
+		s.started.Store(false)
+		if s.conn != nil {
+			s.conn.Close()
+			s.conn = nil
+		}
+		if s.listener != nil {
+			s.srv.Stop()
+			s.listener = nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Server) start(context.Context) error {
+	var tlsCfg *tls.Config
+	if s.tlsEnabled {
+		cert, err := tls.LoadX509KeyPair(s.tlsCertPath, s.tlsKeyPath)
+		if err != nil {
+			return err
+		}
+		tlsCfg = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.address, s.port))
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	if tlsCfg != nil {
+		// gRPC clients require HTTP/2 to be negotiated over TLS
+		grpcTLSCfg := tlsCfg.Clone()
+		grpcTLSCfg.NextProtos = []string{"h2"}
+		listener = tls.NewListener(listener, grpcTLSCfg)
+	}
+	//nolint:errcheck
+	go s.srv.Serve(listener)
+	log.Infow("gRPC server started", "listening on", s.listener.Addr().String())
+
+	if s.gatewayPort == "" {
+		return nil
+	}
+	return s.startGateway(tlsCfg)
+}
+
+// startGateway starts the HTTP/REST gateway proxying the requests to the gRPC server over
+// a loopback connection, so they pass the same auth and limits.
+func (s *Server) startGateway(tlsCfg *tls.Config) error {
+	creds := insecure.NewCredentials()
+	if tlsCfg != nil {
+		// the connection is made to the own listener, whose certificate may not name the address
+		//nolint:gosec
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(s.listener.Addr().String(),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+	)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+
+	ctx := context.Background()
+	mux := runtime.NewServeMux()
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		pb.RegisterHeaderHandler,
+		pb.RegisterShareHandler,
+		pb.RegisterBlobHandler,
+		pb.RegisterDASHandler,
+	} {
+		if err := register(ctx, mux, conn); err != nil {
+			return err
+		}
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.address, s.gatewayPort))
+	if err != nil {
+		return err
+	}
+	s.gatewayListener = listener
+	if tlsCfg != nil {
+		listener = tls.NewListener(listener, tlsCfg)
+	}
+	s.gateway = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 2 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		MaxHeaderBytes:    1 << 20, // 1 MiB
+	}
+	//nolint:errcheck
+	go s.gateway.Serve(listener)
+	log.Infow("gRPC gateway started", "listening on", s.gatewayListener.Addr().String())
+	return nil
+}
+
+// Stop stops the Server and the gateway.
+func (s *Server) Stop(ctx context.Context) error {
+	if !s.started.CompareAndSwap(true, false) {
+		log.Warn("cannot stop server: already stopped")
+		return nil
+	}
+
+	var err error
+	if s.gateway != nil {
+		if shutdownErr := s.gateway.Shutdown(ctx); shutdownErr != nil {
+			// the streaming requests can last forever, so force them to close
+			err = errors.Join(shutdownErr, s.gateway.Close())
+		}
+		s.gateway = nil
+		s.gatewayListener = nil
+	}
+	if s.conn != nil {
+		err = errors.Join(err, s.conn.Close())
+		s.conn = nil
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		// the streams, e.g. subscriptions, can last forever, so force them to close
+		s.srv.Stop()
+	}
+	s.listener = nil
+	log.Info("gRPC server stopped")
+	return err
+}
+
+// ListenAddr returns the listen address of the server.
+func (s *Server) ListenAddr() string {
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// GatewayAddr returns the listen address of the gateway.
+func (s *Server) GatewayAddr() string {
+	if s.gatewayListener == nil {
+		return ""
+	}
+	return s.gatewayListener.Addr().String()
+}
+
+func (s *Server) unaryAuth(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.RecordUsage(ctx, messageSize(req)); err != nil {
+		return nil, toStatus(err)
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.authorizer.RecordUsage(ctx, messageSize(resp)); err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (s *Server) streamAuth(
+	srv any,
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := s.authorize(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	wrapped := &authorizedStream{ServerStream: stream, ctx: ctx, authorizer: s.authorizer}
+	return toStatus(handler(srv, wrapped))
+}
+
+// authorize authorizes the request with the bearer token found in its "authorization" metadata.
+func (s *Server) authorize(ctx context.Context) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	if token != "" {
+		if !strings.HasPrefix(token, "Bearer ") {
+			return nil, status.Error(codes.Unauthenticated, "missing Bearer prefix in auth header")
+		}
+		token = strings.TrimPrefix(token, "Bearer ")
+	}
+
+	ctx, err := s.authorizer.Authorize(ctx, token)
+	if err != nil {
+		log.Warnf("JWT Verification failed: %s", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return ctx, nil
+}
+
+// toStatus maps the errors of the modules to the gRPC status codes.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, libhead.ErrNotFound), errors.Is(err, blob.ErrBlobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, authtoken.ErrRateLimited), errors.Is(err, authtoken.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case isPermissionErr(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// isPermissionErr reports whether the error is returned by the permission checks of
+// the rpc.Server.PermissionedService.
+func isPermissionErr(err error) bool {
+	msg := err.Error()
+	return strings.HasPrefix(msg, "missing permission to invoke") ||
+		strings.HasPrefix(msg, "token scope does not allow")
+}
+
+// authorizedStream overrides the context of the stream with the authorized one and accounts the
+// bytes of every message, failing the stream once the quota of the token is exhausted.
+type authorizedStream struct {
+	grpc.ServerStream
+
+	ctx        context.Context
+	authorizer Authorizer
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) SendMsg(m any) error {
+	if err := s.authorizer.RecordUsage(s.ctx, messageSize(m)); err != nil {
+		return toStatus(err)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizer.RecordUsage(s.ctx, messageSize(m)); err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
+
+func messageSize(m any) uint64 {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return 0
+	}
+	return uint64(proto.Size(msg))
+}
@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	modblob "github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	blobMock "github.com/celestiaorg/celestia-node/nodebuilder/blob/mocks"
+	moddas "github.com/celestiaorg/celestia-node/nodebuilder/das"
+	dasMock "github.com/celestiaorg/celestia-node/nodebuilder/das/mocks"
+	modhead "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
+	modshare "github.com/celestiaorg/celestia-node/nodebuilder/share"
+	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
+)
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	key := []byte("test-secret-key-for-jwt-signing-32")
+	signer, err := jwt.NewSignerHS(jwt.HS256, key)
+	require.NoError(t, err)
+	verifier, err := jwt.NewVerifierHS(jwt.HS256, key)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	headerMod := headerMock.NewMockModule(ctrl)
+	blobMod := blobMock.NewMockModule(ctrl)
+
+	eh := headertest.RandExtendedHeader(t)
+	headerMod.EXPECT().GetByHeight(gomock.Any(), eh.Height()).Return(eh, nil).AnyTimes()
+	headerMod.EXPECT().Subscribe(gomock.Any()).DoAndReturn(
+		func(context.Context) (<-chan *header.ExtendedHeader, error) {
+			sub := make(chan *header.ExtendedHeader, 1)
+			sub <- eh
+			close(sub)
+			return sub, nil
+		})
+
+	ns := libshare.RandomBlobNamespace()
+	blb, err := blob.NewBlobV0(ns, []byte("data"))
+	require.NoError(t, err)
+	blobMod.EXPECT().Get(gomock.Any(), uint64(1), ns, blb.Commitment).Return(blb, nil).AnyTimes()
+
+	rpcSrv := rpc.NewServer("localhost", "0", false, rpc.CORSConfig{}, rpc.TLSConfig{}, rpc.RateLimitConfig{},
+		signer, verifier)
+	srv := NewServer("localhost", "0", "0", rpc.TLSConfig{}, rpcSrv)
+	srv.RegisterServices(
+		rpcSrv.PermissionedService("header", headerMod, &modhead.API{}).(modhead.Module),
+		rpcSrv.PermissionedService("share", shareMock.NewMockModule(ctrl), &modshare.API{}).(modshare.Module),
+		rpcSrv.PermissionedService("blob", blobMod, &modblob.API{}).(modblob.Module),
+		rpcSrv.PermissionedService("das", dasMock.NewMockModule(ctrl), &moddas.API{}).(moddas.Module),
+	)
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(context.Background()))
+	})
+
+	conn, err := grpc.NewClient(srv.ListenAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	headerClient := pb.NewHeaderClient(conn)
+	blobClient := pb.NewBlobClient(conn)
+
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	readToken, err := authtoken.NewSignedJWT(signer, perms.ReadPerms, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("unary", func(t *testing.T) {
+		resp, err := headerClient.GetByHeight(withToken(readToken), &pb.GetByHeightRequest{Height: eh.Height()})
+		require.NoError(t, err)
+		require.Equal(t, eh.Height(), resp.GetHeight())
+		require.EqualValues(t, eh.Hash(), resp.GetHash())
+
+		decoded, err := header.UnmarshalExtendedHeader(resp.GetRaw())
+		require.NoError(t, err)
+		require.Equal(t, eh.Hash(), decoded.Hash())
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		// the default permissions don't allow reading
+		_, err := headerClient.GetByHeight(ctx, &pb.GetByHeightRequest{Height: eh.Height()})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = headerClient.GetByHeight(withToken("invalid"), &pb.GetByHeightRequest{Height: eh.Height()})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// the read token can't submit
+		_, err = blobClient.Submit(withToken(readToken), &pb.SubmitRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		scoped, err := authtoken.NewSignedScopedJWT(signer, perms.ReadPerms, &perms.Scope{
+			Methods:    []string{"blob.*"},
+			Namespaces: []libshare.Namespace{ns},
+		}, time.Hour)
+		require.NoError(t, err)
+
+		resp, err := blobClient.Get(withToken(scoped), &pb.GetBlobRequest{
+			Height:     1,
+			Namespace:  ns.Bytes(),
+			Commitment: blb.Commitment,
+		})
+		require.NoError(t, err)
+		require.Equal(t, blb.Data(), resp.GetData())
+
+		_, err = blobClient.Get(withToken(scoped), &pb.GetBlobRequest{
+			Height:     1,
+			Namespace:  libshare.RandomBlobNamespace().Bytes(),
+			Commitment: blb.Commitment,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = headerClient.GetByHeight(withToken(scoped), &pb.GetByHeightRequest{Height: eh.Height()})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream, err := headerClient.Subscribe(withToken(readToken), &pb.SubscribeHeadersRequest{})
+		require.NoError(t, err)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, eh.Height(), resp.GetHeight())
+
+		_, err = stream.Recv()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("gateway", func(t *testing.T) {
+		url := fmt.Sprintf("http://%s/v1/header/height/%d", srv.GatewayAddr(), eh.Height())
+		get := func(token string) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			t.Cleanup(func() { resp.Body.Close() })
+			return resp
+		}
+
+		resp := get(readToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var body struct {
+			Height string `json:"height"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.Equal(t, strconv.FormatUint(eh.Height(), 10), body.Height)
+
+		resp = get("")
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
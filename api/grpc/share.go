@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/nmt"
+	nmt_pb "github.com/celestiaorg/nmt/pb"
+
+	"github.com/celestiaorg/celestia-node/api/grpc/pb"
+	modshare "github.com/celestiaorg/celestia-node/nodebuilder/share"
+)
+
+// shareServer serves the share module over gRPC.
+type shareServer struct {
+	pb.UnimplementedShareServer
+
+	mod modshare.Module
+}
+
+func (s *shareServer) SharesAvailable(
+	ctx context.Context,
+	req *pb.SharesAvailableRequest,
+) (*pb.SharesAvailableResponse, error) {
+	if err := s.mod.SharesAvailable(ctx, req.GetHeight()); err != nil {
+		return nil, err
+	}
+	return &pb.SharesAvailableResponse{}, nil
+}
+
+func (s *shareServer) GetShare(ctx context.Context, req *pb.GetShareRequest) (*pb.GetShareResponse, error) {
+	shr, err := s.mod.GetShare(ctx, req.GetHeight(), int(req.GetRow()), int(req.GetCol()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetShareResponse{Share: shr.ToBytes()}, nil
+}
+
+func (s *shareServer) GetRow(ctx context.Context, req *pb.GetRowRequest) (*pb.GetRowResponse, error) {
+	row, err := s.mod.GetRow(ctx, req.GetHeight(), int(req.GetRow()))
+	if err != nil {
+		return nil, err
+	}
+	shares, err := row.Shares()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRowResponse{Shares: libshare.ToBytes(shares)}, nil
+}
+
+func (s *shareServer) GetNamespaceData(
+	ctx context.Context,
+	req *pb.GetNamespaceDataRequest,
+) (*pb.GetNamespaceDataResponse, error) {
+	ns, err := toNamespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.mod.GetNamespaceData(ctx, req.GetHeight(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetNamespaceDataResponse{Rows: make([]*pb.RowNamespaceData, 0, len(data))}
+	for _, row := range data {
+		resp.Rows = append(resp.Rows, &pb.RowNamespaceData{
+			Shares: libshare.ToBytes(row.Shares),
+			Proof:  toPBProof(row.Proof),
+		})
+	}
+	return resp, nil
+}
+
+func (s *shareServer) GetEDS(ctx context.Context, req *pb.GetEDSRequest) (*pb.GetEDSResponse, error) {
+	eds, err := s.mod.GetEDS(ctx, req.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetEDSResponse{
+		Shares:   eds.FlattenedODS(),
+		OdsWidth: uint64(eds.Width() / 2),
+	}, nil
+}
+
+func toNamespace(data []byte) (libshare.Namespace, error) {
+	ns, err := libshare.NewNamespaceFromBytes(data)
+	if err != nil {
+		return libshare.Namespace{}, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	return ns, nil
+}
+
+func toPBProof(proof *nmt.Proof) *pb.NmtProof {
+	if proof == nil {
+		return nil
+	}
+	return &pb.NmtProof{
+		Start:                 int64(proof.Start()),
+		End:                   int64(proof.End()),
+		Nodes:                 proof.Nodes(),
+		LeafHash:              proof.LeafHash(),
+		IsMaxNamespaceIgnored: proof.IsMaxNamespaceIDIgnored(),
+	}
+}
+
+func fromPBProof(proof *pb.NmtProof) *nmt.Proof {
+	if proof == nil {
+		return nil
+	}
+	p := nmt.ProtoToProof(nmt_pb.Proof{
+		Start:                 proof.GetStart(),
+		End:                   proof.GetEnd(),
+		Nodes:                 proof.GetNodes(),
+		LeafHash:              proof.GetLeafHash(),
+		IsMaxNamespaceIgnored: proof.GetIsMaxNamespaceIgnored(),
+	})
+	return &p
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blobstream"
+	"github.com/celestiaorg/celestia-node/nodebuilder/core"
 	"github.com/celestiaorg/celestia-node/nodebuilder/da"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/nodebuilder/pruner"
 	"github.com/celestiaorg/celestia-node/nodebuilder/share"
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
 )
@@ -29,12 +32,15 @@ type Client struct {
 	Header     header.API
 	State      state.API
 	Share      share.API
+	Fraud      fraud.API
 	DAS        das.API
 	P2P        p2p.API
 	Node       node.API
 	Blob       blob.API
 	DA         da.API
 	Blobstream blobstream.API
+	Pruner     pruner.API
+	Core       core.API
 
 	closer multiClientCloser
 }
@@ -91,11 +97,14 @@ func moduleMap(client *Client) map[string]any {
 		"share":      &client.Share.Internal,
 		"state":      &client.State.Internal,
 		"header":     &client.Header.Internal,
+		"fraud":      &client.Fraud.Internal,
 		"das":        &client.DAS.Internal,
 		"p2p":        &client.P2P.Internal,
 		"node":       &client.Node.Internal,
 		"blob":       &client.Blob.Internal,
 		"da":         &client.DA.Internal,
 		"blobstream": &client.Blobstream.Internal,
+		"pruner":     &client.Pruner.Internal,
+		"core":       &client.Core.Internal,
 	}
 }
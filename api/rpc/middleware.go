@@ -1,11 +1,19 @@
 package rpc
 
 import (
+	"bufio"
+	"context"
+	"io"
 	"net"
 	"net/http"
+	"reflect"
 
+	"github.com/felixge/httpsnoop"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"golang.org/x/time/rate"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
 // connLimit returns middleware that limits the number of concurrent requests.
@@ -58,6 +66,148 @@ func rateLimit(rps, burst, cacheSize int, next http.Handler) http.Handler {
 	})
 }
 
+// tokenLimit returns middleware that accounts the bytes of the requests and the responses against
+// the daily quota of the token of the request. The calls themselves are accounted by the methods
+// wrapped with limitedProxy. The transfer is cut short once the quota is exhausted: the reads of the
+// request body and the writes of the response fail, and so do the reads and writes of the websocket
+// connections hijacked from the response.
+func tokenLimit(quotas *authtoken.Quotas, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := tokenFromContext(r.Context())
+		record := func(n int) error {
+			if n == 0 {
+				return nil
+			}
+			err := quotas.Record(p, uint64(n))
+			if err != nil {
+				identity := "anonymous"
+				if p != nil {
+					identity = p.ID
+				}
+				// Debug-level to avoid log amplification under sustained limit hits.
+				log.Debugw("token limit exceeded", "token", identity, "err", err)
+			}
+			return err
+		}
+
+		r.Body = &countingReader{ReadCloser: r.Body, record: record}
+		// httpsnoop preserves the http.Hijacker the websocket upgrades rely on
+		w = httpsnoop.Wrap(w, httpsnoop.Hooks{
+			Write: func(write httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+				return func(b []byte) (int, error) {
+					if err := record(len(b)); err != nil {
+						return 0, err
+					}
+					return write(b)
+				}
+			},
+			Hijack: func(hijack httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+				return func() (net.Conn, *bufio.ReadWriter, error) {
+					conn, rw, err := hijack()
+					if err != nil {
+						return nil, nil, err
+					}
+					return &countingConn{Conn: conn, record: record}, rw, nil
+				}
+			},
+		})
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitedProxy wraps all the methods of the Internal struct of an API, so every call is accounted
+// against the limits of the caller's token. The calls exceeding the limits fail with
+// authtoken.ErrRateLimited or authtoken.ErrQuotaExceeded. No limits are enforced while quotas
+// returns nil.
+func limitedProxy(quotas func() *authtoken.Quotas, internal any) {
+	rint := reflect.ValueOf(internal).Elem()
+	for f := range rint.NumField() {
+		field := rint.Type().Field(f)
+		if field.Type.Kind() != reflect.Func || rint.Field(f).IsNil() {
+			continue
+		}
+		// copy the wrapped function out of the field, as the field is overwritten below
+		fn := reflect.ValueOf(rint.Field(f).Interface())
+
+		rint.Field(f).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			q := quotas()
+			if q == nil {
+				return fn.Call(args)
+			}
+			ctx := args[0].Interface().(context.Context)
+			if err := q.Allow(tokenFromContext(ctx)); err != nil {
+				return errorResults(field.Type, err)
+			}
+			return fn.Call(args)
+		}))
+	}
+}
+
+// methodsProxy fills the Internal struct of an API with the methods of the service, without
+// checking any permissions, in place of auth.PermissionedProxy when auth is disabled.
+func methodsProxy(service, internal any) {
+	rs := reflect.ValueOf(service)
+	rint := reflect.ValueOf(internal).Elem()
+	for f := range rint.NumField() {
+		field := rint.Type().Field(f)
+		if field.Type.Kind() != reflect.Func {
+			continue
+		}
+		if method := rs.MethodByName(field.Name); method.IsValid() {
+			rint.Field(f).Set(method)
+		}
+	}
+}
+
+// countingReader accounts the bytes read from the request body.
+type countingReader struct {
+	io.ReadCloser
+	record func(int) error
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if rerr := c.record(n); rerr != nil {
+		return n, rerr
+	}
+	return n, err
+}
+
+// countingConn accounts the bytes read from and written to the hijacked connection.
+type countingConn struct {
+	net.Conn
+	record func(int) error
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if rerr := c.record(n); rerr != nil {
+		return n, rerr
+	}
+	return n, err
+}
+
+func (c *countingConn) Write(p []byte) (int, error) {
+	if err := c.record(len(p)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(p)
+}
+
+type tokenKey struct{}
+
+// withToken returns the context carrying the payload of the token the request was authorized with.
+func withToken(ctx context.Context, p *perms.JWTPayload) context.Context {
+	return context.WithValue(ctx, tokenKey{}, p)
+}
+
+// tokenFromContext returns the payload of the token carried by the context,
+// or nil for the requests without a token.
+func tokenFromContext(ctx context.Context) *perms.JWTPayload {
+	p, _ := ctx.Value(tokenKey{}).(*perms.JWTPayload)
+	return p
+}
+
 // extractIP returns the IP portion of RemoteAddr (strips port).
 func extractIP(r *http.Request) string {
 	host, _, err := net.SplitHostPort(r.RemoteAddr)
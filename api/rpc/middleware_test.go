@@ -1,13 +1,21 @@
 package rpc
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
 const (
@@ -242,3 +250,119 @@ func TestExtractIP(t *testing.T) {
 		assert.Equal(t, tt.expected, extractIP(r))
 	}
 }
+
+func TestLimitedProxy(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	quotas := authtoken.NewQuotas(ds, map[string]authtoken.Limits{
+		"write":  {DailyRequests: 2},
+		"public": {RequestsPerSec: 1, Burst: 1},
+	}, map[string]authtoken.Limits{
+		"limited": {DailyRequests: 1},
+	})
+
+	var api struct {
+		Internal struct {
+			Call func(context.Context) (int, error)
+		}
+	}
+	api.Internal.Call = func(context.Context) (int, error) { return 1, nil }
+	var enabled *authtoken.Quotas
+	limitedProxy(func() *authtoken.Quotas { return enabled }, &api.Internal)
+
+	call := func(p *perms.JWTPayload) error {
+		ctx := context.Background()
+		if p != nil {
+			ctx = withToken(ctx, p)
+		}
+		_, err := api.Internal.Call(ctx)
+		return err
+	}
+
+	// no limits are enforced until the quotas are set
+	first := &perms.JWTPayload{ID: "first", Allow: perms.ReadWritePerms}
+	for range 3 {
+		require.NoError(t, call(first))
+	}
+	enabled = quotas
+
+	// every call is accounted, and tokens of the same tier are limited independently
+	second := &perms.JWTPayload{ID: "second", Allow: perms.ReadWritePerms}
+	for range 2 {
+		require.NoError(t, call(first))
+	}
+	require.ErrorIs(t, call(first), authtoken.ErrQuotaExceeded)
+	require.NoError(t, call(second))
+
+	// the limits of the token override the limits of its tier
+	limited := &perms.JWTPayload{ID: "limited", Allow: perms.ReadWritePerms}
+	require.NoError(t, call(limited))
+	require.ErrorIs(t, call(limited), authtoken.ErrQuotaExceeded)
+
+	// calls without a token share the limits of the public tier
+	require.NoError(t, call(nil))
+	require.ErrorIs(t, call(nil), authtoken.ErrRateLimited)
+}
+
+func TestTokenLimit(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	quotas := authtoken.NewQuotas(ds, nil, map[string]authtoken.Limits{
+		"limited": {DailyBytes: 10},
+	})
+	limited := &perms.JWTPayload{ID: "limited", Allow: perms.ReadWritePerms}
+
+	var writeErr error
+	handler := tokenLimit(quotas, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		_, writeErr = w.Write([]byte("response"))
+	}))
+
+	// the response exceeding the quota is not written
+	req := httptest.NewRequest("POST", "/", strings.NewReader("request"))
+	req = req.WithContext(withToken(req.Context(), limited))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.ErrorIs(t, writeErr, authtoken.ErrQuotaExceeded)
+	assert.Empty(t, w.Body.String())
+
+	usage, err := quotas.Usage(context.Background())
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	assert.EqualValues(t, len("request")+len("response"), usage[0].Bytes)
+}
+
+func TestTokenLimit_HijackedConn(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	quotas := authtoken.NewQuotas(ds, nil, map[string]authtoken.Limits{
+		"limited": {DailyBytes: 25},
+	})
+	limited := &perms.JWTPayload{ID: "limited", Allow: perms.ReadWritePerms}
+
+	written := make(chan int, 1)
+	handler := tokenLimit(quotas, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			written <- -1
+			return
+		}
+		defer conn.Close()
+
+		// mimic a websocket streaming the messages until the connection fails
+		var n int
+		for ; n < 10; n++ {
+			if _, err := conn.Write([]byte("0123456789")); err != nil {
+				break
+			}
+		}
+		written <- n
+	}))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(withToken(r.Context(), limited)))
+	}))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL)
+	if err == nil {
+		resp.Body.Close()
+	}
+	assert.Equal(t, 2, <-written)
+}
@@ -2,6 +2,7 @@ package perms
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"time"
 
@@ -21,24 +22,33 @@ var AuthKey = "Authorization"
 // JWTPayload is a utility struct for marshaling/unmarshalling
 // permissions into for token signing/verifying.
 type JWTPayload struct {
+	// ID identifies the token for revocation. Tokens issued before IDs were introduced
+	// don't have one.
+	ID        string `json:",omitempty"`
 	Allow     []auth.Permission
 	Nonce     []byte
 	ExpiresAt time.Time
+	// Scope optionally restricts the token to a subset of RPC methods and namespaces.
+	Scope *Scope `json:",omitempty"`
 }
 
 func (j *JWTPayload) MarshalBinary() (data []byte, err error) {
 	return json.Marshal(j)
 }
 
-// NewTokenWithPerms generates and signs a new JWT token with the given secret
-// and given permissions.
-func NewTokenWithPerms(signer jwt.Signer, perms []auth.Permission) ([]byte, error) {
-	return NewTokenWithTTL(signer, perms, 0)
-}
+// NewJWTPayload creates a new JWTPayload with a random ID and nonce for the given permissions,
+// scope and TTL. A nil scope imposes no restrictions and a zero TTL means the token never expires.
+func NewJWTPayload(perms []auth.Permission, scope *Scope, ttl time.Duration) (*JWTPayload, error) {
+	if scope != nil {
+		if err := scope.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
-// NewTokenWithTTL generates and signs a new JWT token with the given secret
-// and given permissions and TTL.
-func NewTokenWithTTL(signer jwt.Signer, perms []auth.Permission, ttl time.Duration) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
 	nonce := make([]byte, 32)
 	if _, err := rand.Read(nonce); err != nil {
 		return nil, err
@@ -49,10 +59,27 @@ func NewTokenWithTTL(signer jwt.Signer, perms []auth.Permission, ttl time.Durati
 		expiresAt = time.Now().UTC().Add(ttl)
 	}
 
-	p := &JWTPayload{
+	return &JWTPayload{
+		ID:        hex.EncodeToString(id),
 		Allow:     perms,
 		Nonce:     nonce,
 		ExpiresAt: expiresAt,
+		Scope:     scope,
+	}, nil
+}
+
+// NewTokenWithPerms generates and signs a new JWT token with the given secret
+// and given permissions.
+func NewTokenWithPerms(signer jwt.Signer, perms []auth.Permission) ([]byte, error) {
+	return NewTokenWithTTL(signer, perms, 0)
+}
+
+// NewTokenWithTTL generates and signs a new JWT token with the given secret
+// and given permissions and TTL.
+func NewTokenWithTTL(signer jwt.Signer, perms []auth.Permission, ttl time.Duration) ([]byte, error) {
+	p, err := NewJWTPayload(perms, nil, ttl)
+	if err != nil {
+		return nil, err
 	}
 	token, err := jwt.NewBuilder(signer).Build(p)
 	if err != nil {
@@ -0,0 +1,80 @@
+package perms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+)
+
+// Scope narrows down the access granted to the token by its permissions to the listed RPC methods
+// and namespaces. It never widens the access: a method is callable only if the token has both the
+// permission required by the method and the method is within the Scope. Empty lists impose no
+// restriction.
+type Scope struct {
+	// Methods lists the RPC methods the token may call, either as "module.Method",
+	// e.g. "blob.Submit", or as "module.*" to allow all the methods of the module, e.g. "share.*".
+	Methods []string `json:"methods,omitempty"`
+	// Namespaces lists the namespaces the token may access. Calls passing any other namespace
+	// are rejected, while calls passing no namespace are not restricted.
+	Namespaces []libshare.Namespace `json:"namespaces,omitempty"`
+}
+
+// Validate checks the Scope is well-formed.
+func (s *Scope) Validate() error {
+	for _, method := range s.Methods {
+		module, name, ok := strings.Cut(method, ".")
+		if !ok || module == "" || name == "" || strings.Contains(name, ".") {
+			return fmt.Errorf("invalid scope method '%s': must be 'module.Method' or 'module.*'", method)
+		}
+	}
+	for _, ns := range s.Namespaces {
+		if err := ns.ValidateForData(); err != nil {
+			return fmt.Errorf("invalid scope namespace %s: %w", ns.String(), err)
+		}
+	}
+	return nil
+}
+
+// AllowsMethod reports whether the Scope allows calling the RPC method with the given
+// "module.Method" name. A nil Scope allows any method.
+func (s *Scope) AllowsMethod(method string) bool {
+	if s == nil || len(s.Methods) == 0 {
+		return true
+	}
+	module, _, _ := strings.Cut(method, ".")
+	for _, allowed := range s.Methods {
+		if allowed == method || allowed == module+".*" {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsNamespace reports whether the Scope allows accessing the given namespace.
+// A nil Scope allows any namespace.
+func (s *Scope) AllowsNamespace(ns libshare.Namespace) bool {
+	if s == nil || len(s.Namespaces) == 0 {
+		return true
+	}
+	for _, allowed := range s.Namespaces {
+		if allowed.Equals(ns) {
+			return true
+		}
+	}
+	return false
+}
+
+type scopeKey struct{}
+
+// WithScope returns the context carrying the Scope of the token the request was authorized with.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the Scope carried by the context, or nil if there is none.
+func ScopeFromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+	return scope
+}
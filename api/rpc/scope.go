@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+// namespaced is implemented by the RPC arguments bound to a namespace, e.g. blob.Blob.
+type namespaced interface {
+	Namespace() libshare.Namespace
+}
+
+var (
+	namespaceType  = reflect.TypeOf(libshare.Namespace{})
+	namespacedType = reflect.TypeOf((*namespaced)(nil)).Elem()
+)
+
+// scopedProxy wraps all the methods of the Internal struct of the API registered under the given
+// namespace, so they are only invoked if allowed by the perms.Scope of the caller's token.
+// It is meant to wrap the methods already wrapped by auth.PermissionedProxy.
+func scopedProxy(namespace string, internal any) {
+	rint := reflect.ValueOf(internal).Elem()
+	for f := range rint.NumField() {
+		field := rint.Type().Field(f)
+		if rint.Field(f).IsNil() {
+			continue
+		}
+		// copy the wrapped function out of the field, as the field is overwritten below
+		fn := reflect.ValueOf(rint.Field(f).Interface())
+
+		method := namespace + "." + field.Name
+		nsArgs := namespaceArgs(field.Type)
+		rint.Field(f).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			ctx := args[0].Interface().(context.Context)
+			scope := perms.ScopeFromContext(ctx)
+			if scope == nil {
+				return fn.Call(args)
+			}
+
+			if !scope.AllowsMethod(method) {
+				return errorResults(field.Type, fmt.Errorf("token scope does not allow invoking '%s'", method))
+			}
+			for _, idx := range nsArgs {
+				for _, ns := range argNamespaces(args[idx]) {
+					if !scope.AllowsNamespace(ns) {
+						err := fmt.Errorf("token scope does not allow namespace %s for '%s'", ns.String(), method)
+						return errorResults(field.Type, err)
+					}
+				}
+			}
+			return fn.Call(args)
+		}))
+	}
+}
+
+// namespaceArgs returns the indexes of the arguments of the function carrying namespaces.
+func namespaceArgs(fnType reflect.Type) []int {
+	var idxs []int
+	for i := range fnType.NumIn() {
+		typ := fnType.In(i)
+		if typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+		}
+		if typ == namespaceType || typ.Implements(namespacedType) {
+			idxs = append(idxs, i)
+		}
+	}
+	return idxs
+}
+
+// argNamespaces returns the namespaces carried by the argument found by namespaceArgs.
+func argNamespaces(arg reflect.Value) []libshare.Namespace {
+	if arg.Kind() != reflect.Slice {
+		return argNamespace(arg)
+	}
+
+	var nss []libshare.Namespace
+	for i := range arg.Len() {
+		nss = append(nss, argNamespace(arg.Index(i))...)
+	}
+	return nss
+}
+
+func argNamespace(arg reflect.Value) []libshare.Namespace {
+	if arg.Kind() == reflect.Ptr && arg.IsNil() {
+		return nil
+	}
+	switch v := arg.Interface().(type) {
+	case libshare.Namespace:
+		return []libshare.Namespace{v}
+	case namespaced:
+		return []libshare.Namespace{v.Namespace()}
+	default:
+		return nil
+	}
+}
+
+// errorResults returns the results of the function of the given type failing with the error.
+func errorResults(fnType reflect.Type, err error) []reflect.Value {
+	rerr := reflect.ValueOf(&err).Elem()
+	if fnType.NumOut() == 2 {
+		return []reflect.Value{reflect.Zero(fnType.Out(0)), rerr}
+	}
+	return []reflect.Value{rerr}
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -76,6 +77,8 @@ type Server struct {
 
 	signer   jwt.Signer
 	verifier jwt.Verifier
+	registry *authtoken.Registry
+	quotas   *authtoken.Quotas
 
 	metrics *rpcMetrics
 }
@@ -131,7 +134,8 @@ func NewServer(
 }
 
 // newHandlerStack returns wrapped rpc related handlers.
-// Middleware order (outermost first): rate-limit (opt-in) → conn-limit → CORS/auth → metrics → RPC handler.
+// Middleware order (outermost first):
+// rate-limit (opt-in) → conn-limit → CORS/auth → token-limit (opt-in) → metrics → RPC handler.
 func (s *Server) newHandlerStack(core http.Handler) http.Handler {
 	// otelhttp records HTTP request-level metrics (duration, request/response
 	// sizes, active requests, status) and — unlike a hand-rolled wrapper —
@@ -148,6 +152,10 @@ func (s *Server) newHandlerStack(core http.Handler) http.Handler {
 			otelhttp.WithTracerProvider(noop.NewTracerProvider()),
 		)
 	}
+	// token limits sit right behind the auth, as they are bound to the token of the request
+	if s.quotas != nil {
+		h = tokenLimit(s.quotas, h)
+	}
 	switch {
 	case s.authDisabled:
 		log.Warn("auth disabled, allowing all origins, methods and headers for CORS")
@@ -181,22 +189,103 @@ func (s *Server) WithMetrics() error {
 	return nil
 }
 
+// WithTokenRegistry makes the server reject the tokens revoked in the given registry.
+func (s *Server) WithTokenRegistry(registry *authtoken.Registry) {
+	s.registry = registry
+}
+
+// WithTokenQuotas enforces the rate limits and daily quotas of the tokens. Must be called before
+// Start, since it rebuilds the handler stack to install the middleware accounting the transferred
+// bytes. The calls are accounted by the methods registered with RegisterService.
+func (s *Server) WithTokenQuotas(quotas *authtoken.Quotas) {
+	s.quotas = quotas
+	s.srv.Handler = s.newHandlerStack(s.rpc)
+}
+
 // verifyAuth is the RPC server's auth middleware. This middleware is only
 // reached if a token is provided in the header of the request, otherwise only
-// methods with `read` permissions are accessible.
-func (s *Server) verifyAuth(_ context.Context, token string) ([]auth.Permission, error) {
+// methods with `read` permissions are accessible. Besides the permissions, the returned
+// payload carries the ID of the token and the optional scope restricting it to a subset
+// of methods and namespaces.
+func (s *Server) verifyAuth(_ context.Context, token string) (*perms.JWTPayload, error) {
 	if s.authDisabled {
-		return perms.AllPerms, nil
+		return &perms.JWTPayload{Allow: perms.AllPerms}, nil
 	}
-	return authtoken.ExtractSignedPermissions(s.verifier, token)
+	if s.registry != nil {
+		return s.registry.Verify(s.verifier, token)
+	}
+	return authtoken.ExtractSignedPayload(s.verifier, token)
 }
 
-// authHandler wraps the handler with authentication.
+// authHandler wraps the handler with authentication. It mirrors auth.Handler,
+// additionally passing the token down to the tokenLimit and the scopedProxy.
 func (s *Server) authHandler(next http.Handler) http.Handler {
-	return &auth.Handler{
-		Verify: s.verifyAuth,
-		Next:   next.ServeHTTP,
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token := r.Header.Get(perms.AuthKey)
+		if token == "" {
+			token = r.FormValue("token")
+			if token != "" {
+				token = "Bearer " + token
+			}
+		}
+
+		if token != "" {
+			if !strings.HasPrefix(token, "Bearer ") {
+				log.Warn("missing Bearer prefix in auth header")
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			token = strings.TrimPrefix(token, "Bearer ")
+
+			p, err := s.verifyAuth(ctx, token)
+			if err != nil {
+				log.Warnf("JWT Verification failed (originating from %s): %s", r.RemoteAddr, err)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			ctx = withAuth(ctx, p)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// withAuth returns the context carrying the permissions, the token and the scope of the payload.
+func withAuth(ctx context.Context, p *perms.JWTPayload) context.Context {
+	ctx = auth.WithPerm(ctx, p.Allow)
+	ctx = withToken(ctx, p)
+	if p.Scope != nil {
+		ctx = perms.WithScope(ctx, p.Scope)
+	}
+	return ctx
+}
+
+// Authorize authorizes the request made with the given token over a transport other than the
+// JSON-RPC one, e.g. gRPC. It returns the context carrying the permissions and the scope of the
+// token, same as the auth middleware. The calls are accounted against the token limits by the
+// methods wrapped with PermissionedService. Requests without a token are granted the default
+// permissions only.
+func (s *Server) Authorize(ctx context.Context, token string) (context.Context, error) {
+	if token != "" && !s.authDisabled {
+		p, err := s.verifyAuth(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		ctx = withAuth(ctx, p)
 	}
+	return ctx, nil
+}
+
+// RecordUsage accounts the bytes transferred by the request authorized with Authorize. It returns
+// authtoken.ErrQuotaExceeded once the daily bytes quota of the token is exhausted.
+func (s *Server) RecordUsage(ctx context.Context, bytes uint64) error {
+	if s.quotas != nil {
+		return s.quotas.Record(tokenFromContext(ctx), bytes)
+	}
+	return nil
 }
 
 // corsAny applies permissive CORS (allows all origins, methods, headers)
@@ -218,13 +307,31 @@ func (s *Server) corsWithConfig(next http.Handler) http.Handler {
 // RegisterService registers a service onto the RPC server. All methods on the service will then be
 // exposed over the RPC.
 func (s *Server) RegisterService(namespace string, service, out any) {
-	if s.authDisabled {
-		s.rpc.Register(namespace, service)
-		return
+	s.rpc.Register(namespace, s.PermissionedService(namespace, service, out))
+}
+
+// PermissionedService wraps the service registered under the given namespace, so its methods
+// check the permissions and the scope of the caller's token, same as the methods exposed over
+// the RPC. It returns out holding the wrapped methods. If auth is disabled, no permissions and
+// scopes are checked, and a nil out leaves the service unwrapped.
+//
+// Every call of the wrapped methods is accounted against the limits of the caller's token, so the
+// calls made over a single websocket connection or gRPC stream are accounted one by one.
+func (s *Server) PermissionedService(namespace string, service, out any) any {
+	if s.authDisabled && out == nil {
+		return service
 	}
 
-	auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, getInternalStruct(out))
-	s.rpc.Register(namespace, out)
+	internal := getInternalStruct(out)
+	if s.authDisabled {
+		methodsProxy(service, internal)
+	} else {
+		auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, internal)
+		scopedProxy(namespace, internal)
+	}
+	// quotas are read on every call, as WithTokenQuotas may be called after the registration
+	limitedProxy(func() *authtoken.Quotas { return s.quotas }, internal)
+	return out
 }
 
 func getInternalStruct(api any) any {
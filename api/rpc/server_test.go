@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
@@ -21,10 +22,15 @@ import (
 	"github.com/cristalhq/jwt/v5"
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
 // TestServer_HandlerStackSelection tests that the correct middleware stack is selected
@@ -311,13 +317,13 @@ func TestServer_VerifyAuth(t *testing.T) {
 				verifier,
 			)
 
-			permissions, err := server.verifyAuth(context.Background(), tt.token)
+			payload, err := server.verifyAuth(context.Background(), tt.token)
 
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedPerms, permissions)
+				assert.Equal(t, tt.expectedPerms, payload.Allow)
 			}
 		})
 	}
@@ -471,6 +477,160 @@ func TestServer_WithMetrics_WebSocketSubscription(t *testing.T) {
 	}
 }
 
+// TestServer_RevokedToken tests that the tokens revoked in the registry are rejected.
+func TestServer_RevokedToken(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	signer, verifier := createTestJWT(t)
+	server := NewServer("localhost", "0", false, CORSConfig{}, TLSConfig{}, RateLimitConfig{}, signer, verifier)
+	registry, err := authtoken.NewRegistry(ctx, ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+	server.WithTokenRegistry(registry)
+
+	p, err := perms.NewJWTPayload(perms.ReadPerms, nil, 0)
+	require.NoError(t, err)
+	token, err := authtoken.SignPayload(signer, p)
+	require.NoError(t, err)
+	require.NoError(t, registry.Register(ctx, p, "test"))
+
+	handler := server.newHandlerStack(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func() int {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set(perms.AuthKey, "Bearer "+token)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve())
+	require.NoError(t, registry.Revoke(ctx, p.ID))
+	require.Equal(t, http.StatusUnauthorized, serve())
+}
+
+// scopeTestItem is an RPC argument bound to a namespace.
+type scopeTestItem struct {
+	Ns libshare.Namespace
+}
+
+func (i *scopeTestItem) Namespace() libshare.Namespace { return i.Ns }
+
+type scopeTestService struct{}
+
+func (scopeTestService) Get(context.Context, libshare.Namespace) (string, error) { return "data", nil }
+
+func (scopeTestService) Submit(context.Context, []*scopeTestItem) error { return nil }
+
+func (scopeTestService) Transfer(context.Context) error { return nil }
+
+type scopeTestAPI struct {
+	Internal struct {
+		Get      func(context.Context, libshare.Namespace) (string, error) `perm:"read"`
+		Submit   func(context.Context, []*scopeTestItem) error             `perm:"write"`
+		Transfer func(context.Context) error                               `perm:"write"`
+	}
+}
+
+func (api *scopeTestAPI) Get(ctx context.Context, ns libshare.Namespace) (string, error) {
+	return api.Internal.Get(ctx, ns)
+}
+
+func (api *scopeTestAPI) Submit(ctx context.Context, items []*scopeTestItem) error {
+	return api.Internal.Submit(ctx, items)
+}
+
+func (api *scopeTestAPI) Transfer(ctx context.Context) error {
+	return api.Internal.Transfer(ctx)
+}
+
+// TestServer_ScopedToken tests that the methods and namespaces of scoped tokens are enforced
+// on top of the permissions.
+func TestServer_ScopedToken(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	signer, verifier := createTestJWT(t)
+	srv := NewServer("127.0.0.1", "0", false, CORSConfig{}, TLSConfig{}, RateLimitConfig{}, signer, verifier)
+	srv.RegisterService("test", scopeTestService{}, &scopeTestAPI{})
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, srv.Stop(context.Background())) })
+
+	allowedNs := libshare.MustNewV0Namespace(bytes.Repeat([]byte{1}, libshare.NamespaceVersionZeroIDSize))
+	otherNs := libshare.MustNewV0Namespace(bytes.Repeat([]byte{2}, libshare.NamespaceVersionZeroIDSize))
+
+	newClient := func(t *testing.T, scope *perms.Scope) *scopeTestAPI {
+		token, err := authtoken.NewSignedScopedJWT(signer, perms.ReadWritePerms, scope, time.Hour)
+		require.NoError(t, err)
+
+		var client scopeTestAPI
+		header := http.Header{perms.AuthKey: []string{"Bearer " + token}}
+		closer, err := jsonrpc.NewClient(ctx, "http://"+srv.ListenAddr(), "test", &client.Internal, header)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		return &client
+	}
+
+	t.Run("unscoped", func(t *testing.T) {
+		client := newClient(t, nil)
+		_, err := client.Internal.Get(ctx, otherNs)
+		require.NoError(t, err)
+		require.NoError(t, client.Internal.Transfer(ctx))
+	})
+
+	t.Run("methods", func(t *testing.T) {
+		client := newClient(t, &perms.Scope{Methods: []string{"test.Submit", "test.Get"}})
+		_, err := client.Internal.Get(ctx, otherNs)
+		require.NoError(t, err)
+		require.NoError(t, client.Internal.Submit(ctx, []*scopeTestItem{{Ns: otherNs}}))
+		err = client.Internal.Transfer(ctx)
+		require.ErrorContains(t, err, "token scope does not allow invoking 'test.Transfer'")
+	})
+
+	t.Run("module wildcard", func(t *testing.T) {
+		client := newClient(t, &perms.Scope{Methods: []string{"test.*"}})
+		require.NoError(t, client.Internal.Transfer(ctx))
+
+		client = newClient(t, &perms.Scope{Methods: []string{"other.*"}})
+		require.Error(t, client.Internal.Transfer(ctx))
+	})
+
+	t.Run("namespaces", func(t *testing.T) {
+		client := newClient(t, &perms.Scope{
+			Methods:    []string{"test.Submit", "test.Get"},
+			Namespaces: []libshare.Namespace{allowedNs},
+		})
+		_, err := client.Internal.Get(ctx, allowedNs)
+		require.NoError(t, err)
+		_, err = client.Internal.Get(ctx, otherNs)
+		require.ErrorContains(t, err, "token scope does not allow namespace")
+
+		require.NoError(t, client.Internal.Submit(ctx, []*scopeTestItem{{Ns: allowedNs}}))
+		err = client.Internal.Submit(ctx, []*scopeTestItem{{Ns: allowedNs}, {Ns: otherNs}})
+		require.ErrorContains(t, err, "token scope does not allow namespace")
+	})
+
+	t.Run("permissions still apply", func(t *testing.T) {
+		token, err := authtoken.NewSignedScopedJWT(signer, perms.ReadPerms, &perms.Scope{Methods: []string{"test.*"}}, 0)
+		require.NoError(t, err)
+
+		var client scopeTestAPI
+		header := http.Header{perms.AuthKey: []string{"Bearer " + token}}
+		closer, err := jsonrpc.NewClient(ctx, "http://"+srv.ListenAddr(), "test", &client.Internal, header)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+
+		err = client.Internal.Transfer(ctx)
+		require.ErrorContains(t, err, "missing permission")
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, err := authtoken.NewSignedScopedJWT(signer, perms.ReadPerms, &perms.Scope{Methods: []string{"Submit"}}, 0)
+		require.Error(t, err)
+	})
+}
+
 func generateSelfSignedCert(t *testing.T) (certPath, keyPath string) {
 	t.Helper()
 
@@ -24,16 +24,22 @@ import (
 	blobMock "github.com/celestiaorg/celestia-node/nodebuilder/blob/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blobstream"
 	blobstreamMock "github.com/celestiaorg/celestia-node/nodebuilder/blobstream/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/core"
+	coreMock "github.com/celestiaorg/celestia-node/nodebuilder/core/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/da"
 	daMock "github.com/celestiaorg/celestia-node/nodebuilder/da/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
 	dasMock "github.com/celestiaorg/celestia-node/nodebuilder/das/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
+	fraudMock "github.com/celestiaorg/celestia-node/nodebuilder/fraud/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/header"
 	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	nodeMock "github.com/celestiaorg/celestia-node/nodebuilder/node/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 	p2pMock "github.com/celestiaorg/celestia-node/nodebuilder/p2p/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/pruner"
+	prunerMock "github.com/celestiaorg/celestia-node/nodebuilder/pruner/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/share"
 	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
 	statemod "github.com/celestiaorg/celestia-node/nodebuilder/state"
@@ -129,12 +135,15 @@ type api struct {
 	Header     header.Module
 	State      statemod.Module
 	Share      share.Module
+	Fraud      fraud.Module
 	DAS        das.Module
 	Node       node.Module
 	P2P        p2p.Module
 	Blob       blob.Module
 	DA         da.Module //nolint: staticcheck
 	Blobstream blobstream.Module
+	Pruner     pruner.Module
+	Core       core.Module
 }
 
 func TestModulesImplementFullAPI(t *testing.T) {
@@ -341,6 +350,7 @@ func setupNodeWithAuthedRPC(t *testing.T,
 	mockAPI := &mockAPI{
 		stateMock.NewMockModule(ctrl),
 		shareMock.NewMockModule(ctrl),
+		fraudMock.NewMockModule(ctrl),
 		headerMock.NewMockModule(ctrl),
 		dasMock.NewMockModule(ctrl),
 		p2pMock.NewMockModule(ctrl),
@@ -348,11 +358,14 @@ func setupNodeWithAuthedRPC(t *testing.T,
 		blobMock.NewMockModule(ctrl),
 		daMock.NewMockModule(ctrl),
 		blobstreamMock.NewMockModule(ctrl),
+		prunerMock.NewMockModule(ctrl),
+		coreMock.NewMockModule(ctrl),
 	}
 
 	// given the behavior of fx.Invoke, this invoke will be called last as it is added at the root
 	// level module. For further information, check the documentation on fx.Invoke.
 	invokeRPC := fx.Invoke(func(srv *rpc.Server) {
+		srv.RegisterService("fraud", mockAPI.Fraud, &fraud.API{})
 		srv.RegisterService("das", mockAPI.Das, &das.API{})
 		srv.RegisterService("header", mockAPI.Header, &header.API{})
 		srv.RegisterService("state", mockAPI.State, &statemod.API{})
@@ -361,6 +374,8 @@ func setupNodeWithAuthedRPC(t *testing.T,
 		srv.RegisterService("node", mockAPI.Node, &node.API{})
 		srv.RegisterService("blob", mockAPI.Blob, &blob.API{})
 		srv.RegisterService("da", mockAPI.DA, &da.API{})
+		srv.RegisterService("pruner", mockAPI.Pruner, &pruner.API{})
+		srv.RegisterService("core", mockAPI.Core, &core.API{})
 	})
 	// fx.Replace does not work here, but fx.Decorate does
 	nd := nodebuilder.TestNode(t, node.Bridge, invokeRPC, fx.Decorate(func() (jwt.Signer, jwt.Verifier, error) {
@@ -379,6 +394,7 @@ func setupNodeWithAuthedRPC(t *testing.T,
 type mockAPI struct {
 	State      *stateMock.MockModule
 	Share      *shareMock.MockModule
+	Fraud      *fraudMock.MockModule
 	Header     *headerMock.MockModule
 	Das        *dasMock.MockModule
 	P2P        *p2pMock.MockModule
@@ -386,4 +402,6 @@ type mockAPI struct {
 	Blob       *blobMock.MockModule
 	DA         *daMock.MockModule
 	Blobstream *blobstreamMock.MockModule
+	Pruner     *prunerMock.MockModule
+	Core       *coreMock.MockModule
 }
@@ -0,0 +1,212 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+// ReceiptOptions configures the guarantees provided by SubmitWithReceipt.
+type ReceiptOptions struct {
+	// Confirmations is the number of blocks that have to be produced on top of the
+	// inclusion height before the receipt is returned.
+	// Zero means the receipt is returned as soon as the blobs are included.
+	Confirmations uint64 `json:"confirmations,omitempty"`
+	// MaxResubmissions is the maximum number of times the blobs are re-submitted in case
+	// the PayForBlob transaction was evicted from the mempool.
+	// Zero disables re-submission.
+	MaxResubmissions uint64 `json:"max_resubmissions,omitempty"`
+}
+
+// Receipt describes the inclusion of the submitted blobs.
+type Receipt struct {
+	// TxHash is the hash of the PayForBlob transaction that included the blobs.
+	TxHash string `json:"tx_hash"`
+	// Height is the height at which the blobs were included.
+	Height uint64 `json:"height"`
+	// Resubmissions is the number of times the blobs were re-submitted after eviction.
+	Resubmissions uint64 `json:"resubmissions"`
+	// Blobs holds the inclusion details for each submitted blob
+	// in the same order the blobs were submitted.
+	Blobs []*BlobReceipt `json:"blobs"`
+}
+
+// BlobReceipt describes the inclusion of a single blob.
+type BlobReceipt struct {
+	Namespace  libshare.Namespace `json:"namespace"`
+	Commitment Commitment         `json:"commitment"`
+	// StartIndex is the index of the blob's first share in the EDS.
+	StartIndex int `json:"start_index"`
+	// EndIndex is the index of the blob's last share in the EDS.
+	EndIndex int `json:"end_index"`
+	// Proof proves the inclusion of the blob's commitment to the data root.
+	Proof *CommitmentProof `json:"proof"`
+}
+
+// SubmitWithReceipt sends PFB transaction and returns a Receipt once the blobs are included
+// and, optionally, confirmed by the requested amount of blocks.
+// If the transaction gets evicted from the mempool, the blobs are re-submitted
+// up to ReceiptOptions.MaxResubmissions times.
+func (s *Service) SubmitWithReceipt(
+	ctx context.Context,
+	blobs []*Blob,
+	txConfig *SubmitOptions,
+	opts *ReceiptOptions,
+) (_ *Receipt, err error) {
+	ctx, span := tracer.Start(ctx, "blob/submit-with-receipt")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+		if err != nil {
+			log.Errorw("submitting blobs with receipt failed", "err", err)
+		}
+	}()
+
+	if opts == nil {
+		opts = &ReceiptOptions{}
+	}
+
+	libBlobs := ToLibBlobs(blobs...)
+	receipt := &Receipt{}
+	for {
+		resp, err := s.blobSubmitter.SubmitPayForBlob(ctx, libBlobs, txConfig)
+		if err == nil {
+			receipt.TxHash = resp.TxHash
+			receipt.Height = uint64(resp.Height)
+			break
+		}
+		if !isTxEvicted(err) || receipt.Resubmissions >= opts.MaxResubmissions {
+			return nil, err
+		}
+		receipt.Resubmissions++
+		log.Warnw("PFB transaction was evicted from the mempool, re-submitting",
+			"attempt", receipt.Resubmissions,
+			"max", opts.MaxResubmissions,
+			"err", err,
+		)
+	}
+	span.SetAttributes(
+		attribute.Int64("height", int64(receipt.Height)),
+		attribute.String("hash", receipt.TxHash),
+	)
+
+	if err = s.waitForConfirmations(ctx, receipt.Height, opts.Confirmations); err != nil {
+		return nil, fmt.Errorf("waiting for %d confirmations of height %d: %w",
+			opts.Confirmations, receipt.Height, err)
+	}
+
+	receipt.Blobs, err = s.blobReceipts(ctx, receipt.Height, blobs)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// waitForConfirmations blocks until a header which is `confirmations` blocks above the given
+// height is received. It returns right away if the local head is high enough already.
+func (s *Service) waitForConfirmations(ctx context.Context, height, confirmations uint64) error {
+	if confirmations == 0 {
+		return nil
+	}
+
+	target := height + confirmations
+	if reached, err := s.headReached(ctx, target); err != nil || reached {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	headerCh, err := s.headerSub(ctx)
+	if err != nil {
+		return err
+	}
+	// the target may have been reached before the subscription started
+	if reached, err := s.headReached(ctx, target); err != nil || reached {
+		return err
+	}
+
+	for {
+		select {
+		case h, ok := <-headerCh:
+			if !ok {
+				return errors.New("header subscription closed")
+			}
+			if h.Height() >= target {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// headReached reports whether the local head is at the target height or above. It is always
+// false if the Service can't get the local head.
+func (s *Service) headReached(ctx context.Context, target uint64) (bool, error) {
+	if s.headGetter == nil {
+		return false, nil
+	}
+	head, err := s.headGetter(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting local head: %w", err)
+	}
+	return head.Height() >= target, nil
+}
+
+// blobReceipts retrieves the included blobs at the given height and builds a BlobReceipt
+// for each of them.
+func (s *Service) blobReceipts(ctx context.Context, height uint64, blobs []*Blob) ([]*BlobReceipt, error) {
+	header, err := s.headerGetter(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*BlobReceipt, len(blobs))
+	for i, b := range blobs {
+		included, err := s.Get(ctx, height, b.Namespace(), b.Commitment)
+		if err != nil {
+			return nil, fmt.Errorf("getting included blob %s: %w", b.Commitment, err)
+		}
+		length, err := included.Length()
+		if err != nil {
+			return nil, err
+		}
+		proof, err := s.GetCommitmentProof(ctx, height, b.Namespace(), b.Commitment)
+		if err != nil {
+			return nil, fmt.Errorf("proving commitment %s: %w", b.Commitment, err)
+		}
+
+		receipts[i] = &BlobReceipt{
+			Namespace:  b.Namespace(),
+			Commitment: b.Commitment,
+			StartIndex: included.Index(),
+			EndIndex:   lastShareIndex(len(header.DAH.RowRoots), included.Index(), length),
+			Proof:      proof,
+		}
+	}
+	return receipts, nil
+}
+
+// lastShareIndex returns the EDS index of the last share of the blob that starts at
+// the given EDS index and spans the given amount of shares. Blob shares are laid out
+// sequentially in the ODS, so the parity part of the rows has to be skipped.
+func lastShareIndex(rowLength, startIndex, length int) int {
+	odsWidth := rowLength / 2
+	row, col := calculateIndex(rowLength, startIndex)
+	last := row*odsWidth + col + length - 1
+	return (last/odsWidth)*rowLength + last%odsWidth
+}
+
+// isTxEvicted reports whether the submission failed because the transaction was
+// evicted from the mempool and never got included.
+func isTxEvicted(err error) bool {
+	return errors.Is(err, state.ErrTxEvicted)
+}
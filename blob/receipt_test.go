@@ -0,0 +1,202 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+func TestService_SubmitWithReceipt(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{1, 6, 3, 6}, true)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+	shares, err := BlobsToShares(blobs...)
+	require.NoError(t, err)
+
+	service := createService(ctx, t, shares)
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+
+	submitter := &submitterStub{evictions: 2}
+	service.blobSubmitter = submitter
+
+	h, err := service.headerGetter(ctx, 1)
+	require.NoError(t, err)
+
+	receipt, err := service.SubmitWithReceipt(ctx, blobs, state.NewTxConfig(), &ReceiptOptions{MaxResubmissions: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), receipt.Height)
+	assert.Equal(t, "hash", receipt.TxHash)
+	assert.Equal(t, uint64(2), receipt.Resubmissions)
+	assert.Equal(t, 3, submitter.calls)
+	require.Len(t, receipt.Blobs, len(blobs))
+
+	for i, blobReceipt := range receipt.Blobs {
+		b, err := service.Get(ctx, 1, blobs[i].Namespace(), blobs[i].Commitment)
+		require.NoError(t, err)
+		length, err := b.Length()
+		require.NoError(t, err)
+
+		assert.Equal(t, blobs[i].Namespace(), blobReceipt.Namespace)
+		assert.Equal(t, blobs[i].Commitment, blobReceipt.Commitment)
+		assert.Equal(t, b.Index(), blobReceipt.StartIndex)
+		assert.Equal(t, lastShareIndex(len(h.DAH.RowRoots), b.Index(), length), blobReceipt.EndIndex)
+		require.NoError(t, blobReceipt.Proof.Verify(h.DataHash, blobReceipt.Commitment))
+	}
+}
+
+func TestService_SubmitWithReceipt_ResubmissionsExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{4}, true)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+
+	service := NewService(&submitterStub{evictions: 3}, nil, nil, nil)
+	_, err = service.SubmitWithReceipt(ctx, blobs, state.NewTxConfig(), &ReceiptOptions{MaxResubmissions: 2})
+	require.Error(t, err)
+	assert.True(t, isTxEvicted(err))
+
+	// non-eviction errors are not retried, even if they mention an eviction
+	submitter := &submitterStub{err: errors.New("insufficient funds, tx evicted")}
+	service = NewService(submitter, nil, nil, nil)
+	_, err = service.SubmitWithReceipt(ctx, blobs, state.NewTxConfig(), &ReceiptOptions{MaxResubmissions: 2})
+	require.Error(t, err)
+	assert.Equal(t, 1, submitter.calls)
+}
+
+func TestService_SubmitWithReceipt_Confirmations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{4}, true)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+	shares, err := BlobsToShares(blobs...)
+	require.NoError(t, err)
+
+	service := createService(ctx, t, shares)
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+	service.blobSubmitter = &submitterStub{}
+
+	headerCh := make(chan *header.ExtendedHeader)
+	service.headerSub = func(context.Context) (<-chan *header.ExtendedHeader, error) {
+		return headerCh, nil
+	}
+
+	receiptCh := make(chan *Receipt, 1)
+	go func() {
+		receipt, err := service.SubmitWithReceipt(ctx, blobs, state.NewTxConfig(), &ReceiptOptions{Confirmations: 2})
+		assert.NoError(t, err)
+		receiptCh <- receipt
+	}()
+
+	for height := uint64(2); height <= 3; height++ {
+		select {
+		case receipt := <-receiptCh:
+			t.Fatalf("receipt returned before confirmations: %v", receipt)
+		default:
+		}
+		h := headertest.RandExtendedHeader(t)
+		h.RawHeader.Height = int64(height)
+		headerCh <- h
+	}
+
+	select {
+	case receipt := <-receiptCh:
+		require.NotNil(t, receipt)
+		assert.Equal(t, uint64(1), receipt.Height)
+		require.Len(t, receipt.Blobs, 1)
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
+func TestService_SubmitWithReceipt_ConfirmedAlready(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{4}, true)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+	shares, err := BlobsToShares(blobs...)
+	require.NoError(t, err)
+
+	service := createService(ctx, t, shares)
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+	service.blobSubmitter = &submitterStub{}
+	service.headerSub = func(context.Context) (<-chan *header.ExtendedHeader, error) {
+		t.Error("subscribed to new headers despite the local head being high enough")
+		return nil, errors.New("unexpected subscription")
+	}
+	service.WithHeadGetter(func(context.Context) (*header.ExtendedHeader, error) {
+		h := headertest.RandExtendedHeader(t)
+		h.RawHeader.Height = 3
+		return h, nil
+	})
+
+	receipt, err := service.SubmitWithReceipt(ctx, blobs, state.NewTxConfig(), &ReceiptOptions{Confirmations: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), receipt.Height)
+}
+
+func TestLastShareIndex(t *testing.T) {
+	tests := []struct {
+		rowLength, start, length, expected int
+	}{
+		{rowLength: 8, start: 0, length: 1, expected: 0},
+		{rowLength: 8, start: 1, length: 3, expected: 3},
+		{rowLength: 8, start: 2, length: 3, expected: 8},
+		{rowLength: 8, start: 3, length: 9, expected: 19},
+		{rowLength: 4, start: 4, length: 2, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d/%d", tt.rowLength, tt.start, tt.length), func(t *testing.T) {
+			assert.Equal(t, tt.expected, lastShareIndex(tt.rowLength, tt.start, tt.length))
+		})
+	}
+}
+
+type submitterStub struct {
+	evictions int
+	err       error
+	calls     int
+}
+
+func (s *submitterStub) SubmitPayForBlob(
+	context.Context,
+	[]*libshare.Blob,
+	*state.TxConfig,
+) (*types.TxResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.calls <= s.evictions {
+		return nil, fmt.Errorf("%w: transaction %d", state.ErrTxEvicted, s.calls)
+	}
+	return &types.TxResponse{Height: 1, TxHash: "hash"}, nil
+}
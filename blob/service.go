@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/celestiaorg/celestia-app/v9/pkg/appconsts"
 	pkgproof "github.com/celestiaorg/celestia-app/v9/pkg/proof"
@@ -27,6 +30,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability"
 	"github.com/celestiaorg/celestia-node/share/shwap"
 	"github.com/celestiaorg/celestia-node/state"
 )
@@ -50,6 +54,18 @@ type Submitter interface {
 	SubmitPayForBlob(context.Context, []*libshare.Blob, *state.TxConfig) (*types.TxResponse, error)
 }
 
+// pendingSubmissionsGetter is implemented by the Submitters that persist pending submissions.
+type pendingSubmissionsGetter interface {
+	PendingSubmissions(context.Context) ([]*state.PendingSubmission, error)
+}
+
+// NamespaceSharesGetter reads the shares of a namespace from the local storage without
+// building inclusion proofs. The index of the first share in the ODS is returned along with
+// the shares.
+type NamespaceSharesGetter interface {
+	GetNamespaceShares(context.Context, *header.ExtendedHeader, libshare.Namespace) (int, []libshare.Share, error)
+}
+
 type Service struct {
 	// ctx represents the Service's lifecycle context.
 	ctx    context.Context
@@ -62,6 +78,10 @@ type Service struct {
 	headerGetter func(context.Context, uint64) (*header.ExtendedHeader, error)
 	// headerSub subscribes to new headers to supply to blob subscriptions.
 	headerSub func(ctx context.Context) (<-chan *header.ExtendedHeader, error)
+	// headGetter optionally returns the local head, so subscriptions can start from historic heights.
+	headGetter func(context.Context) (*header.ExtendedHeader, error)
+	// nsSharesGetter optionally reads namespace shares from the local storage.
+	nsSharesGetter NamespaceSharesGetter
 	// metrics tracks blob-related metrics
 	metrics *metrics
 }
@@ -81,6 +101,18 @@ func NewService(
 	}
 }
 
+// WithNamespaceSharesGetter enables reading blobs of GetAll and subscriptions directly from the
+// local storage, avoiding retrieval of whole rows with proofs when the storage has the data.
+func (s *Service) WithNamespaceSharesGetter(getter NamespaceSharesGetter) {
+	s.nsSharesGetter = getter
+}
+
+// WithHeadGetter enables subscriptions starting from historic heights (see SubscribeOptions).
+// The head is used to tell the heights that are not synced yet from the unavailable ones.
+func (s *Service) WithHeadGetter(getter func(context.Context) (*header.ExtendedHeader, error)) {
+	s.headGetter = getter
+}
+
 func (s *Service) Start(context.Context) error {
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 	return nil
@@ -99,81 +131,305 @@ type SubscriptionResponse struct {
 	Blobs  []*Blob
 	Height uint64 // Deprecated: use Header.Height() instead. Kept for backwards compatibility.
 	Header *header.RawHeader
+	// BlobsByNamespace groups the blobs by the subscribed namespaces in the order the namespaces
+	// were provided. It is only set for subscriptions created with SubscribeAll.
+	BlobsByNamespace []*NamespaceBlobs
+	// Empty explicitly marks heights that have no blobs under the subscribed namespaces.
+	Empty bool
+	// Cursor can be passed in SubscribeOptions to resume the subscription right after this
+	// response.
+	Cursor string
+}
+
+// NamespaceBlobs holds the blobs of a single namespace.
+type NamespaceBlobs struct {
+	Namespace libshare.Namespace
+	Blobs     []*Blob
+}
+
+// SubscribeOptions configures the height the subscription starts from.
+type SubscribeOptions struct {
+	// FromHeight is the height to start streaming from. Historic heights are replayed before
+	// switching to the live ones. Zero means streaming starts from the next live height.
+	// The subscription is closed if any of the heights is unavailable, e.g. below the tail of
+	// the header store.
+	FromHeight uint64 `json:"from_height,omitempty"`
+	// Cursor resumes the subscription right after the response it was taken from.
+	// It takes precedence over FromHeight.
+	Cursor string `json:"cursor,omitempty"`
 }
 
+// startHeight returns the height the subscription starts from or zero for live subscriptions.
+func (opts *SubscribeOptions) startHeight() (uint64, error) {
+	if opts == nil {
+		return 0, nil
+	}
+	if opts.Cursor == "" {
+		return opts.FromHeight, nil
+	}
+
+	height, err := strconv.ParseUint(opts.Cursor, 10, 64)
+	if err != nil || height == 0 {
+		return 0, fmt.Errorf("blob: invalid subscription cursor: %s", opts.Cursor)
+	}
+	return height, nil
+}
+
+// subscriptionRetryDelay is the delay between attempts to fetch a height, or its blobs, that
+// are not yet available during the subscription.
+var subscriptionRetryDelay = time.Second
+
 // Subscribe returns a channel that will receive SubscriptionResponse objects.
+// The subscription starts from the next live height.
 // The channel will be closed when the context is canceled or the service is stopped.
 // Please note that no errors are returned: underlying operations are retried until successful.
 // Additionally, not reading from the returned channel will cause the stream to close after 16 messages.
 func (s *Service) Subscribe(ctx context.Context, ns libshare.Namespace) (<-chan *SubscriptionResponse, error) {
+	return s.subscribe(ctx, []libshare.Namespace{ns}, nil, false)
+}
+
+// SubscribeFrom works as Subscribe, but if a starting height or a cursor is provided, historic
+// heights are replayed first and then the subscription seamlessly switches to live heights
+// without gaps or duplicates. The replay is closed if a historic height can't be retrieved,
+// e.g. if its data is pruned or outside the sampling window.
+func (s *Service) SubscribeFrom(
+	ctx context.Context,
+	ns libshare.Namespace,
+	opts *SubscribeOptions,
+) (<-chan *SubscriptionResponse, error) {
+	return s.subscribe(ctx, []libshare.Namespace{ns}, opts, false)
+}
+
+// SubscribeAll works as SubscribeFrom, but streams the blobs of multiple namespaces in a single
+// subscription. Shares of each height are retrieved once for all the namespaces and the blobs
+// are grouped by namespace in SubscriptionResponse.BlobsByNamespace.
+func (s *Service) SubscribeAll(
+	ctx context.Context,
+	namespaces []libshare.Namespace,
+	opts *SubscribeOptions,
+) (<-chan *SubscriptionResponse, error) {
+	if len(namespaces) == 0 {
+		return nil, errors.New("blob: no namespaces provided")
+	}
+
+	unique := make([]libshare.Namespace, 0, len(namespaces))
+	for _, ns := range namespaces {
+		if !slices.ContainsFunc(unique, ns.Equals) {
+			unique = append(unique, ns)
+		}
+	}
+	return s.subscribe(ctx, unique, opts, true)
+}
+
+func (s *Service) subscribe(
+	ctx context.Context,
+	namespaces []libshare.Namespace,
+	opts *SubscribeOptions,
+	grouped bool,
+) (<-chan *SubscriptionResponse, error) {
 	if s.ctx == nil {
 		return nil, fmt.Errorf("service has not been started")
 	}
 
+	from, err := opts.startHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &subscription{
+		namespaces: namespaces,
+		grouped:    grouped,
+		blobCh:     make(chan *SubscriptionResponse, 16),
+	}
 	log.Infow("subscribing for blobs",
-		"namespaces", ns.String(),
+		"namespaces", sub.String(),
+		"from", from,
 	)
+	if from != 0 {
+		if s.headGetter == nil {
+			return nil, fmt.Errorf("blob: subscriptions from historic heights are not supported")
+		}
+		go s.subscribeFrom(ctx, sub, from)
+		return sub.blobCh, nil
+	}
+
 	headerCh, err := s.headerSub(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	blobCh := make(chan *SubscriptionResponse, 16)
 	go func() {
-		defer close(blobCh)
+		defer close(sub.blobCh)
 
 		for {
 			select {
 			case header, ok := <-headerCh:
 				if ctx.Err() != nil {
-					log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespace", ns.ID())
+					log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespaces", sub)
 					return
 				}
 				if !ok {
-					log.Errorw("header channel closed for subscription", "namespace", ns.ID())
+					log.Errorw("header channel closed for subscription", "namespaces", sub)
 					return
 				}
-				// close subscription before buffer overflows
-				if len(blobCh) == cap(blobCh) {
-					log.Debugw("blobsub: canceling subscription due to buffer overflow from slow reader", "namespace", ns.ID())
+				if !s.sendSubscriptionResponse(ctx, sub, header, false) {
 					return
 				}
+			case <-ctx.Done():
+				log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespaces", sub)
+				return
+			case <-s.ctx.Done():
+				log.Debugw("blobsub: canceling subscription due to service ctx closing", "namespaces", sub)
+				return
+			}
+		}
+	}()
+	return sub.blobCh, nil
+}
 
-				var blobs []*Blob
-				var err error
-				for {
-					blobs, err = s.getAll(ctx, header, []libshare.Namespace{ns})
-					if ctx.Err() != nil {
-						// context canceled, continuing would lead to unexpected missed heights for the client
-						log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespace", ns.ID())
-						return
-					}
-					if err == nil {
-						// operation successful, break the loop
-						break
-					}
-				}
+// subscription holds the state of a single blob subscription.
+type subscription struct {
+	namespaces []libshare.Namespace
+	// grouped defines whether the blobs are grouped by namespace in responses.
+	grouped bool
+	blobCh  chan *SubscriptionResponse
+}
 
-				select {
-				case <-ctx.Done():
-					log.Debugw("blobsub: pending response canceled due to user ctx closing", "namespace", ns.ID())
-					return
-				case blobCh <- &SubscriptionResponse{
-					Blobs:  blobs,
-					Height: header.Height(),
-					Header: &header.RawHeader,
-				}:
-				}
+func (sub *subscription) String() string {
+	ids := make([]string, len(sub.namespaces))
+	for i, ns := range sub.namespaces {
+		ids[i] = hex.EncodeToString(ns.ID())
+	}
+	return strings.Join(ids, ",")
+}
+
+// subscribeFrom streams the blobs sequentially starting from the given height. Heights above
+// the local head are awaited, so the stream switches from historic to live heights without
+// gaps or duplicates. The subscription is closed if any of the heights can't be retrieved,
+// e.g. if it starts below the tail of the header store.
+func (s *Service) subscribeFrom(ctx context.Context, sub *subscription, height uint64) {
+	defer close(sub.blobCh)
+
+	var head uint64
+	for {
+		if height > head {
+			hdr, err := s.headGetter(ctx)
+			if err != nil {
+				log.Errorw("blobsub: canceling subscription due to failure getting the head",
+					"namespaces", sub, "err", err)
+				return
+			}
+			head = hdr.Height()
+		}
+
+		if height > head {
+			log.Debugw("blobsub: waiting for the height to be synced",
+				"namespaces", sub, "height", height, "head", head)
+			select {
+			case <-time.After(subscriptionRetryDelay):
+				continue
 			case <-ctx.Done():
-				log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespace", ns.ID())
+				log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespaces", sub)
 				return
 			case <-s.ctx.Done():
-				log.Debugw("blobsub: canceling subscription due to service ctx closing", "namespace", ns.ID())
+				log.Debugw("blobsub: canceling subscription due to service ctx closing", "namespaces", sub)
 				return
 			}
 		}
-	}()
-	return blobCh, nil
+
+		header, err := s.headerGetter(ctx, height)
+		if err != nil {
+			log.Errorw("blobsub: canceling subscription due to failure getting the header",
+				"namespaces", sub, "height", height, "err", err)
+			return
+		}
+
+		if s.ctx.Err() != nil {
+			log.Debugw("blobsub: canceling subscription due to service ctx closing", "namespaces", sub)
+			return
+		}
+		// the heights are pulled at the pace of the reader, so a slow one only delays the stream
+		if !s.sendSubscriptionResponse(ctx, sub, header, true) {
+			return
+		}
+		height++
+	}
+}
+
+// sendSubscriptionResponse retrieves the blobs of the subscribed namespaces at the given header
+// and sends them to the subscription channel. It returns false if the subscription has to be
+// closed. With backpressure, as for the historic heights, the send blocks until the reader
+// catches up instead of closing the subscription once the buffer is full, and the subscription
+// is closed if the blobs of the height are unavailable for good, e.g. pruned or outside the
+// sampling window, instead of retrying forever.
+func (s *Service) sendSubscriptionResponse(
+	ctx context.Context,
+	sub *subscription,
+	header *header.ExtendedHeader,
+	backpressure bool,
+) bool {
+	// close subscription before buffer overflows
+	if !backpressure && len(sub.blobCh) == cap(sub.blobCh) {
+		log.Debugw("blobsub: canceling subscription due to buffer overflow from slow reader", "namespaces", sub)
+		return false
+	}
+
+	var blobs [][]*Blob
+	var err error
+	for {
+		blobs, err = s.getNamespacesBlobs(ctx, header, sub.namespaces)
+		if ctx.Err() != nil {
+			// context canceled, continuing would lead to unexpected missed heights for the client
+			log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespaces", sub)
+			return false
+		}
+		if err == nil {
+			// operation successful, break the loop
+			break
+		}
+		if backpressure && (errors.Is(err, shwap.ErrNotFound) || errors.Is(err, availability.ErrOutsideSamplingWindow)) {
+			log.Errorw("blobsub: canceling subscription due to unavailable blobs",
+				"namespaces", sub, "height", header.Height(), "err", err)
+			return false
+		}
+
+		log.Debugw("blobsub: retrying to get blobs",
+			"namespaces", sub, "height", header.Height(), "err", err)
+		select {
+		case <-time.After(subscriptionRetryDelay):
+		case <-ctx.Done():
+			log.Debugw("blobsub: canceling subscription due to user ctx closing", "namespaces", sub)
+			return false
+		case <-s.ctx.Done():
+			log.Debugw("blobsub: canceling subscription due to service ctx closing", "namespaces", sub)
+			return false
+		}
+	}
+
+	resp := &SubscriptionResponse{
+		Blobs:  slices.Concat(blobs...),
+		Height: header.Height(),
+		Header: &header.RawHeader,
+		Cursor: strconv.FormatUint(header.Height()+1, 10),
+	}
+	resp.Empty = len(resp.Blobs) == 0
+	if sub.grouped {
+		resp.BlobsByNamespace = make([]*NamespaceBlobs, len(sub.namespaces))
+		for i, ns := range sub.namespaces {
+			resp.BlobsByNamespace[i] = &NamespaceBlobs{Namespace: ns, Blobs: blobs[i]}
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		log.Debugw("blobsub: pending response canceled due to user ctx closing", "namespaces", sub)
+		return false
+	case <-s.ctx.Done():
+		log.Debugw("blobsub: pending response canceled due to service ctx closing", "namespaces", sub)
+		return false
+	case sub.blobCh <- resp:
+		return true
+	}
 }
 
 // Submit sends PFB transaction and reports the height at which it was included.
@@ -205,6 +461,16 @@ func (s *Service) Submit(ctx context.Context, blobs []*Blob, txConfig *SubmitOpt
 	return uint64(resp.Height), nil
 }
 
+// PendingSubmissions returns the submissions that were accepted by the node,
+// but are not confirmed yet.
+func (s *Service) PendingSubmissions(ctx context.Context) ([]*state.PendingSubmission, error) {
+	getter, ok := s.blobSubmitter.(pendingSubmissionsGetter)
+	if !ok {
+		return nil, errors.New("blob: pending submissions are not tracked by the submitter")
+	}
+	return getter.PendingSubmissions(ctx)
+}
+
 // Get retrieves a blob in a given namespace at the given height by commitment.
 // Get collects all namespaced data from the EDS, construct the blob
 // and compares the commitment argument.
@@ -355,6 +621,103 @@ func (s *Service) getAll(
 	return blobs, err
 }
 
+// getNamespacesBlobs returns the blobs of each of the given namespaces at the given header.
+// In contrast to getAll, rows containing the namespaces are retrieved only once,
+// regardless of the amount of namespaces they contain. If the rows can't be retrieved,
+// the namespaces are retrieved one by one.
+func (s *Service) getNamespacesBlobs(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	namespaces []libshare.Namespace,
+) (_ [][]*Blob, err error) {
+	ctx, span := tracer.Start(ctx, "blob/get-namespaces-blobs")
+	defer utils.SetStatusAndEnd(span, err)
+
+	if len(namespaces) == 1 {
+		blobs, err := s.getBlobs(ctx, namespaces[0], header)
+		return [][]*Blob{blobs}, err
+	}
+
+	namespaceRows := make([][]int, len(namespaces))
+	rowIdxs := make([]int, 0)
+	for i, ns := range namespaces {
+		namespaceRows[i], err = share.RowsWithNamespace(header.DAH, ns)
+		if err != nil {
+			return nil, err
+		}
+		rowIdxs = append(rowIdxs, namespaceRows[i]...)
+	}
+	slices.Sort(rowIdxs)
+	rowIdxs = slices.Compact(rowIdxs)
+	span.SetAttributes(attribute.Int("rows", len(rowIdxs)))
+
+	var (
+		rows   = make(map[int][]libshare.Share, len(rowIdxs))
+		rowsLk sync.Mutex
+	)
+	errGroup, rowsCtx := errgroup.WithContext(ctx)
+	for _, idx := range rowIdxs {
+		errGroup.Go(func() error {
+			row, err := s.shareGetter.GetRow(rowsCtx, header, idx)
+			if err != nil {
+				return fmt.Errorf("getting row %d: %w", idx, err)
+			}
+			shares, err := row.Shares()
+			if err != nil {
+				return fmt.Errorf("getting shares of row %d: %w", idx, err)
+			}
+
+			rowsLk.Lock()
+			rows[idx] = shares
+			rowsLk.Unlock()
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		// the rows may be unavailable while the namespace data is not, e.g. the data of
+		// the pinned namespaces is kept after the square is pruned
+		log.Debugw("falling back to namespace data retrieval",
+			"height", header.Height(),
+			"namespaces", len(namespaces),
+			"err", err,
+		)
+		result := make([][]*Blob, len(namespaces))
+		for i, ns := range namespaces {
+			result[i], err = s.getBlobs(ctx, ns, header)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	result := make([][]*Blob, len(namespaces))
+	for i, ns := range namespaces {
+		namespacedShares := make(shwap.NamespaceData, len(namespaceRows[i]))
+		for j, idx := range namespaceRows[i] {
+			namespacedShares[j], err = shwap.RowNamespaceDataFromShares(rows[idx], ns, idx)
+			if err != nil {
+				return nil, fmt.Errorf("extracting namespace data from row %d: %w", idx, err)
+			}
+		}
+
+		blobs := make([]*Blob, 0)
+		sharesParser := &parser{verifyFn: func(blob *Blob) bool {
+			blobs = append(blobs, blob)
+			return false
+		}}
+		_, _, err = parseNamespaceData(header, ns, namespacedShares, sharesParser)
+		if err != nil && !errors.Is(err, ErrBlobNotFound) {
+			return nil, fmt.Errorf("parsing blobs for the namespace (%s): %w", ns.String(), err)
+		}
+		result[i] = blobs
+	}
+	return result, nil
+}
+
 // Included verifies that the blob was included in a specific height.
 // To ensure that blob was included in a specific height, we need:
 // 1. verify the provided commitment by recomputing it;
@@ -433,17 +796,6 @@ func (s *Service) retrieve(
 		attribute.Int64("eds-size", int64(len(header.DAH.RowRoots))),
 	)
 
-	rowIndex := -1
-	for i, row := range header.DAH.RowRoots {
-		outside, err := share.IsOutsideRange(namespace, row, row)
-		if err != nil {
-			return nil, nil, err
-		}
-		if !outside {
-			rowIndex = i
-			break
-		}
-	}
 	// collect shares for the requested namespace
 	namespacedShares, err := s.shareGetter.GetNamespaceData(ctx, header, namespace)
 	if err != nil {
@@ -456,6 +808,29 @@ func (s *Service) retrieve(
 	span.AddEvent("received-shares", trace.WithAttributes(
 		attribute.Int("amount", namespacedShares.Length()),
 	))
+	return parseNamespaceData(header, namespace, namespacedShares, sharesParser)
+}
+
+// parseNamespaceData parses blobs and their proofs out of the namespace data.
+// Parsing is stopped once the `verify` condition in shareParser is met.
+func parseNamespaceData(
+	header *header.ExtendedHeader,
+	namespace libshare.Namespace,
+	namespacedShares shwap.NamespaceData,
+	sharesParser *parser,
+) (_ *Blob, _ *Proof, err error) {
+	height := header.Height()
+	rowIndex := -1
+	for i, row := range header.DAH.RowRoots {
+		outside, err := share.IsOutsideRange(namespace, row, row)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !outside {
+			rowIndex = i
+			break
+		}
+	}
 
 	var (
 		appShares = make([]libshare.Share, 0)
@@ -563,7 +938,12 @@ func (s *Service) getBlobs(
 	}
 	sharesParser := &parser{verifyFn: verifyFn}
 
-	_, _, err = s.retrieve(ctx, header.Height(), namespace, sharesParser)
+	if namespacedShares, ok := s.localNamespaceData(ctx, namespace, header); ok {
+		span.AddEvent("read-local-shares")
+		_, _, err = parseNamespaceData(header, namespace, namespacedShares, sharesParser)
+	} else {
+		_, _, err = s.retrieve(ctx, header.Height(), namespace, sharesParser)
+	}
 	if err != nil && !errors.Is(err, ErrBlobNotFound) {
 		log.Errorf("retrieving blobs for the namespace (%s): %v", namespace.String(), err)
 		span.RecordError(err)
@@ -577,6 +957,44 @@ func (s *Service) getBlobs(
 	return blobs, nil
 }
 
+// localNamespaceData reads the shares of the namespace from the local storage and groups them
+// by rows. The returned rows carry only positions of the shares and no proof nodes, so they
+// can only be used for parsing blobs. False is returned if the local storage can't serve the
+// namespace.
+func (s *Service) localNamespaceData(
+	ctx context.Context,
+	namespace libshare.Namespace,
+	header *header.ExtendedHeader,
+) (shwap.NamespaceData, bool) {
+	if s.nsSharesGetter == nil {
+		return nil, false
+	}
+
+	from, shares, err := s.nsSharesGetter.GetNamespaceShares(ctx, header, namespace)
+	if err != nil {
+		log.Debugw("falling back to namespace data retrieval",
+			"namespace", namespace.String(),
+			"height", header.Height(),
+			"err", err,
+		)
+		return nil, false
+	}
+
+	odsWidth := len(header.DAH.RowRoots) / 2
+	namespacedShares := make(shwap.NamespaceData, 0)
+	for len(shares) > 0 {
+		col := from % odsWidth
+		amount := min(odsWidth-col, len(shares))
+		proof := nmt.NewInclusionProof(col, col+amount, nil, true)
+		namespacedShares = append(namespacedShares, shwap.RowNamespaceData{
+			Shares: shares[:amount],
+			Proof:  &proof,
+		})
+		shares, from = shares[amount:], from+amount
+	}
+	return namespacedShares, true
+}
+
 func (s *Service) GetCommitmentProof(
 	ctx context.Context,
 	height uint64,
@@ -10,6 +10,7 @@ import (
 	"math"
 	"slices"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	"github.com/celestiaorg/celestia-node/share/ipld"
@@ -774,6 +776,234 @@ func TestService_Subscribe(t *testing.T) {
 	})
 }
 
+func TestService_SubscribeFrom(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{16, 16, 16}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+
+	service, headers := createServiceWithSub(ctx, t, blobs)
+	// only the first two heights are available at the beginning, the last one becomes
+	// available later to simulate switching from historic heights to the live ones
+	var available atomic.Uint64
+	available.Store(2)
+	service.WithHeadGetter(func(context.Context) (*header.ExtendedHeader, error) {
+		return headers[available.Load()-1], nil
+	})
+	service.headerGetter = func(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+		if height > available.Load() {
+			return nil, errors.New("height is from the future")
+		}
+		return headers[height-1], nil
+	}
+	retryDelay := subscriptionRetryDelay
+	subscriptionRetryDelay = time.Millisecond * 10
+	t.Cleanup(func() { subscriptionRetryDelay = retryDelay })
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+
+	ns := blobs[0].Namespace()
+	subCh, err := service.SubscribeFrom(ctx, ns, &SubscribeOptions{FromHeight: 1})
+	require.NoError(t, err)
+
+	var cursor string
+	for height := uint64(1); height <= uint64(len(headers)); height++ {
+		if height == 3 {
+			available.Store(3)
+		}
+
+		select {
+		case resp := <-subCh:
+			assert.Equal(t, height, resp.Height)
+			assert.Equal(t, height == 1, !resp.Empty)
+			assert.Equal(t, resp.Empty, len(resp.Blobs) == 0)
+			assert.Equal(t, fmt.Sprint(height+1), resp.Cursor)
+			if height == 1 {
+				cursor = resp.Cursor
+			}
+		case <-ctx.Done():
+			t.Fatalf("timeout waiting for subscription response %d", height)
+		}
+	}
+
+	// ensure there are no duplicates
+	select {
+	case resp := <-subCh:
+		t.Fatalf("unexpected response at height %d", resp.Height)
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// resume the subscription right after the first response
+	subCh, err = service.SubscribeFrom(ctx, ns, &SubscribeOptions{FromHeight: 1, Cursor: cursor})
+	require.NoError(t, err)
+	select {
+	case resp := <-subCh:
+		assert.Equal(t, uint64(2), resp.Height)
+		assert.True(t, resp.Empty)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for resumed subscription response")
+	}
+
+	_, err = service.SubscribeFrom(ctx, ns, &SubscribeOptions{Cursor: "invalid"})
+	require.Error(t, err)
+}
+
+func TestService_SubscribeFrom_Backpressure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{16}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+
+	// replay more heights than the subscription buffer fits
+	const heights = 64
+	service, headers := createServiceWithSub(ctx, t, blobs)
+	head := headertest.RandExtendedHeader(t)
+	head.RawHeader.Height = heights
+	service.WithHeadGetter(func(context.Context) (*header.ExtendedHeader, error) {
+		return head, nil
+	})
+	service.headerGetter = func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return headers[0], nil
+	}
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+
+	subCh, err := service.SubscribeFrom(ctx, blobs[0].Namespace(), &SubscribeOptions{FromHeight: 1})
+	require.NoError(t, err)
+
+	// let the buffer fill up before reading
+	time.Sleep(time.Millisecond * 100)
+	for i := 0; i < heights; i++ {
+		select {
+		case resp, ok := <-subCh:
+			require.True(t, ok, "subscription closed after %d responses", i)
+			assert.Len(t, resp.Blobs, 1)
+		case <-ctx.Done():
+			t.Fatalf("timeout waiting for subscription response %d", i)
+		}
+	}
+}
+
+func TestService_SubscribeFrom_Unavailable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{16}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+
+	service, headers := createServiceWithSub(ctx, t, blobs)
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+	_, err = service.SubscribeFrom(ctx, blobs[0].Namespace(), &SubscribeOptions{FromHeight: 1})
+	require.Error(t, err, "historic subscriptions require the head getter")
+
+	service.WithHeadGetter(func(context.Context) (*header.ExtendedHeader, error) {
+		return headers[0], nil
+	})
+	service.headerGetter = func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return nil, errors.New("requested header is below tail")
+	}
+
+	subCh, err := service.SubscribeFrom(ctx, blobs[0].Namespace(), &SubscribeOptions{FromHeight: 1})
+	require.NoError(t, err)
+	select {
+	case _, ok := <-subCh:
+		assert.False(t, ok, "expected subscription channel to be closed")
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for subscription channel to close")
+	}
+
+	// the header is there, but the square is outside the sampling window
+	service.headerGetter = func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return headers[0], nil
+	}
+	unavailable := mock.NewMockGetter(gomock.NewController(t))
+	unavailable.EXPECT().GetNamespaceData(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		Return(nil, fmt.Errorf("getting namespace data: %w", availability.ErrOutsideSamplingWindow))
+	service.shareGetter = unavailable
+
+	subCh, err = service.SubscribeFrom(ctx, blobs[0].Namespace(), &SubscribeOptions{FromHeight: 1})
+	require.NoError(t, err)
+	select {
+	case _, ok := <-subCh:
+		assert.False(t, ok, "expected subscription channel to be closed")
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for subscription channel to close")
+	}
+}
+
+func TestService_SubscribeAll(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{3, 5, 2, 6}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobs[i].Namespace().IsLessThan(blobs[j].Namespace())
+	})
+	shares, err := BlobsToShares(blobs...)
+	require.NoError(t, err)
+
+	service := createService(ctx, t, shares)
+	service.WithHeadGetter(func(ctx context.Context) (*header.ExtendedHeader, error) {
+		return service.headerGetter(ctx, 1)
+	})
+	require.NoError(t, service.Start(ctx))
+	t.Cleanup(func() { _ = service.Stop(ctx) })
+
+	absent, err := libshare.NewV0Namespace([]byte("absent"))
+	require.NoError(t, err)
+	namespaces := []libshare.Namespace{
+		blobs[3].Namespace(),
+		absent,
+		blobs[0].Namespace(),
+		blobs[2].Namespace(),
+		blobs[0].Namespace(),
+	}
+
+	subCh, err := service.SubscribeAll(ctx, namespaces, &SubscribeOptions{FromHeight: 1})
+	require.NoError(t, err)
+
+	select {
+	case resp := <-subCh:
+		assert.Equal(t, uint64(1), resp.Height)
+		assert.False(t, resp.Empty)
+		// duplicated namespaces are ignored
+		require.Len(t, resp.BlobsByNamespace, 4)
+
+		expected := [][]*Blob{{blobs[3]}, {}, {blobs[0]}, {blobs[2]}}
+		for i, nsBlobs := range resp.BlobsByNamespace {
+			assert.Equal(t, namespaces[i], nsBlobs.Namespace)
+			require.Len(t, nsBlobs.Blobs, len(expected[i]))
+			for j, b := range nsBlobs.Blobs {
+				assert.Equal(t, expected[i][j].Commitment, b.Commitment)
+				assert.Equal(t, expected[i][j].Data(), b.Data())
+
+				// ensure the index matches the one of the single-namespace retrieval
+				single, err := service.Get(ctx, 1, b.Namespace(), b.Commitment)
+				require.NoError(t, err)
+				assert.Equal(t, single.Index(), b.Index())
+			}
+		}
+		assert.Len(t, resp.Blobs, 3)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for subscription response")
+	}
+
+	_, err = service.SubscribeAll(ctx, nil, nil)
+	require.Error(t, err)
+}
+
 func TestService_Subscribe_MultipleNamespaces(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	t.Cleanup(cancel)
@@ -872,6 +1102,127 @@ func BenchmarkGetByCommitment(b *testing.B) {
 	}
 }
 
+// TestService_GetAllLocalShares ensures blobs read from the local namespace shares are the same
+// as the ones retrieved through the namespace data with proofs.
+func TestService_GetAllLocalShares(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlob, err := libshare.GenerateV0Blobs([]int{9, 5, 15, 4, 24}, true)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlob...)
+	require.NoError(t, err)
+	padding, err := libshare.NamespacePaddingShare(blobs[0].Namespace(), libshare.ShareVersionZero)
+	require.NoError(t, err)
+
+	rawShares := make([]libshare.Share, 0) //nolint:prealloc
+	for _, blob := range blobs {
+		sh, err := BlobsToShares(blob)
+		require.NoError(t, err)
+		rawShares = append(rawShares, append(sh, padding)...)
+	}
+	// fill up the square
+	rawShares = append(rawShares, libshare.TailPaddingShare(), libshare.TailPaddingShare())
+	service := createService(ctx, t, rawShares)
+
+	expected, err := service.GetAll(ctx, 1, []libshare.Namespace{blobs[0].Namespace()})
+	require.NoError(t, err)
+	require.Len(t, expected, len(blobs))
+
+	stub := &namespaceSharesStub{shares: rawShares}
+	service.WithNamespaceSharesGetter(stub)
+	local, err := service.GetAll(ctx, 1, []libshare.Namespace{blobs[0].Namespace()})
+	require.NoError(t, err)
+	require.Equal(t, 1, stub.calls)
+	require.Equal(t, expected, local)
+
+	// unknown namespace is not found locally
+	local, err = service.GetAll(ctx, 1, []libshare.Namespace{libshare.RandomBlobNamespace()})
+	require.NoError(t, err)
+	require.Empty(t, local)
+
+	// falls back to the namespace data if local shares are not available
+	stub.err = errors.New("no index")
+	local, err = service.GetAll(ctx, 1, []libshare.Namespace{blobs[0].Namespace()})
+	require.NoError(t, err)
+	require.Equal(t, expected, local)
+}
+
+func TestService_GetNamespacesBlobs_RowsUnavailable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	libBlobs, err := libshare.GenerateV0Blobs([]int{4, 6}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(libBlobs...)
+	require.NoError(t, err)
+	slices.SortFunc(blobs, func(a, b *Blob) int {
+		return a.Namespace().Compare(b.Namespace())
+	})
+
+	rawShares, err := BlobsToShares(blobs...)
+	require.NoError(t, err)
+	for len(rawShares) < 16 {
+		rawShares = append(rawShares, libshare.TailPaddingShare())
+	}
+	square, err := rsmt2d.ComputeExtendedDataSquare(
+		libshare.ToBytes(rawShares),
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(4))
+	require.NoError(t, err)
+	accessor := &eds.Rsmt2D{ExtendedDataSquare: square}
+	h := headertest.ExtendedHeaderFromEDS(t, 1, square)
+
+	// only the namespace data is available, as with the pinned namespaces of a pruned square
+	ctrl := gomock.NewController(t)
+	shareGetter := mock.NewMockGetter(ctrl)
+	shareGetter.EXPECT().GetRow(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		Return(shwap.Row{}, shwap.ErrOperationNotSupported)
+	shareGetter.EXPECT().GetNamespaceData(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, _ *header.ExtendedHeader, ns libshare.Namespace) (shwap.NamespaceData, error) {
+			return eds.NamespaceData(ctx, accessor, ns)
+		})
+	headerGetter := func(context.Context, uint64) (*header.ExtendedHeader, error) { return h, nil }
+	service := NewService(nil, shareGetter, headerGetter, nil)
+
+	namespaces := []libshare.Namespace{blobs[0].Namespace(), blobs[1].Namespace()}
+	result, err := service.getNamespacesBlobs(ctx, h, namespaces)
+	require.NoError(t, err)
+	require.Len(t, result, len(namespaces))
+	for i, nsBlobs := range result {
+		require.Len(t, nsBlobs, 1)
+		require.Equal(t, blobs[i].Commitment, nsBlobs[0].Commitment)
+	}
+}
+
+type namespaceSharesStub struct {
+	shares []libshare.Share
+	err    error
+	calls  int
+}
+
+func (s *namespaceSharesStub) GetNamespaceShares(
+	_ context.Context,
+	_ *header.ExtendedHeader,
+	ns libshare.Namespace,
+) (int, []libshare.Share, error) {
+	if s.err != nil {
+		return 0, nil, s.err
+	}
+	s.calls++
+	from := slices.IndexFunc(s.shares, func(sh libshare.Share) bool {
+		return sh.Namespace().Equals(ns)
+	})
+	if from == -1 {
+		return 0, nil, nil
+	}
+	to := from
+	for to < len(s.shares) && s.shares[to].Namespace().Equals(ns) {
+		to++
+	}
+	return from, s.shares[from:to], nil
+}
+
 func createServiceWithSub(ctx context.Context, t testing.TB, blobs []*Blob) (*Service, []*header.ExtendedHeader) {
 	bs := ipld.NewMemBlockservice()
 	batching := ds_sync.MutexWrap(ds.NewMapDatastore())
@@ -942,6 +1293,11 @@ func createService(ctx context.Context, t testing.TB, shares []libshare.Share) *
 			smpl, err := accessor.Sample(ctx, indices[0])
 			return []shwap.Sample{smpl}, err
 		})
+	shareGetter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).AnyTimes().Return(square, nil)
+	shareGetter.EXPECT().GetRow(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, _ *header.ExtendedHeader, rowIdx int) (shwap.Row, error) {
+			return shwap.RowFromEDS(square, rowIdx, shwap.Left)
+		})
 
 	// create header and put it into the store
 	h := headertest.ExtendedHeaderFromEDS(t, 1, square)
@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"crypto/rand"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -14,20 +16,26 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	rpc "github.com/celestiaorg/celestia-node/api/rpc/client"
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/libs/keystore"
+	"github.com/celestiaorg/celestia-node/nodebuilder"
 	nodemod "github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
-var ttlFlagName = "ttl"
+var (
+	ttlFlagName   = "ttl"
+	labelFlagName = "label"
+)
 
 func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "auth [permission-level (e.g. read || write || admin)]",
 		Short: "Signs and outputs a hex-encoded JWT token with the given permissions.",
 		Long: "Signs and outputs a hex-encoded JWT token with the given permissions. NOTE: only use this command when " +
-			"the node has already been initialized and started.",
+			"the node has already been initialized and started. The token is recorded in the token registry of the " +
+			"node and its ID, which the token can be revoked by, is printed to stderr.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := ParseStoreDeterminationFlags(cmd, NodeType(cmd.Context()), args)
 			if err != nil {
@@ -46,6 +54,10 @@ func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			label, err := cmd.Flags().GetString(labelFlagName)
+			if err != nil {
+				return err
+			}
 
 			ks, err := newKeystore(StorePath(cmd.Context()))
 			if err != nil {
@@ -63,11 +75,13 @@ func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 				}
 			}
 
-			token, err := buildJWTToken(key.Body, permissions, ttl)
+			token, id, err := issueToken(cmd.Context(), StorePath(cmd.Context()), key.Body, label, permissions, ttl)
 			if err != nil {
 				return err
 			}
 			fmt.Printf("%s\n", token)
+			// the ID goes to stderr, so the output can still be used as the token as is
+			fmt.Fprintf(os.Stderr, "token ID: %s\n", id)
 			return nil
 		},
 	}
@@ -76,6 +90,7 @@ func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 		cmd.Flags().AddFlagSet(set)
 	}
 	cmd.Flags().Duration(ttlFlagName, 0, "Set a Time-to-live (TTL) for the token")
+	cmd.Flags().String(labelFlagName, "", "Set a label to record the token with in the token registry")
 
 	return cmd
 }
@@ -96,6 +111,97 @@ func buildJWTToken(body []byte, permissions []auth.Permission, ttl time.Duration
 	return authtoken.NewSignedJWT(signer, permissions, ttl)
 }
 
+// issueToken signs the token and records it in the token registry of the node, so it can be listed
+// and revoked. The store of a running node is locked, so such a node is asked to issue the token
+// over the RPC instead. It returns the token along with its ID.
+func issueToken(
+	ctx context.Context,
+	path string,
+	key []byte,
+	label string,
+	permissions []auth.Permission,
+	ttl time.Duration,
+) (string, string, error) {
+	path, err := homedir.Expand(filepath.Clean(path))
+	if err != nil {
+		return "", "", err
+	}
+
+	store, err := nodebuilder.OpenStore(path, nil)
+	switch {
+	case errors.Is(err, nodebuilder.ErrOpened):
+		token, err := issueTokenByNode(ctx, path, key, label, permissions, ttl)
+		if err != nil {
+			return "", "", fmt.Errorf("issuing token by the running node: %w", err)
+		}
+		verifier, err := jwt.NewVerifierHS(jwt.HS256, key)
+		if err != nil {
+			return "", "", err
+		}
+		p, err := authtoken.ExtractSignedPayload(verifier, token)
+		if err != nil {
+			return "", "", err
+		}
+		return token, p.ID, nil
+	case err != nil:
+		return "", "", err
+	}
+	defer store.Close()
+
+	ds, err := store.Datastore()
+	if err != nil {
+		return "", "", err
+	}
+	registry, err := authtoken.NewRegistry(ctx, ds)
+	if err != nil {
+		return "", "", err
+	}
+
+	signer, err := jwt.NewSignerHS(jwt.HS256, key)
+	if err != nil {
+		return "", "", err
+	}
+	p, err := perms.NewJWTPayload(permissions, nil, ttl)
+	if err != nil {
+		return "", "", err
+	}
+	token, err := authtoken.SignPayload(signer, p)
+	if err != nil {
+		return "", "", err
+	}
+	if err := registry.Register(ctx, p, label); err != nil {
+		return "", "", fmt.Errorf("registering token: %w", err)
+	}
+	return token, p.ID, nil
+}
+
+// issueTokenByNode asks the running node to issue the token, authorizing with a short-lived
+// admin token.
+func issueTokenByNode(
+	ctx context.Context,
+	path string,
+	key []byte,
+	label string,
+	permissions []auth.Permission,
+	ttl time.Duration,
+) (string, error) {
+	cfg, err := nodebuilder.LoadConfig(filepath.Join(path, "config.toml"))
+	if err != nil {
+		return "", err
+	}
+	adminToken, err := buildJWTToken(key, perms.AllPerms, time.Minute)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := rpc.NewClient(ctx, cfg.RPC.RequestURL(), adminToken)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+	return client.Node.AuthNewWithLabel(ctx, label, permissions, nil, ttl)
+}
+
 func generateNewKey(ks keystore.Keystore) (keystore.PrivKey, error) {
 	sk, err := io.ReadAll(io.LimitReader(rand.Reader, 32))
 	if err != nil {
@@ -17,6 +17,7 @@ func WithSubcommands() func(*cobra.Command, []*pflag.FlagSet) {
 			cmdnode.Start(cmdnode.WithFlagSet(flags)),
 			cmdnode.AuthCmd(flags...),
 			cmdnode.ResetStore(flags...),
+			cmdnode.CompressStore(flags...),
 			cmdnode.RemoveConfigCmd(flags...),
 			cmdnode.UpdateConfigCmd(flags...),
 		)
@@ -26,9 +27,11 @@ func WithSubcommands() func(*cobra.Command, []*pflag.FlagSet) {
 func init() {
 	bridgeCmd := cmdnode.NewBridge(WithSubcommands())
 	lightCmd := cmdnode.NewLight(WithSubcommands())
+	fullCmd := cmdnode.NewFull(WithSubcommands())
 	rootCmd.AddCommand(
 		bridgeCmd,
 		lightCmd,
+		fullCmd,
 		docgenCmd,
 		versionCmd,
 	)
@@ -47,7 +50,7 @@ func run() error {
 }
 
 var rootCmd = &cobra.Command{
-	Use: "celestia [  bridge  ||  light  ] [subcommand]",
+	Use: "celestia [  bridge  ||  full  ||  light  ] [subcommand]",
 	Short: `
 	    ____      __          __  _
 	  / ____/__  / /__  _____/ /_(_)___ _
@@ -3,10 +3,12 @@ package main
 import (
 	"github.com/celestiaorg/celestia-node/cmd"
 	blob "github.com/celestiaorg/celestia-node/nodebuilder/blob/cmd"
+	core "github.com/celestiaorg/celestia-node/nodebuilder/core/cmd"
 	das "github.com/celestiaorg/celestia-node/nodebuilder/das/cmd"
 	header "github.com/celestiaorg/celestia-node/nodebuilder/header/cmd"
 	node "github.com/celestiaorg/celestia-node/nodebuilder/node/cmd"
 	p2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p/cmd"
+	pruner "github.com/celestiaorg/celestia-node/nodebuilder/pruner/cmd"
 	share "github.com/celestiaorg/celestia-node/nodebuilder/share/cmd"
 	state "github.com/celestiaorg/celestia-node/nodebuilder/state/cmd"
 )
@@ -19,6 +21,8 @@ func init() {
 	share.Cmd.PersistentFlags().AddFlagSet(cmd.RPCFlags())
 	state.Cmd.PersistentFlags().AddFlagSet(cmd.RPCFlags())
 	node.Cmd.PersistentFlags().AddFlagSet(cmd.RPCFlags())
+	pruner.Cmd.PersistentFlags().AddFlagSet(cmd.RPCFlags())
+	core.Cmd.PersistentFlags().AddFlagSet(cmd.RPCFlags())
 
 	rootCmd.AddCommand(
 		blob.Cmd,
@@ -28,5 +32,7 @@ func init() {
 		share.Cmd,
 		state.Cmd,
 		node.Cmd,
+		pruner.Cmd,
+		core.Cmd,
 	)
 }
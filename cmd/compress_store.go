@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+)
+
+// CompressStore constructs a CLI command to convert the EDS files of Celestia Node
+// into the compressed format.
+func CompressStore(fsets ...*flag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compress-store",
+		Short: "Converts the stored EDS files into the compressed format. The node must be stopped.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := ParseStoreDeterminationFlags(cmd, NodeType(cmd.Context()), args)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+
+			return nodebuilder.CompressStore(ctx, StorePath(ctx), NodeType(ctx))
+		},
+	}
+	for _, set := range fsets {
+		cmd.Flags().AddFlagSet(set)
+	}
+	return cmd
+}
@@ -40,6 +40,33 @@ func NewBridge(options ...func(*cobra.Command, []*pflag.FlagSet)) *cobra.Command
 	return cmd
 }
 
+func NewFull(options ...func(*cobra.Command, []*pflag.FlagSet)) *cobra.Command {
+	flags := []*pflag.FlagSet{
+		NodeFlags(),
+		p2p.Flags(),
+		header.Flags(),
+		MiscFlags(),
+		core.Flags(),
+		rpc.Flags(),
+		state.Flags(),
+		pruner.Flags(),
+	}
+	cmd := &cobra.Command{
+		Use:   "full [subcommand]",
+		Args:  cobra.NoArgs,
+		Short: "Manage your Full node",
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := WithNodeType(cmd.Context(), node.Full)
+			cmd.SetContext(ctx)
+			return nil
+		},
+	}
+	for _, option := range options {
+		option(cmd, flags)
+	}
+	return cmd
+}
+
 func NewLight(options ...func(*cobra.Command, []*pflag.FlagSet)) *cobra.Command {
 	flags := []*pflag.FlagSet{
 		NodeFlags(),
@@ -0,0 +1,343 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"golang.org/x/time/rate"
+
+	"github.com/celestiaorg/celestia-app/v9/pkg/da"
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share/availability"
+	"github.com/celestiaorg/celestia-node/share/shwap/p2p/shrex/shrexsub"
+	"github.com/celestiaorg/celestia-node/store"
+)
+
+const (
+	// backfillCheckpointEvery is the amount of heights after which the backfill checkpoint is
+	// persisted mid-round.
+	backfillCheckpointEvery = 100
+	// backfillMaxAttempts is the amount of rounds a height fails to be backfilled in before it is
+	// given up on, so a block core can't serve doesn't pin the checkpoint forever.
+	backfillMaxAttempts = 5
+)
+
+var (
+	backfillPrefix        = datastore.NewKey("core_backfill")
+	backfillCheckpointKey = datastore.NewKey("checkpoint")
+)
+
+// backfillCheckpoint is the progress of the Backfiller.
+type backfillCheckpoint struct {
+	// Height is the height up to which every block the bridge is meant to keep is stored.
+	Height uint64 `json:"height"`
+	// Attempts is the amount of failed attempts to backfill each of the heights after Height.
+	Attempts map[uint64]int `json:"attempts,omitempty"`
+}
+
+// Backfiller fills the gaps in the block store from core. The Listener stores only the blocks
+// announced while the bridge is up, and the Exchange falls back to p2p when core misses a block,
+// storing only its header, so an outage leaves heights with a header but no block. The Backfiller
+// periodically scans the heights of the header store within the availability window, or all of
+// them in archival mode, and for every height missing from the block store it fetches the block
+// from core, checks it against the stored header, extends and stores it, and announces it over
+// shrexsub.
+//
+// Backfilling is resumable: the height up to which no gaps are left is checkpointed, and rate
+// limited, so it doesn't starve the Listener of the core endpoint. A height failing to be
+// backfilled in backfillMaxAttempts rounds is given up on and left as a gap.
+type Backfiller struct {
+	fetcher         blockGetter
+	headers         libhead.Store[*header.ExtendedHeader]
+	store           *store.Store
+	hashBroadcaster shrexsub.BroadcastFn
+	ds              datastore.Datastore
+
+	availabilityWindow time.Duration
+	archival           bool
+	interval           time.Duration
+	limiter            *rate.Limiter
+	retryPolicy        RetryPolicy
+
+	// checkpoint is only accessed by the backfilling routine once started.
+	checkpoint backfillCheckpoint
+
+	cancel context.CancelFunc
+	closed chan struct{}
+}
+
+// blockGetter fetches blocks by height.
+type blockGetter interface {
+	GetSignedBlock(ctx context.Context, height int64) (*SignedBlock, error)
+}
+
+func NewBackfiller(
+	fetcher blockGetter,
+	headers libhead.Store[*header.ExtendedHeader],
+	store *store.Store,
+	hashBroadcaster shrexsub.BroadcastFn,
+	ds datastore.Datastore,
+	opts ...Option,
+) (*Backfiller, error) {
+	p := defaultParams()
+	for _, opt := range opts {
+		opt(&p)
+	}
+	if p.backfillRate <= 0 {
+		return nil, fmt.Errorf("backfill: rate must be positive, got %f", p.backfillRate)
+	}
+	if p.backfillInterval <= 0 {
+		return nil, fmt.Errorf("backfill: interval must be positive, got %v", p.backfillInterval)
+	}
+	if err := p.retryPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("backfill: %w", err)
+	}
+
+	return &Backfiller{
+		fetcher:            fetcher,
+		headers:            headers,
+		store:              store,
+		hashBroadcaster:    hashBroadcaster,
+		ds:                 namespace.Wrap(ds, backfillPrefix),
+		availabilityWindow: p.availabilityWindow,
+		archival:           p.archival,
+		interval:           p.backfillInterval,
+		limiter:            rate.NewLimiter(rate.Limit(p.backfillRate), 1),
+		retryPolicy:        p.retryPolicy,
+	}, nil
+}
+
+// Start loads the checkpoint and kicks off the backfilling routine.
+func (b *Backfiller) Start(ctx context.Context) error {
+	if b.cancel != nil {
+		return fmt.Errorf("backfill: already started")
+	}
+
+	cp, err := b.loadCheckpoint(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill: loading checkpoint: %w", err)
+	}
+	b.checkpoint = cp
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.closed = make(chan struct{})
+	go b.run(ctx)
+	return nil
+}
+
+// Stop stops the backfilling routine and persists the checkpoint.
+func (b *Backfiller) Stop(ctx context.Context) error {
+	b.cancel()
+	select {
+	case <-b.closed:
+		b.cancel = nil
+		b.closed = nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return b.storeCheckpoint(ctx)
+}
+
+// run backfills right away and then every interval until ctx is canceled.
+func (b *Backfiller) run(ctx context.Context) {
+	defer close(b.closed)
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		if err := b.backfill(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Errorw("backfill: round failed", "err", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// backfill runs a single backfilling round over the heights after the checkpoint up to the head of
+// the header store. A height failing to be backfilled doesn't stop the round, but the checkpoint
+// is not moved past it, so the next round retries it, until it fails backfillMaxAttempts times.
+func (b *Backfiller) backfill(ctx context.Context) error {
+	head := b.headers.Height()
+	tail, err := b.headers.Tail(ctx)
+	if err != nil {
+		return fmt.Errorf("getting tail header: %w", err)
+	}
+	from := max(b.checkpoint.Height+1, tail.Height())
+	if !b.archival {
+		start, err := b.windowStart(ctx, from, head)
+		if err != nil {
+			return err
+		}
+		from = start
+	}
+	if from > head {
+		return nil
+	}
+	// the heights before are either outside the window or aren't known to the bridge
+	if b.checkpoint.Height < from-1 {
+		b.checkpoint.Height = from - 1
+		maps.DeleteFunc(b.checkpoint.Attempts, func(height uint64, _ int) bool { return height < from })
+	}
+
+	var backfilled, failed int
+	for height := from; height <= head; height++ {
+		ok, err := b.backfillHeight(ctx, height)
+		switch {
+		case errors.Is(err, context.Canceled):
+			return err
+		case err != nil:
+			if b.giveUp(height) {
+				log.Errorw("backfill: giving up on height", "height", height,
+					"attempts", backfillMaxAttempts, "err", err)
+				break
+			}
+			failed++
+			log.Warnw("backfill: failed to backfill height", "height", height, "err", err)
+		case ok:
+			backfilled++
+		}
+
+		if failed == 0 {
+			// the heights given up on are forgotten once the checkpoint moves past them
+			delete(b.checkpoint.Attempts, height)
+			b.checkpoint.Height = height
+			if height%backfillCheckpointEvery == 0 {
+				if err := b.storeCheckpoint(ctx); err != nil {
+					log.Warnw("backfill: storing checkpoint", "err", err)
+				}
+			}
+		}
+	}
+
+	if backfilled > 0 || failed > 0 {
+		log.Infow("backfill: round finished", "from", from, "to", head,
+			"backfilled", backfilled, "failed", failed)
+	}
+	return b.storeCheckpoint(ctx)
+}
+
+// giveUp accounts for a failed attempt to backfill the given height and reports whether it failed
+// backfillMaxAttempts times.
+func (b *Backfiller) giveUp(height uint64) bool {
+	if b.checkpoint.Attempts == nil {
+		b.checkpoint.Attempts = make(map[uint64]int)
+	}
+	b.checkpoint.Attempts[height]++
+	return b.checkpoint.Attempts[height] >= backfillMaxAttempts
+}
+
+// backfillHeight stores the block at the given height if it is missing. It reports whether the
+// block was backfilled.
+func (b *Backfiller) backfillHeight(ctx context.Context, height uint64) (bool, error) {
+	has, err := b.store.HasByHeight(ctx, height)
+	if err != nil {
+		return false, fmt.Errorf("checking the block store: %w", err)
+	}
+	if has {
+		return false, nil
+	}
+
+	eh, err := b.headers.GetByHeight(ctx, height)
+	if err != nil {
+		return false, fmt.Errorf("getting header: %w", err)
+	}
+	if !b.archival && !availability.IsWithinWindow(eh.Time(), b.availabilityWindow) {
+		return false, nil
+	}
+
+	if err := b.limiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	var blk *SignedBlock
+	err = b.retryPolicy.do(ctx, func(ctx context.Context, _ int) (err error) {
+		blk, err = b.fetcher.GetSignedBlock(ctx, int64(height))
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("fetching signed block from core: %w", err)
+	}
+	// the header store is the verified chain: a block not matching it is refused
+	if !bytes.Equal(blk.Header.Hash(), eh.Hash()) {
+		return false, fmt.Errorf("core served block %X not matching header %X", blk.Header.Hash(), eh.Hash())
+	}
+
+	eds, err := da.ConstructEDS(blk.Data.Txs.ToSliceOfBytes(), blk.Header.Version.App, -1)
+	if err != nil {
+		return false, fmt.Errorf("extending block data: %w", err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return false, fmt.Errorf("computing data availability header: %w", err)
+	}
+	if !bytes.Equal(dah.Hash(), eh.DataHash) {
+		return false, fmt.Errorf("block data hash %X not matching header data hash %X", dah.Hash(), eh.DataHash)
+	}
+
+	if err := storeEDS(ctx, eh, eds, b.store, b.availabilityWindow, b.archival); err != nil {
+		return false, fmt.Errorf("storing EDS: %w", err)
+	}
+
+	err = b.hashBroadcaster(ctx, shrexsub.Notification{
+		DataHash: eh.DataHash.Bytes(),
+		Height:   height,
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Warnw("backfill: broadcasting data hash", "height", height, "err", err)
+	}
+	log.Debugw("backfill: stored block", "height", height)
+	return true, nil
+}
+
+// windowStart finds the first height within [from, head] with its header within the availability
+// window. It returns head+1 if there is none.
+func (b *Backfiller) windowStart(ctx context.Context, from, head uint64) (uint64, error) {
+	lo, hi := from, head+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		eh, err := b.headers.GetByHeight(ctx, mid)
+		if err != nil {
+			return 0, fmt.Errorf("getting header at height %d: %w", mid, err)
+		}
+		if availability.IsWithinWindow(eh.Time(), b.availabilityWindow) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+func (b *Backfiller) loadCheckpoint(ctx context.Context) (backfillCheckpoint, error) {
+	bs, err := b.ds.Get(ctx, backfillCheckpointKey)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return backfillCheckpoint{}, nil
+	}
+	if err != nil {
+		return backfillCheckpoint{}, err
+	}
+
+	var cp backfillCheckpoint
+	err = json.Unmarshal(bs, &cp)
+	return cp, err
+}
+
+func (b *Backfiller) storeCheckpoint(ctx context.Context) error {
+	bs, err := json.Marshal(b.checkpoint)
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+	return b.ds.Put(ctx, backfillCheckpointKey, bs)
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share/shwap/p2p/shrex/shrexsub"
+	"github.com/celestiaorg/celestia-node/store"
+)
+
+func TestBackfiller(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	const (
+		numHeaders = 10
+		// heights 6 and above are within the window
+		window = 5*time.Hour + time.Minute
+	)
+	suite := headertest.NewTestSuite(t,
+		headertest.WithStartTime(time.Now().Add(-numHeaders*time.Hour)),
+		headertest.WithBlockTime(time.Hour),
+	)
+	headers := headertest.NewCustomStore(t, suite, numHeaders)
+
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+	// the Listener stored some of the blocks
+	for _, height := range []uint64{7, 9} {
+		eh, err := headers.GetByHeight(ctx, height)
+		require.NoError(t, err)
+		require.NoError(t, edsStore.PutODSQ4(ctx, eh.DAH, height, nil))
+	}
+
+	var (
+		lk        sync.Mutex
+		failing   = map[int64]bool{8: true}
+		fetched   []int64
+		announced []uint64
+	)
+	fetcher := &fakeSource{getFn: func(ctx context.Context, height int64) (*SignedBlock, error) {
+		lk.Lock()
+		defer lk.Unlock()
+		fetched = append(fetched, height)
+		if failing[height] {
+			return nil, errors.New("core is down")
+		}
+		return signedBlockOf(ctx, t, headers, height), nil
+	}}
+	broadcast := func(_ context.Context, n shrexsub.Notification) error {
+		lk.Lock()
+		defer lk.Unlock()
+		announced = append(announced, n.Height)
+		return nil
+	}
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	backfiller := newTestBackfiller(t, fetcher, headers, edsStore, broadcast, ds, WithAvailabilityWindow(window))
+	require.NoError(t, backfiller.backfill(ctx))
+
+	assert.Equal(t, []int64{6, 8, 10}, fetched, "only the missing heights within the window are fetched")
+	assert.Equal(t, []uint64{6, 10}, announced)
+	// the checkpoint doesn't move past the failed height
+	assert.EqualValues(t, 7, backfiller.checkpoint.Height)
+	for _, height := range []uint64{6, 10} {
+		has, err := edsStore.HasByHeight(ctx, height)
+		require.NoError(t, err)
+		assert.True(t, has)
+	}
+
+	// the failed height is retried by a backfiller resuming from the checkpoint
+	lk.Lock()
+	failing[8] = false
+	fetched = nil
+	lk.Unlock()
+	backfiller = newTestBackfiller(t, fetcher, headers, edsStore, broadcast, ds, WithAvailabilityWindow(window))
+	cp, err := backfiller.loadCheckpoint(ctx)
+	require.NoError(t, err)
+	backfiller.checkpoint = cp
+	require.NoError(t, backfiller.backfill(ctx))
+
+	assert.Equal(t, []int64{8}, fetched)
+	assert.EqualValues(t, numHeaders, backfiller.checkpoint.Height)
+}
+
+func TestBackfiller_GivesUpOnFailingHeight(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	const numHeaders = 5
+	headers := headertest.NewCustomStore(t, headertest.NewTestSuite(t), numHeaders)
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+
+	fetcher := &fakeSource{getFn: func(ctx context.Context, height int64) (*SignedBlock, error) {
+		if height == 3 {
+			return nil, errors.New("block pruned by core")
+		}
+		return signedBlockOf(ctx, t, headers, height), nil
+	}}
+	broadcast := func(context.Context, shrexsub.Notification) error { return nil }
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+
+	round := func() *Backfiller {
+		// every round resumes from the checkpoint, so the attempts survive restarts
+		backfiller := newTestBackfiller(t, fetcher, headers, edsStore, broadcast, ds, WithArchivalMode())
+		cp, err := backfiller.loadCheckpoint(ctx)
+		require.NoError(t, err)
+		backfiller.checkpoint = cp
+		require.NoError(t, backfiller.backfill(ctx))
+		return backfiller
+	}
+	for range backfillMaxAttempts - 1 {
+		assert.EqualValues(t, 2, round().checkpoint.Height)
+	}
+
+	backfiller := round()
+	assert.EqualValues(t, numHeaders, backfiller.checkpoint.Height)
+	assert.Empty(t, backfiller.checkpoint.Attempts)
+}
+
+func TestBackfiller_RetriesFetch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	headers := headertest.NewStore(t)
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+
+	var attempts atomic.Int32
+	fetcher := &fakeSource{getFn: func(ctx context.Context, height int64) (*SignedBlock, error) {
+		if attempts.Add(1) == 1 {
+			<-ctx.Done() // the first attempt hangs until it times out
+			return nil, ctx.Err()
+		}
+		return signedBlockOf(ctx, t, headers, height), nil
+	}}
+	policy := RetryPolicy{
+		AttemptTimeout: 50 * time.Millisecond,
+		MaxAttempts:    2,
+		Backoff:        time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+	backfiller := newTestBackfiller(t, fetcher, headers, edsStore, func(context.Context, shrexsub.Notification) error {
+		return nil
+	}, datastore.NewMapDatastore(), WithArchivalMode(), WithRetryPolicy(policy))
+
+	ok, err := backfiller.backfillHeight(ctx, 2)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestBackfiller_RefusesMismatchingBlock(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	headers := headertest.NewStore(t)
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+
+	fetcher := &fakeSource{getFn: func(ctx context.Context, height int64) (*SignedBlock, error) {
+		// core serves a block of another chain
+		return signedBlockOf(ctx, t, headers, height-1), nil
+	}}
+	backfiller := newTestBackfiller(t, fetcher, headers, edsStore, func(context.Context, shrexsub.Notification) error {
+		return nil
+	}, datastore.NewMapDatastore(), WithArchivalMode())
+
+	_, err = backfiller.backfillHeight(ctx, 2)
+	require.Error(t, err)
+	has, err := edsStore.HasByHeight(ctx, 2)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func newTestBackfiller(
+	t *testing.T,
+	fetcher blockGetter,
+	headers libhead.Store[*header.ExtendedHeader],
+	edsStore *store.Store,
+	broadcast shrexsub.BroadcastFn,
+	ds datastore.Datastore,
+	opts ...Option,
+) *Backfiller {
+	// a failed fetch is not retried unless the test asks for it
+	opts = append([]Option{WithRetryPolicy(RetryPolicy{AttemptTimeout: time.Second, MaxAttempts: 1})}, opts...)
+	opts = append(opts, WithBackfillRate(1000))
+	backfiller, err := NewBackfiller(fetcher, headers, edsStore, broadcast, ds, opts...)
+	require.NoError(t, err)
+	return backfiller
+}
+
+// signedBlockOf makes the block the stored header at the given height is made of.
+func signedBlockOf(
+	ctx context.Context,
+	t *testing.T,
+	headers libhead.Store[*header.ExtendedHeader],
+	height int64,
+) *SignedBlock {
+	eh, err := headers.GetByHeight(ctx, uint64(height))
+	require.NoError(t, err)
+	return &SignedBlock{Header: &eh.RawHeader, Commit: eh.Commit, Data: &types.Data{}, ValidatorSet: eh.ValidatorSet}
+}
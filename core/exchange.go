@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/types"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -23,8 +24,16 @@ const concurrencyLimit = 16
 
 var tracer = otel.Tracer("core")
 
+// exchangeFetcher is what the Exchange fetches blocks with: either a single BlockFetcher or a
+// MultiSource holding the blocks fetched by height to its quorum.
+type exchangeFetcher interface {
+	blockGetter
+	GetBlockByHash(ctx context.Context, hash libhead.Hash) (*types.Block, error)
+	GetBlockInfo(ctx context.Context, height int64) (*types.Commit, *types.ValidatorSet, error)
+}
+
 type Exchange struct {
-	fetcher   *BlockFetcher
+	fetcher   exchangeFetcher
 	store     *store.Store
 	construct header.ConstructFn
 
@@ -34,13 +43,14 @@ type Exchange struct {
 	// fallback for when core doesn't have the block - only get headers, not EDS
 	p2pExchange libhead.Exchange[*header.ExtendedHeader]
 
-	chainID string
+	chainID     string
+	retryPolicy RetryPolicy
 
 	metrics *exchangeMetrics
 }
 
 func NewExchange(
-	fetcher *BlockFetcher,
+	fetcher exchangeFetcher,
 	store *store.Store,
 	construct header.ConstructFn,
 	opts ...Option,
@@ -49,6 +59,9 @@ func NewExchange(
 	for _, opt := range opts {
 		opt(&p)
 	}
+	if err := p.retryPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("exchange: %w", err)
+	}
 
 	var (
 		metrics *exchangeMetrics
@@ -69,6 +82,7 @@ func NewExchange(
 		archival:           p.archival,
 		p2pExchange:        p.p2pExchange,
 		chainID:            p.chainID,
+		retryPolicy:        p.retryPolicy,
 		metrics:            metrics,
 	}, nil
 }
@@ -143,7 +157,11 @@ func (ce *Exchange) getRangeByHeight(ctx context.Context, from, amount uint64) (
 
 func (ce *Exchange) Get(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error) {
 	log.Debugw("requesting header", "hash", hash.String())
-	block, err := ce.fetcher.GetBlockByHash(ctx, hash)
+	var block *types.Block
+	err := ce.retryPolicy.do(ctx, func(ctx context.Context, _ int) (err error) {
+		block, err = ce.fetcher.GetBlockByHash(ctx, hash)
+		return err
+	})
 	if err != nil {
 		log.Debugw("failed to fetch block by hash from core, trying fallback", "hash", hash.String(), "error", err)
 		// Try fallback: only get header from P2P, DASer will download EDS later
@@ -155,7 +173,14 @@ func (ce *Exchange) Get(ctx context.Context, hash libhead.Hash) (*header.Extende
 
 	ce.verifyChainID(block.ChainID, block.Height, block.Hash())
 
-	comm, vals, err := ce.fetcher.GetBlockInfo(ctx, block.Height)
+	var (
+		comm *types.Commit
+		vals *types.ValidatorSet
+	)
+	err = ce.retryPolicy.do(ctx, func(ctx context.Context, _ int) (err error) {
+		comm, vals, err = ce.fetcher.GetBlockInfo(ctx, block.Height)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("fetching block info for height %d: %w", block.Height, err)
 	}
@@ -228,7 +253,11 @@ func (ce *Exchange) getExtendedHeaderByHeight(ctx context.Context, height int64)
 	}()
 	span.SetAttributes(attribute.Int64("height", height))
 
-	b, err := ce.fetcher.GetSignedBlock(ctx, height)
+	var b *SignedBlock
+	err = ce.retryPolicy.do(ctx, func(ctx context.Context, _ int) (err error) {
+		b, err = ce.fetcher.GetSignedBlock(ctx, height)
+		return err
+	})
 	if err != nil {
 		log.Debugw("failed to fetch signed block from core, trying fallback", "height", height, "error", err)
 		// Try fallback: only get header from P2P, DASer will download EDS later
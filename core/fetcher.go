@@ -37,6 +37,9 @@ type BlockEvent struct {
 	// "remove the bad source" actionable. A single BlockFetcher sets it too but
 	// ignores it on fetch, having only one source.
 	addr string
+	// tried are the sources the block already failed to be fetched from. A
+	// MultiSource passes them over when retrying the fetch.
+	tried []string
 }
 
 var (
@@ -120,8 +123,9 @@ func (f *BlockFetcher) GetSignedBlock(ctx context.Context, height int64) (*Signe
 // GetSignedBlockFrom fetches the block for the given event. A single
 // BlockFetcher has only one source, so the event's source hint is irrelevant
 // and it simply fetches by height.
-func (f *BlockFetcher) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, error) {
-	return f.GetSignedBlock(ctx, ev.Height)
+func (f *BlockFetcher) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, string, error) {
+	blk, err := f.GetSignedBlock(ctx, ev.Height)
+	return blk, f.addr, err
 }
 
 // Commit queries Core for a `Commit` from the block at
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/types"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -35,20 +36,21 @@ type Fetcher interface {
 	// instead of once per source.
 	SubscribeNewBlockEvent(ctx context.Context) (chan BlockEvent, error)
 	// GetSignedBlockFrom fetches the full signed block for the event from the
-	// source that announced it — the peer fastest to notify this height. It does
-	// NOT fall back to other sources on failure: an error is just an error.
-	// Resilience is a property of the fan-in — another source announces the same
-	// height, and the Listener retries the fetch from it (the failed attempt
-	// stored nothing, so the duplicate is a store-miss, not a skip).
-	GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, error)
+	// source that announced it — the peer fastest to notify this height — or, for
+	// a MultiSource, from a healthier source that announced it too. It returns the
+	// address of the source it fetched from, whether or not it succeeded. It makes
+	// a single attempt: the Listener retries a failed fetch according to its
+	// RetryPolicy, and a MultiSource falls over to the sources not tried yet for
+	// the event on every retry.
+	GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, string, error)
 	// ChainID returns the network/chain ID of the source.
 	ChainID(ctx context.Context) (string, error)
 	// IsSyncingFrom reports whether the source that announced the event is still
 	// catching up to the head. Sync state is per-source: the answer must come
-	// from the same peer that served the block, since another source being
+	// from the same peer that announced the block, since another source being
 	// caught up says nothing about whether THIS block is a fresh head or a
-	// replay of an old height from a peer mid-blocksync. Like
-	// GetSignedBlockFrom, it does not fall back to other sources on failure.
+	// replay of an old height from a peer mid-blocksync. It does not fall back
+	// to other sources on failure.
 	IsSyncingFrom(ctx context.Context, ev BlockEvent) (bool, error)
 }
 
@@ -59,19 +61,20 @@ type Fetcher interface {
 type blockSource interface {
 	SubscribeNewBlockEvent(ctx context.Context) (chan BlockEvent, error)
 	GetSignedBlock(ctx context.Context, height int64) (*SignedBlock, error)
+	GetBlockByHash(ctx context.Context, hash libhead.Hash) (*types.Block, error)
+	GetBlockInfo(ctx context.Context, height int64) (*types.Commit, *types.ValidatorSet, error)
+	Commit(ctx context.Context, height int64) (*types.Commit, error)
 	ChainID(ctx context.Context) (string, error)
 	IsSyncing(ctx context.Context) (bool, error)
 }
 
-// blockFetchTimeout bounds a single block fetch made while handling a height
-// announced by a source.
-// TODO(@vgonkivs): make timeout configurable
-const blockFetchTimeout = 10 * time.Second
-
 var (
 	_ Fetcher     = (*BlockFetcher)(nil)
 	_ Fetcher     = (*MultiSource)(nil)
 	_ blockSource = (*BlockFetcher)(nil)
+
+	_ exchangeFetcher = (*BlockFetcher)(nil)
+	_ exchangeFetcher = (*MultiSource)(nil)
 )
 
 // Listener is responsible for listening to Core for
@@ -96,6 +99,7 @@ type Listener struct {
 
 	chainID string
 
+	retryPolicy     RetryPolicy
 	listenerTimeout time.Duration
 	cancel          context.CancelFunc
 	closed          chan struct{}
@@ -114,6 +118,9 @@ func NewListener(
 	for _, opt := range opts {
 		opt(&p)
 	}
+	if err := p.retryPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("listener: %w", err)
+	}
 
 	var (
 		metrics *listenerMetrics
@@ -137,6 +144,7 @@ func NewListener(
 		listenerTimeout:    5 * blocktime,
 		metrics:            metrics,
 		chainID:            p.chainID,
+		retryPolicy:        p.retryPolicy,
 	}, nil
 }
 
@@ -242,11 +250,10 @@ func (cl *Listener) handleNewBlockEvent(ctx context.Context, ev BlockEvent) erro
 	}
 
 	// Fetch the full block on demand from the source that announced it first —
-	// the fastest peer to notify this height — so a MultiSource downloads it
-	// once rather than once per source.
-	fetchCtx, cancel := context.WithTimeout(ctx, blockFetchTimeout)
-	b, err := cl.fetcher.GetSignedBlockFrom(fetchCtx, ev)
-	cancel()
+	// the fastest peer to notify this height, unless a MultiSource knows a
+	// healthier one having it — so a MultiSource downloads it once rather than
+	// once per source.
+	b, err := cl.fetchBlock(ctx, ev)
 	if err != nil {
 		cl.metrics.blockEvent(ctx, ev.addr, "fetch_error")
 		return fmt.Errorf("fetching signed block at height %d: %w", ev.Height, err)
@@ -270,7 +277,7 @@ func (cl *Listener) handleNewBlockEvent(ctx context.Context, ev BlockEvent) erro
 	// or a catch-up replay (publish locally). Queried before the block is
 	// stored, so a failure here leaves the height unstored and the duplicate
 	// announcement from another source retries it whole.
-	syncCtx, cancel := context.WithTimeout(ctx, blockFetchTimeout)
+	syncCtx, cancel := context.WithTimeout(ctx, cl.retryPolicy.AttemptTimeout)
 	syncing, err := cl.fetcher.IsSyncingFrom(syncCtx, ev)
 	cancel()
 	if err != nil {
@@ -286,6 +293,33 @@ func (cl *Listener) handleNewBlockEvent(ctx context.Context, ev BlockEvent) erro
 	return nil
 }
 
+// fetchBlock fetches the block announced by the event following the RetryPolicy,
+// with a MultiSource falling over to the sources not tried yet for the event.
+func (cl *Listener) fetchBlock(ctx context.Context, ev BlockEvent) (*SignedBlock, error) {
+	var b *SignedBlock
+	err := cl.retryPolicy.do(ctx, func(fetchCtx context.Context, attempt int) error {
+		var (
+			source string
+			err    error
+		)
+		b, source, err = cl.fetcher.GetSignedBlockFrom(fetchCtx, ev)
+		if err != nil {
+			log.Debugw("listener: block fetch failed",
+				"height", ev.Height, "source", source, "attempt", attempt, "err", err)
+			if source != "" {
+				ev.tried = append(ev.tried, source)
+			}
+			return err
+		}
+		cl.metrics.blockServed(ctx, source, source == ev.addr, attempt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
 func (cl *Listener) handleNewSignedBlock(ctx context.Context, ev BlockEvent, b *SignedBlock, syncing bool) error {
 	var err error
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -34,7 +35,8 @@ func TestListener_DedupSkipsStoredHeight(t *testing.T) {
 
 	var fetched atomic.Bool
 	cl := &Listener{
-		store: st,
+		store:       st,
+		retryPolicy: DefaultRetryPolicy(),
 		fetcher: &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
 			fetched.Store(true)
 			return nil, errors.New("must not fetch an already-stored height")
@@ -74,7 +76,12 @@ func TestListener_ChainIDCheckedAfterDedup(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, st.PutODSQ4(ctx, roots, stored, eds))
 
-	cl := &Listener{store: st, fetcher: wrongChainSource("wrong-chain"), chainID: "expected-chain"}
+	cl := &Listener{
+		store:       st,
+		fetcher:     wrongChainSource("wrong-chain"),
+		chainID:     "expected-chain",
+		retryPolicy: DefaultRetryPolicy(),
+	}
 
 	t.Run("wrong chain on a stored height is deduped, no panic", func(t *testing.T) {
 		assert.NotPanics(t, func() {
@@ -120,7 +127,7 @@ func TestListener_HistoricBlockSkippedAfterFetch(t *testing.T) {
 	src := blockAtTime(time.Now().Add(-2 * window))
 	src.syncingErr = errors.New("sync state must not be queried for a historic block")
 
-	cl := &Listener{store: st, fetcher: src, availabilityWindow: window}
+	cl := &Listener{store: st, fetcher: src, availabilityWindow: window, retryPolicy: DefaultRetryPolicy()}
 
 	t.Run("historic block is dropped after fetch, before sync-status query", func(t *testing.T) {
 		err := cl.handleNewBlockEvent(ctx, BlockEvent{Height: 7})
@@ -134,7 +141,7 @@ func TestListener_HistoricBlockSkippedAfterFetch(t *testing.T) {
 	t.Run("within-window block passes the gate", func(t *testing.T) {
 		fresh := blockAtTime(time.Now())
 		fresh.syncingErr = errors.New("queried")
-		cl := &Listener{store: st, fetcher: fresh, availabilityWindow: window}
+		cl := &Listener{store: st, fetcher: fresh, availabilityWindow: window, retryPolicy: DefaultRetryPolicy()}
 
 		err := cl.handleNewBlockEvent(ctx, BlockEvent{Height: 8})
 		require.ErrorContains(t, err, "queried", "a fresh block must reach the sync-status query")
@@ -143,9 +150,78 @@ func TestListener_HistoricBlockSkippedAfterFetch(t *testing.T) {
 	t.Run("archival listener keeps historic blocks flowing", func(t *testing.T) {
 		archival := blockAtTime(time.Now().Add(-2 * window))
 		archival.syncingErr = errors.New("queried")
-		cl := &Listener{store: st, fetcher: archival, availabilityWindow: window, archival: true}
+		cl := &Listener{
+			store:              st,
+			fetcher:            archival,
+			availabilityWindow: window,
+			archival:           true,
+			retryPolicy:        DefaultRetryPolicy(),
+		}
 
 		err := cl.handleNewBlockEvent(ctx, BlockEvent{Height: 9})
 		require.ErrorContains(t, err, "queried", "an archival node must process historic blocks")
 	})
 }
+
+// TestListener_FetchRetries verifies a failed block fetch is retried according
+// to the RetryPolicy, falling over to another source of a MultiSource, and that
+// a block the sources disagree on is refused without retrying.
+func TestListener_FetchRetries(t *testing.T) {
+	ctx := t.Context()
+
+	var downCalls atomic.Int32
+	down := &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
+		downCalls.Add(1)
+		return nil, errors.New("down")
+	}}
+	up := &fakeSource{getFn: func(_ context.Context, h int64) (*SignedBlock, error) {
+		b := blockAt(h)
+		return &b, nil
+	}}
+	policy := RetryPolicy{
+		AttemptTimeout: time.Second,
+		MaxAttempts:    2,
+		Backoff:        time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+	ev := BlockEvent{Height: 5, addr: "down"}
+
+	t.Run("falls over to another source", func(t *testing.T) {
+		ms := newMultiSource(tagged("down", down), tagged("up", up))
+		cl := &Listener{fetcher: ms, retryPolicy: policy}
+
+		b, err := cl.fetchBlock(ctx, ev)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, b.Header.Height)
+		assert.EqualValues(t, 1, ms.Health()[1].Fetches)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		downCalls.Store(0)
+		policy := policy
+		policy.MaxAttempts = 3
+		cl := &Listener{fetcher: newMultiSource(tagged("down", down)), retryPolicy: policy}
+
+		_, err := cl.fetchBlock(ctx, ev)
+		require.ErrorContains(t, err, "down")
+		assert.EqualValues(t, 3, downCalls.Load())
+	})
+
+	t.Run("quorum mismatch is not retried", func(t *testing.T) {
+		inconsistent := &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
+			// the commit is for another block
+			header := &types.Header{Height: 5, ValidatorsHash: []byte("validators")}
+			return &SignedBlock{Header: header, Commit: &types.Commit{Height: 5}}, nil
+		}}
+		ms := newMultiSource(tagged("up", inconsistent), tagged("other", up))
+		ms.quorum = 2
+		cl := &Listener{fetcher: ms, retryPolicy: policy}
+
+		_, err := cl.fetchBlock(ctx, BlockEvent{Height: 5, addr: "up"})
+		var mismatchErr *QuorumMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		health := ms.Health()
+		assert.EqualValues(t, 0, health[0].Fetches)
+		assert.EqualValues(t, 1, health[1].Fetches)
+	})
+}
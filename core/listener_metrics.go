@@ -31,6 +31,7 @@ type listenerMetrics struct {
 	headerSubPublishDurationInst metric.Float64Histogram
 
 	blockEventsInst metric.Int64Counter
+	blockServedInst metric.Int64Counter
 }
 
 func newListenerMetrics() (*listenerMetrics, error) {
@@ -113,6 +114,17 @@ func newListenerMetrics() (*listenerMetrics, error) {
 		return nil, err
 	}
 
+	m.blockServedInst, err = meter.Int64Counter(
+		"core_blocks_served_total",
+		metric.WithDescription(
+			"blocks fetched from core sources, labeled by the `source` that finally served the block, "+
+				"whether it is the `announcer` of the block, and the amount of fetch `attempts` it took",
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return m, nil
 }
 
@@ -202,6 +214,18 @@ func (m *listenerMetrics) blockEvent(ctx context.Context, source, result string)
 	})
 }
 
+// blockServed records the source that finally served a block after the given
+// amount of fetch attempts, and whether it announced the block too.
+func (m *listenerMetrics) blockServed(ctx context.Context, source string, announcer bool, attempts int) {
+	m.observe(ctx, func(ctx context.Context) {
+		m.blockServedInst.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("source", source),
+			attribute.Bool("announcer", announcer),
+			attribute.Int("attempts", attempts),
+		))
+	})
+}
+
 func (m *listenerMetrics) Close() error {
 	if m == nil {
 		return nil
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/cometbft/cometbft/types"
 	"google.golang.org/grpc"
+
+	libhead "github.com/celestiaorg/go-header"
 )
 
 // taggedSource pairs a source endpoint with its address (conn.Target). It is the
@@ -21,13 +25,53 @@ type taggedSource struct {
 // resubscribes forever on its own), so a single failing endpoint cannot stall
 // the others. Duplicate heights across sources are expected and must be deduplicated by
 // the consumer.
+//
+// The set of sources is not fixed: sources pruned by Verify are re-verified
+// periodically and re-admitted once they confirm the expected network, and
+// sources can be added or removed at runtime via AddSource/RemoveSource.
 type MultiSource struct {
+	// mu guards the source sets, their health and the fan-in state below.
+	mu sync.RWMutex
 	// sources keyed by addr (conn.Target). The announcing source's addr travels
 	// on every BlockEvent, so GetSignedBlockFrom/IsSyncingFrom resolve back to it
 	// by addr — no indices that pruning in Verify would shift. Addr is a reliable
 	// id: one addr is one core endpoint, and duplicate endpoints are rejected at
-	// config validation, so the map never silently collapses distinct sources.
-	sources map[string]blockSource
+	// config validation and by AddSource, so the map never silently collapses
+	// distinct sources.
+	sources map[string]*source
+	// pruned are the sources that failed verification, keyed by addr. They are
+	// re-verified every reverifyInterval and moved back to sources once they
+	// confirm the expected network.
+	pruned map[string]*source
+	// chainID is the expected chain ID set by Verify. Sources are re-verified and
+	// admitted against it.
+	chainID string
+	// quorum is the amount of sources that must commit to the same block for it to be handed
+	// out. Quorum of 0 or 1 trusts the announcing source alone.
+	quorum int
+
+	// firstSeen keeps when each recent height was first announced by any source,
+	// the baseline every source's announce lag is measured against.
+	firstSeen map[int64]time.Time
+
+	// fan-in state, set once SubscribeNewBlockEvent is called.
+	out    chan BlockEvent
+	subCtx context.Context
+	// subscribed is the amount of running source subscriptions. out is closed
+	// once it drops to zero, after which no new subscriptions are started.
+	subscribed int
+	closed     bool
+
+	metrics *multiSourceMetrics
+}
+
+// source is a single source of the MultiSource along with its health.
+type source struct {
+	src    blockSource
+	health SourceHealth
+	// cancel stops the source's subscription. It is nil until the source is
+	// subscribed.
+	cancel context.CancelFunc
 }
 
 // NewMultiSource builds a MultiSource over the given gRPC connections. With a
@@ -42,27 +86,31 @@ func NewMultiSource(grpcClients ...*grpc.ClientConn) *MultiSource {
 
 // newMultiSource is the internal constructor used by NewMultiSource and tests.
 func newMultiSource(sources ...taggedSource) *MultiSource {
-	byAddr := make(map[string]blockSource, len(sources))
+	byAddr := make(map[string]*source, len(sources))
 	for _, s := range sources {
-		byAddr[s.addr] = s.fetcher
+		byAddr[s.addr] = newSource(s)
+	}
+	return &MultiSource{
+		sources:   byAddr,
+		pruned:    make(map[string]*source),
+		firstSeen: make(map[int64]time.Time),
 	}
-	return &MultiSource{sources: byAddr}
+}
+
+func newSource(s taggedSource) *source {
+	return &source{src: s.fetcher, health: SourceHealth{Addr: s.addr, Active: true}}
 }
 
 // Verify checks every source against the expected network and keeps only those
 // that confirmed the expected chain ID. Both wrong-chain AND unreachable
 // sources are pruned (logged with their address): sources are operator-curated
-// endpoints, so an endpoint that cannot vouch for its network at startup has no
-// business in the active set — letting it join later would defer a wrong-chain
-// failure to a mid-run panic instead of a startup log. The operator restores a
-// pruned endpoint by fixing it and restarting. It errors if no source could be
+// endpoints, so an endpoint that cannot vouch for its network has no business
+// in the active set. Pruned sources are not forgotten though: they are
+// re-verified every reverifyInterval once subscribed and re-admitted as soon
+// as they confirm the expected network, so an endpoint that was merely down at
+// startup rejoins without a restart. It errors if no source could be
 // confirmed, so a fully misconfigured or unreachable set refuses to start. The
 // expected chain ID must be set: a node must know which network it serves.
-//
-// Verify mutates m.sources and is safe only because Listener.Start calls it
-// synchronously before SubscribeNewBlockEvent spawns goroutines and before
-// ChainID/IsSyncingFrom run. Pruning is keyed by addr, so it no longer matters
-// that indices would shift — but concurrent mutation would still race the reads.
 func (m *MultiSource) Verify(ctx context.Context, expected string) error {
 	if expected == "" {
 		return fmt.Errorf("multisource: expected chain ID must be configured")
@@ -72,15 +120,17 @@ func (m *MultiSource) Verify(ctx context.Context, expected string) error {
 	// result slot (a map can't be range-indexed for that). Query every source's
 	// chain ID concurrently so a slow or unreachable endpoint doesn't serialize
 	// startup behind it: total latency is the slowest source, not the sum. The wg
-	// barrier alone orders the writes before the reads below — no mutex.
+	// barrier alone orders the writes before the reads below.
 	type entry struct {
 		addr string
-		src  blockSource
+		src  *source
 	}
+	m.mu.RLock()
 	entries := make([]entry, 0, len(m.sources))
 	for addr, src := range m.sources {
 		entries = append(entries, entry{addr: addr, src: src})
 	}
+	m.mu.RUnlock()
 
 	ids := make([]string, len(entries))
 	errs := make([]error, len(entries))
@@ -89,60 +139,100 @@ func (m *MultiSource) Verify(ctx context.Context, expected string) error {
 		wg.Add(1)
 		go func(i int, e entry) {
 			defer wg.Done()
-			ids[i], errs[i] = e.src.ChainID(ctx)
+			ids[i], errs[i] = e.src.src.ChainID(ctx)
 		}(i, e)
 	}
 	wg.Wait()
 
-	// Assemble the surviving set sequentially: free of shared mutation now that
-	// all goroutines have returned.
-	kept := make(map[string]blockSource, len(entries))
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chainID = expected
 	for i, e := range entries {
-		switch {
-		case errs[i] != nil:
-			log.Errorw("multisource: dropping unverifiable source",
-				"source", e.addr, "err", errs[i])
-		case ids[i] != expected:
-			log.Errorw("multisource: dropping endpoint on wrong network",
-				"source", e.addr, "expected", expected, "received", ids[i])
-		default:
-			kept[e.addr] = e.src
+		if err := verifyChainID(ids[i], errs[i], expected); err != nil {
+			log.Errorw("multisource: pruning unverified source", "source", e.addr, "err", err)
+			m.pruneLocked(e.addr, e.src, err)
 		}
 	}
-	m.sources = kept
-	if len(kept) == 0 {
+	if len(m.sources) == 0 {
 		return fmt.Errorf("multisource: no source confirmed on expected network %q", expected)
 	}
+	if len(m.sources) < m.quorum {
+		return fmt.Errorf("multisource: %d sources confirmed on expected network %q, fewer than quorum of %d",
+			len(m.sources), expected, m.quorum)
+	}
 	return nil
 }
 
+// verifyChainID checks the chain ID result reported by a source against the expected one.
+func verifyChainID(id string, err error, expected string) error {
+	switch {
+	case err != nil:
+		return fmt.Errorf("unverifiable: %w", err)
+	case id != expected:
+		return fmt.Errorf("on wrong network: expected %q, received %q", expected, id)
+	default:
+		return nil
+	}
+}
+
 // SubscribeNewBlockEvent fans every source's subscription into one channel,
 // closed once all source goroutines exit (i.e. ctx is canceled). It forwards
 // BlockEvents, not full blocks: each event is tagged with its source's addr so
-// the consumer fetches the block once from the announcing peer via
-// GetSignedBlockFrom instead of every source downloading it independently.
+// the consumer fetches the block once via GetSignedBlockFrom instead of every
+// source downloading it independently. Sources admitted later, either
+// re-verified or added at runtime, join the same channel.
 func (m *MultiSource) SubscribeNewBlockEvent(ctx context.Context) (chan BlockEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.out != nil && !m.closed {
+		return nil, errors.New("multisource: already subscribed")
+	}
+
 	// One buffer slot per source: each can deposit an event without blocking the
 	// others, beyond which the ctx-guarded send applies backpressure.
-	out := make(chan BlockEvent, len(m.sources))
-
-	var wg sync.WaitGroup
+	m.out = make(chan BlockEvent, len(m.sources))
+	m.subCtx = ctx
+	m.closed = false
 	for addr, src := range m.sources {
-		wg.Add(1)
-		go func(addr string, s blockSource) {
-			defer wg.Done()
-			m.subscribe(ctx, addr, s, out)
-		}(addr, src)
+		m.subscribeLocked(addr, src)
+	}
+	if m.subscribed == 0 {
+		m.closed = true
+		close(m.out)
+		return m.out, nil
 	}
 
-	// Close the fan-in channel only after every source goroutine has stopped, so
-	// the consumer can detect end-of-stream and we never send on a closed channel.
-	go func() {
-		wg.Wait()
-		close(out)
-	}()
+	go m.reverify(ctx)
+	return m.out, nil
+}
+
+// subscribeLocked starts the subscription of the given source, unless the
+// fan-in has not been started yet or is already closed.
+func (m *MultiSource) subscribeLocked(addr string, src *source) {
+	if m.out == nil || m.closed {
+		return
+	}
 
-	return out, nil
+	ctx, cancel := context.WithCancel(m.subCtx)
+	src.cancel = cancel
+	m.subscribed++
+	go func(out chan<- BlockEvent) {
+		defer m.unsubscribed()
+		m.subscribe(ctx, addr, src.src, out)
+	}(m.out)
+}
+
+// unsubscribed accounts for a stopped source subscription. Closing the fan-in
+// channel only after every source goroutine has stopped lets the consumer
+// detect end-of-stream, and we never send on a closed channel.
+func (m *MultiSource) unsubscribed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribed--
+	if m.subscribed == 0 && !m.closed {
+		m.closed = true
+		close(m.out)
+	}
 }
 
 // subscribe subscribes to a single source and forwards its heights into out,
@@ -165,6 +255,7 @@ func (m *MultiSource) subscribe(ctx context.Context, addr string, src blockSourc
 				log.Debugw("multisource: source subscription closed", "source", addr)
 				return
 			}
+			m.recordAnnounce(addr, ev.Height)
 			select {
 			case out <- BlockEvent{Height: ev.Height, addr: addr}:
 			case <-ctx.Done():
@@ -174,23 +265,85 @@ func (m *MultiSource) subscribe(ctx context.Context, addr string, src blockSourc
 	}
 }
 
-// GetSignedBlockFrom fetches the block for the event from the single source
-// that announced it — the fastest peer to notify this height, a fresh signal
-// it's the most responsive peer. It does ONE thing and does not fall back to
-// other sources: an error is returned as-is. Resilience is the fan-in's job —
-// the same height is announced by other sources, and since a failed fetch
-// stores nothing, the Listener re-fetches it from whichever source's duplicate
-// event arrives next.
-func (m *MultiSource) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, error) {
-	src, ok := m.sources[ev.addr]
-	if !ok {
-		return nil, fmt.Errorf("multisource: unknown source %q for height %d", ev.addr, ev.Height)
+// GetSignedBlockFrom fetches the block for the event from the healthiest
+// source known to have it: the announcing source or any other source that
+// already announced the height, ranked by health (see SourceHealth). The
+// announcer — the fastest peer to notify this height — wins ties. The sources
+// the event was already tried with are passed over, falling over to the rest:
+// first to the ones that announced the height, then to the ones yet to announce
+// it, as they may have the block regardless. Once every source is tried, they
+// are all candidates again. A single attempt is made; its result is recorded
+// against the chosen source's health and returned along with its address.
+//
+// In quorum mode, the block is handed out only once enough of the other
+// sources commit to its hash; only their commits are fetched, not the blocks.
+// A source disagreeing fails the fetch with QuorumMismatchError naming it, and
+// too few sources responding fails it with ErrNoQuorum — the latter typically
+// because the others lag behind, so their own announcements retry the height.
+// Mismatches are logged and counted here, once for all the consumers.
+func (m *MultiSource) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, string, error) {
+	addr, src, err := m.fetchSource(ev)
+	if err != nil {
+		return nil, "", err
 	}
-	blk, err := src.GetSignedBlock(ctx, ev.Height)
+	blk, err := src.src.GetSignedBlock(ctx, ev.Height)
+	m.recordFetch(src, err)
 	if err != nil {
-		return nil, fmt.Errorf("multisource: source %s: %w", ev.addr, err)
+		return nil, addr, fmt.Errorf("multisource: source %s: %w", addr, err)
 	}
-	return blk, nil
+	if m.quorum > 1 {
+		// confirm the height the block is at rather than the requested one, which is 0 for the latest
+		if err := m.confirmQuorum(ctx, blk.Header.Height, addr, blk); err != nil {
+			var mismatchErr *QuorumMismatchError
+			if errors.As(err, &mismatchErr) {
+				log.Errorw("multisource: core sources disagree on the block, refusing it",
+					"height", mismatchErr.Height, "source", mismatchErr.Source, "mismatching", mismatchErr.Mismatching)
+				m.metrics.quorumMismatch(ctx, mismatchErr.Mismatching)
+			}
+			return nil, addr, err
+		}
+	}
+	return blk, addr, nil
+}
+
+// GetSignedBlock fetches the block at the given height, or the latest one for
+// height 0, from the healthiest source, preferring the ones that announced the
+// height. It is the fetch path for the consumers that sync heights on their
+// own rather than follow announcements, e.g. the Exchange and the Backfiller,
+// and it is held to the quorum the same way as GetSignedBlockFrom.
+func (m *MultiSource) GetSignedBlock(ctx context.Context, height int64) (*SignedBlock, error) {
+	blk, _, err := m.GetSignedBlockFrom(ctx, BlockEvent{Height: height})
+	return blk, err
+}
+
+// GetBlockByHash fetches the block with the given hash from the first
+// responsive source. The hash pins the block, so a single source can't serve
+// a different one and no quorum is needed.
+func (m *MultiSource) GetBlockByHash(ctx context.Context, hash libhead.Hash) (*types.Block, error) {
+	var errs error
+	for addr, src := range m.activeSources() {
+		blk, err := src.GetBlockByHash(ctx, hash)
+		if err == nil {
+			return blk, nil
+		}
+		errs = errors.Join(errs, fmt.Errorf("%s: %w", addr, err))
+	}
+	return nil, fmt.Errorf("multisource: no source returned block %s: %w", hash, errs)
+}
+
+// GetBlockInfo fetches the commit and the validator set at the given height
+// from the first responsive source. It is used along with GetBlockByHash, whose
+// caller checks the commit is for the block it asked for.
+func (m *MultiSource) GetBlockInfo(ctx context.Context, height int64) (*types.Commit, *types.ValidatorSet, error) {
+	var errs error
+	for addr, src := range m.activeSources() {
+		commit, vals, err := src.GetBlockInfo(ctx, height)
+		if err == nil {
+			return commit, vals, nil
+		}
+		errs = errors.Join(errs, fmt.Errorf("%s: %w", addr, err))
+	}
+	return nil, nil, fmt.Errorf("multisource: no source returned block info at height %d: %w", height, errs)
 }
 
 // ChainID returns the chain ID from the first responsive source. All sources
@@ -198,7 +351,7 @@ func (m *MultiSource) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*S
 // against the expected chain ID.
 func (m *MultiSource) ChainID(ctx context.Context) (string, error) {
 	var errs error
-	for addr, src := range m.sources {
+	for addr, src := range m.activeSources() {
 		id, err := src.ChainID(ctx)
 		if err == nil {
 			return id, nil
@@ -209,20 +362,38 @@ func (m *MultiSource) ChainID(ctx context.Context) (string, error) {
 }
 
 // IsSyncingFrom reports whether the source that announced the event is still
-// catching up. Sync state is per-source: the same peer that announced and
-// served the block answers whether it is a fresh head or a catch-up replay —
-// another source being caught up says nothing about this block. Like
-// GetSignedBlockFrom, it does not fall back to other sources: the Listener
-// calls it before storing the height, so on error the duplicate announcement
-// from another source retries the height whole.
+// catching up. Sync state is per-source: the peer that announced the height
+// answers whether it is a fresh head or a catch-up replay — another source
+// being caught up says nothing about this announcement. The answer is also
+// recorded in the source's health, demoting it for fetches while it syncs.
+// It does not fall back to other sources: the Listener calls it before
+// storing the height, so on error the duplicate announcement from another
+// source retries the height whole.
 func (m *MultiSource) IsSyncingFrom(ctx context.Context, ev BlockEvent) (bool, error) {
+	m.mu.RLock()
 	src, ok := m.sources[ev.addr]
+	m.mu.RUnlock()
 	if !ok {
 		return false, fmt.Errorf("multisource: unknown source %q for height %d", ev.addr, ev.Height)
 	}
-	syncing, err := src.IsSyncing(ctx)
+	syncing, err := src.src.IsSyncing(ctx)
 	if err != nil {
 		return false, fmt.Errorf("multisource: source %s: %w", ev.addr, err)
 	}
+
+	m.mu.Lock()
+	src.health.Syncing = syncing
+	m.mu.Unlock()
 	return syncing, nil
 }
+
+// activeSources returns a snapshot of the active sources keyed by addr.
+func (m *MultiSource) activeSources() map[string]blockSource {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	sources := make(map[string]blockSource, len(m.sources))
+	for addr, src := range m.sources {
+		sources[addr] = src.src
+	}
+	return sources
+}
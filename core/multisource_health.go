@@ -0,0 +1,321 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// announceWindow is the amount of the most recent heights the first announcement time is
+	// kept for. Announcements of older heights don't count towards the announce lag.
+	announceWindow = 64
+	// lagWeight is the weight of the latest announce lag in the moving average of a source.
+	lagWeight = 0.2
+	// reverifyTimeout bounds re-verifying a single pruned source.
+	reverifyTimeout = 10 * time.Second
+)
+
+// reverifyInterval is how often the pruned sources are re-verified. It is a var for testing.
+var reverifyInterval = time.Minute
+
+// ErrUnknownSource is returned when no source is known under the given address.
+var ErrUnknownSource = errors.New("multisource: unknown source")
+
+// SourceHealth is the health of a single core endpoint of the MultiSource.
+// When fetching a block, the sources known to have it are ranked by their
+// health: the ones that aren't syncing first, then the ones with fewer
+// consecutive fetch failures, then the ones announcing new blocks faster.
+type SourceHealth struct {
+	Addr string `json:"addr"`
+	// Active is false if the source is pruned for failing verification. Pruned
+	// sources are re-verified periodically.
+	Active bool `json:"active"`
+	// Error is the reason the source is pruned for.
+	Error string `json:"error,omitempty"`
+	// LastAnnouncedHeight is the highest height announced by the source.
+	LastAnnouncedHeight int64 `json:"last_announced_height"`
+	// AnnounceLag is the moving average of how late the source announces heights
+	// compared to the first source announcing them.
+	AnnounceLag time.Duration `json:"announce_lag"`
+	// Fetches is the amount of blocks fetched from the source, failures included.
+	Fetches uint64 `json:"fetches"`
+	// FetchFailures is the amount of failed block fetches.
+	FetchFailures uint64 `json:"fetch_failures"`
+	// ConsecutiveFailures is the amount of block fetches failed in a row.
+	ConsecutiveFailures uint64 `json:"consecutive_failures"`
+	// Syncing is whether the source reported catching up to the head the last
+	// time it was asked.
+	Syncing bool `json:"syncing"`
+}
+
+// healthierThan reports whether h is preferred over other for fetching a block.
+func (h SourceHealth) healthierThan(other SourceHealth) bool {
+	if h.Syncing != other.Syncing {
+		return !h.Syncing
+	}
+	if h.ConsecutiveFailures != other.ConsecutiveFailures {
+		return h.ConsecutiveFailures < other.ConsecutiveFailures
+	}
+	return h.AnnounceLag < other.AnnounceLag
+}
+
+// Health returns the health of every known source, pruned ones included, sorted by address.
+func (m *MultiSource) Health() []SourceHealth {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	health := make([]SourceHealth, 0, len(m.sources)+len(m.pruned))
+	for _, src := range m.sources {
+		health = append(health, src.health)
+	}
+	for _, src := range m.pruned {
+		health = append(health, src.health)
+	}
+	slices.SortFunc(health, func(a, b SourceHealth) int {
+		return strings.Compare(a.Addr, b.Addr)
+	})
+	return health
+}
+
+// AddSource verifies the core endpoint behind the given connection is on the
+// expected network and admits it, subscribing to it if the MultiSource is
+// already subscribed. The connection stays owned by the caller.
+func (m *MultiSource) AddSource(ctx context.Context, conn *grpc.ClientConn) error {
+	return m.addSource(ctx, taggedSource{fetcher: NewBlockFetcher(conn), addr: conn.Target()})
+}
+
+func (m *MultiSource) addSource(ctx context.Context, s taggedSource) error {
+	m.mu.RLock()
+	_, active := m.sources[s.addr]
+	_, pruned := m.pruned[s.addr]
+	expected := m.chainID
+	m.mu.RUnlock()
+	if active || pruned {
+		return fmt.Errorf("multisource: source %s already exists", s.addr)
+	}
+
+	if expected != "" {
+		id, err := s.fetcher.ChainID(ctx)
+		if err := verifyChainID(id, err, expected); err != nil {
+			return fmt.Errorf("multisource: source %s: %w", s.addr, err)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.sources[s.addr]; ok {
+		return fmt.Errorf("multisource: source %s already exists", s.addr)
+	}
+	src := newSource(s)
+	m.sources[s.addr] = src
+	m.subscribeLocked(s.addr, src)
+	log.Infow("multisource: added source", "source", s.addr)
+	return nil
+}
+
+// RemoveSource stops using the source under the given address, unsubscribing
+// from it. It refuses to remove the last active source or to leave fewer
+// active sources than the quorum.
+func (m *MultiSource) RemoveSource(addr string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.pruned[addr]; ok {
+		delete(m.pruned, addr)
+		log.Infow("multisource: removed source", "source", addr)
+		return nil
+	}
+	src, ok := m.sources[addr]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSource, addr)
+	}
+	if len(m.sources) == 1 {
+		return fmt.Errorf("multisource: can't remove the last active source %s", addr)
+	}
+	if len(m.sources)-1 < m.quorum {
+		return fmt.Errorf("multisource: removing source %s leaves fewer active sources than quorum of %d",
+			addr, m.quorum)
+	}
+
+	if src.cancel != nil {
+		src.cancel()
+	}
+	delete(m.sources, addr)
+	log.Infow("multisource: removed source", "source", addr)
+	return nil
+}
+
+// fetchSource picks the source to fetch the block announced by the event from.
+// An event not announced by any source, as made by GetSignedBlock, is fetched
+// like a retried one. See GetSignedBlockFrom.
+func (m *MultiSource) fetchSource(ev BlockEvent) (string, *source, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(ev.tried) > 0 || ev.addr == "" {
+		if addr, src := m.fallbackSourceLocked(ev); src != nil {
+			return addr, src, nil
+		}
+		if ev.addr == "" {
+			return "", nil, fmt.Errorf("multisource: no source for height %d", ev.Height)
+		}
+		// every source is tried already, so start over
+	}
+
+	addr := ev.addr
+	best, ok := m.sources[addr]
+	if !ok {
+		return "", nil, fmt.Errorf("multisource: unknown source %q for height %d", ev.addr, ev.Height)
+	}
+	for other, src := range m.sources {
+		if src.health.LastAnnouncedHeight < ev.Height {
+			continue
+		}
+		if src.health.healthierThan(best.health) {
+			addr, best = other, src
+		}
+	}
+	return addr, best, nil
+}
+
+// fallbackSourceLocked picks the healthiest source the event wasn't tried with
+// yet, preferring the ones that announced its height. The announcer wins ties.
+// It returns a nil source if every source is tried already.
+func (m *MultiSource) fallbackSourceLocked(ev BlockEvent) (string, *source) {
+	var (
+		addr          string
+		best          *source
+		bestAnnounced bool
+	)
+	for candidate, src := range m.sources {
+		if slices.Contains(ev.tried, candidate) {
+			continue
+		}
+		announced := src.health.LastAnnouncedHeight >= ev.Height
+		switch {
+		case best == nil,
+			announced && !bestAnnounced,
+			announced == bestAnnounced && src.health.healthierThan(best.health),
+			announced == bestAnnounced && candidate == ev.addr && !best.health.healthierThan(src.health):
+			addr, best, bestAnnounced = candidate, src, announced
+		}
+	}
+	return addr, best
+}
+
+// recordAnnounce accounts for the source announcing the height in its health.
+func (m *MultiSource) recordAnnounce(addr string, height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	src, ok := m.sources[addr]
+	if !ok || height <= src.health.LastAnnouncedHeight {
+		// removed in the meantime, or replaying heights it announced already
+		return
+	}
+
+	now := time.Now()
+	first, ok := m.firstSeen[height]
+	if !ok {
+		first = now
+		m.firstSeen[height] = now
+		for h := range m.firstSeen {
+			if h <= height-announceWindow {
+				delete(m.firstSeen, h)
+			}
+		}
+	}
+
+	lag := now.Sub(first)
+	if src.health.LastAnnouncedHeight == 0 {
+		src.health.AnnounceLag = lag
+	} else {
+		src.health.AnnounceLag = time.Duration((1-lagWeight)*float64(src.health.AnnounceLag) + lagWeight*float64(lag))
+	}
+	src.health.LastAnnouncedHeight = height
+}
+
+// recordFetch accounts for the result of fetching a block from the source in its health.
+func (m *MultiSource) recordFetch(src *source, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	src.health.Fetches++
+	if err != nil {
+		src.health.FetchFailures++
+		src.health.ConsecutiveFailures++
+		return
+	}
+	src.health.ConsecutiveFailures = 0
+}
+
+// pruneLocked moves the active source to the pruned ones for the given reason.
+func (m *MultiSource) pruneLocked(addr string, src *source, reason error) {
+	if src.cancel != nil {
+		src.cancel()
+		src.cancel = nil
+	}
+	src.health.Active = false
+	src.health.Error = reason.Error()
+	delete(m.sources, addr)
+	m.pruned[addr] = src
+}
+
+// reverify periodically re-verifies the pruned sources until ctx is canceled.
+func (m *MultiSource) reverify(ctx context.Context) {
+	ticker := time.NewTicker(reverifyInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.reverifyPruned(ctx)
+		}
+	}
+}
+
+// reverifyPruned checks every pruned source against the expected network,
+// re-admitting the ones that confirm it.
+func (m *MultiSource) reverifyPruned(ctx context.Context) {
+	m.mu.RLock()
+	pruned := make(map[string]*source, len(m.pruned))
+	for addr, src := range m.pruned {
+		pruned[addr] = src
+	}
+	expected := m.chainID
+	m.mu.RUnlock()
+
+	for addr, src := range pruned {
+		verifyCtx, cancel := context.WithTimeout(ctx, reverifyTimeout)
+		id, err := src.src.ChainID(verifyCtx)
+		cancel()
+		err = verifyChainID(id, err, expected)
+
+		m.mu.Lock()
+		if m.pruned[addr] != src {
+			// removed in the meantime
+			m.mu.Unlock()
+			continue
+		}
+		if err != nil {
+			src.health.Error = err.Error()
+			m.mu.Unlock()
+			log.Debugw("multisource: pruned source still unverified", "source", addr, "err", err)
+			continue
+		}
+		src.health.Active = true
+		src.health.Error = ""
+		delete(m.pruned, addr)
+		m.sources[addr] = src
+		m.subscribeLocked(addr, src)
+		m.mu.Unlock()
+		log.Infow("multisource: re-admitted source", "source", addr)
+	}
+}
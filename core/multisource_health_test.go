@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSource_ReadmitsPrunedSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	flakyCh := make(chan BlockEvent)
+	good := &fakeSource{chainID: "mocha-4", subFn: func(int) (chan BlockEvent, error) { return neverDelivers(), nil }}
+	flaky := &fakeSource{
+		chainIDErr: errors.New("unreachable"),
+		subFn:      func(int) (chan BlockEvent, error) { return flakyCh, nil },
+	}
+	ms := newMultiSource(tagged("good", good), tagged("flaky", flaky))
+	require.NoError(t, ms.Verify(ctx, "mocha-4"))
+	require.Len(t, ms.sources, 1)
+
+	health := ms.Health()
+	require.Len(t, health, 2)
+	assert.Equal(t, "flaky", health[0].Addr)
+	assert.False(t, health[0].Active)
+	assert.Contains(t, health[0].Error, "unreachable")
+
+	out, err := ms.SubscribeNewBlockEvent(ctx)
+	require.NoError(t, err)
+
+	// still down: stays pruned
+	ms.reverifyPruned(ctx)
+	require.Len(t, ms.sources, 1)
+
+	flaky.chainIDErr = nil
+	flaky.chainID = "mocha-4"
+	ms.reverifyPruned(ctx)
+	require.Len(t, ms.sources, 2)
+	assert.Empty(t, ms.pruned)
+
+	// the re-admitted source joins the fan-in
+	flakyCh <- BlockEvent{Height: 5}
+	ev, ok := recv(t, out)
+	require.True(t, ok)
+	assert.Equal(t, BlockEvent{Height: 5, addr: "flaky"}, ev)
+	assert.True(t, ms.Health()[0].Active)
+}
+
+func TestMultiSource_FetchPrefersHealthiest(t *testing.T) {
+	ctx := t.Context()
+
+	// every fetch fails with the error naming the source that served it
+	served := func(addr string) *fakeSource {
+		return &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
+			return nil, errors.New(addr)
+		}}
+	}
+	fetchedFrom := func(t *testing.T, ms *MultiSource, ev BlockEvent) string {
+		_, addr, err := ms.GetSignedBlockFrom(ctx, ev)
+		require.Error(t, err)
+		assert.Equal(t, addr, errors.Unwrap(err).Error())
+		return addr
+	}
+	ev := BlockEvent{Height: 10, addr: "announcer"}
+
+	t.Run("announcer wins ties", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", served("announcer")), tagged("other", served("other")))
+		ms.recordAnnounce("announcer", 10)
+		ms.recordAnnounce("other", 10)
+		ms.sources["other"].health.AnnounceLag = 0
+
+		assert.Equal(t, "announcer", fetchedFrom(t, ms, ev))
+	})
+
+	t.Run("failing announcer is passed over", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", served("announcer")), tagged("other", served("other")))
+		ms.recordAnnounce("announcer", 10)
+		ms.recordAnnounce("other", 10)
+
+		// the fetches keep failing, so the sources take turns
+		assert.Equal(t, "announcer", fetchedFrom(t, ms, ev))
+		assert.Equal(t, "other", fetchedFrom(t, ms, ev))
+		assert.Equal(t, "announcer", fetchedFrom(t, ms, ev))
+
+		health := ms.Health()
+		assert.EqualValues(t, 2, health[0].FetchFailures)
+		assert.EqualValues(t, 2, health[0].ConsecutiveFailures)
+		assert.EqualValues(t, 1, health[1].Fetches)
+	})
+
+	t.Run("source yet to announce the height is not used", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", served("announcer")), tagged("behind", served("behind")))
+		ms.recordAnnounce("announcer", 10)
+		ms.recordAnnounce("behind", 9)
+		ms.sources["announcer"].health.ConsecutiveFailures = 5
+
+		assert.Equal(t, "announcer", fetchedFrom(t, ms, ev))
+	})
+
+	t.Run("syncing source is passed over", func(t *testing.T) {
+		ms := newMultiSource(
+			tagged("announcer", &fakeSource{syncing: true, getFn: served("announcer").getFn}),
+			tagged("other", served("other")),
+		)
+		ms.recordAnnounce("announcer", 10)
+		ms.recordAnnounce("other", 10)
+		_, err := ms.IsSyncingFrom(ctx, ev)
+		require.NoError(t, err)
+
+		assert.Equal(t, "other", fetchedFrom(t, ms, ev))
+	})
+}
+
+func TestMultiSource_AddRemoveSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	subscribed := func(ch chan BlockEvent) func(int) (chan BlockEvent, error) {
+		return func(int) (chan BlockEvent, error) { return ch, nil }
+	}
+	first := &fakeSource{chainID: "mocha-4", subFn: subscribed(neverDelivers())}
+	ms := newMultiSource(tagged("first", first))
+	ms.quorum = 1
+	require.NoError(t, ms.Verify(ctx, "mocha-4"))
+	out, err := ms.SubscribeNewBlockEvent(ctx)
+	require.NoError(t, err)
+
+	err = ms.addSource(ctx, tagged("wrong", &fakeSource{chainID: "arabica-11"}))
+	require.Error(t, err)
+	err = ms.addSource(ctx, tagged("first", first))
+	require.Error(t, err)
+
+	addedCh := make(chan BlockEvent)
+	require.NoError(t, ms.addSource(ctx, tagged("added", &fakeSource{chainID: "mocha-4", subFn: subscribed(addedCh)})))
+	addedCh <- BlockEvent{Height: 3}
+	ev, ok := recv(t, out)
+	require.True(t, ok)
+	assert.Equal(t, BlockEvent{Height: 3, addr: "added"}, ev)
+
+	require.NoError(t, ms.RemoveSource("first"))
+	require.Error(t, ms.RemoveSource("added"), "the last active source must stay")
+	require.ErrorIs(t, ms.RemoveSource("first"), ErrUnknownSource)
+
+	health := ms.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, "added", health[0].Addr)
+	assert.EqualValues(t, 3, health[0].LastAnnouncedHeight)
+}
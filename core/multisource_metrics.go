@@ -0,0 +1,44 @@
+package core
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+)
+
+type multiSourceMetrics struct {
+	quorumMismatchInst metric.Int64Counter
+}
+
+// WithMetrics enables the metrics of the MultiSource. It must be called before the MultiSource
+// is used.
+func (m *MultiSource) WithMetrics() error {
+	quorumMismatchInst, err := meter.Int64Counter(
+		"core_quorum_mismatch_total",
+		metric.WithDescription(
+			"blocks refused because core sources disagreed with the serving one, labeled by the "+
+				"mismatching `source`",
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	m.metrics = &multiSourceMetrics{quorumMismatchInst: quorumMismatchInst}
+	return nil
+}
+
+// quorumMismatch records the source disagreeing with the serving one on a block.
+func (m *multiSourceMetrics) quorumMismatch(ctx context.Context, source string) {
+	if m == nil {
+		return
+	}
+
+	ctx = utils.ResetContextOnError(ctx)
+	m.quorumMismatchInst.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("source", source),
+	))
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc"
+)
+
+// ErrNoQuorum is returned when too few sources confirmed the block announced for a height.
+var ErrNoQuorum = errors.New("multisource: no quorum")
+
+// QuorumMismatchError is returned when sources disagree on the block at a height. The block is
+// refused regardless of how many sources agree with it: one of the sides is either compromised or
+// on a fork, and the bridge must not publish a header that only some of its sources vouch for.
+type QuorumMismatchError struct {
+	Height int64
+	// Source is the source that served the block.
+	Source string
+	// Mismatching is the source reporting a different block than the serving one. It is the
+	// serving source itself if its block doesn't match its own commit.
+	Mismatching string
+}
+
+func (e *QuorumMismatchError) Error() string {
+	return fmt.Sprintf("multisource: source %s disagrees with %s on the block at height %d",
+		e.Mismatching, e.Source, e.Height)
+}
+
+// NewQuorumMultiSource builds a MultiSource over the given gRPC connections that only hands out a
+// block once at least quorum of the sources, the announcing one included, commit to the same block
+// hash. See MultiSource.GetSignedBlockFrom.
+func NewQuorumMultiSource(quorum int, grpcClients ...*grpc.ClientConn) (*MultiSource, error) {
+	if quorum < 1 || quorum > len(grpcClients) {
+		return nil, fmt.Errorf("multisource: quorum %d must be within [1, %d]", quorum, len(grpcClients))
+	}
+
+	ms := NewMultiSource(grpcClients...)
+	ms.quorum = quorum
+	return ms, nil
+}
+
+// confirmQuorum checks that the other sources commit to the block the given source served.
+// It returns once quorum sources agree, failing right away if any of the sources disagrees.
+// The sources failing to respond, e.g. lagging behind the serving one, count as neither.
+func (m *MultiSource) confirmQuorum(ctx context.Context, height int64, served string, blk *SignedBlock) error {
+	hash := blk.Header.Hash()
+	if !bytes.Equal(blk.Commit.BlockID.Hash, hash) {
+		return &QuorumMismatchError{Height: height, Source: served, Mismatching: served}
+	}
+
+	agreed := 1 // the serving source
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type response struct {
+		addr   string
+		commit *types.Commit
+		err    error
+	}
+	sources := m.activeSources()
+	delete(sources, served)
+	responses := make(chan response, len(sources))
+	for addr, src := range sources {
+		go func(addr string, src blockSource) {
+			commit, err := src.Commit(ctx, height)
+			responses <- response{addr: addr, commit: commit, err: err}
+		}(addr, src)
+	}
+
+	var errs error
+	for range sources {
+		resp := <-responses
+		switch {
+		case resp.err != nil:
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", resp.addr, resp.err))
+		case !bytes.Equal(resp.commit.BlockID.Hash, hash):
+			return &QuorumMismatchError{Height: height, Source: served, Mismatching: resp.addr}
+		default:
+			agreed++
+			if agreed >= m.quorum {
+				return nil
+			}
+		}
+	}
+	err := fmt.Errorf("%w: %d of %d sources confirmed the block at height %d",
+		ErrNoQuorum, agreed, m.quorum, height)
+	return errors.Join(err, errs)
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/store"
+)
+
+func TestMultiSource_Quorum(t *testing.T) {
+	const height = 10
+	blk := &SignedBlock{Header: &types.Header{Height: height, ValidatorsHash: tmhash.Sum([]byte("vals"))}}
+	blk.Commit = &types.Commit{Height: height, BlockID: types.BlockID{Hash: blk.Header.Hash()}}
+	forked := &types.Commit{Height: height, BlockID: types.BlockID{Hash: tmhash.Sum([]byte("fork"))}}
+
+	announcer := &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) { return blk, nil }}
+	agreeing := func() *fakeSource {
+		return &fakeSource{commitFn: func(context.Context, int64) (*types.Commit, error) { return blk.Commit, nil }}
+	}
+	disagreeing := &fakeSource{commitFn: func(context.Context, int64) (*types.Commit, error) { return forked, nil }}
+	lagging := &fakeSource{commitFn: func(context.Context, int64) (*types.Commit, error) {
+		return nil, errors.New("height not available")
+	}}
+	ev := BlockEvent{Height: height, addr: "announcer"}
+
+	t.Run("quorum reached", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", announcer), tagged("a", agreeing()), tagged("b", lagging))
+		ms.quorum = 2
+
+		got, _, err := ms.GetSignedBlockFrom(t.Context(), ev)
+		require.NoError(t, err)
+		assert.Equal(t, blk, got)
+	})
+
+	t.Run("source disagrees", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", announcer), tagged("fork", disagreeing))
+		ms.quorum = 2
+		// the mismatch is counted by the MultiSource itself
+		require.NoError(t, ms.WithMetrics())
+
+		_, _, err := ms.GetSignedBlockFrom(t.Context(), ev)
+		var mismatchErr *QuorumMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		assert.Equal(t, "announcer", mismatchErr.Source)
+		assert.Equal(t, "fork", mismatchErr.Mismatching)
+	})
+
+	t.Run("too few sources respond", func(t *testing.T) {
+		ms := newMultiSource(tagged("announcer", announcer), tagged("a", agreeing()), tagged("b", lagging))
+		ms.quorum = 3
+
+		_, _, err := ms.GetSignedBlockFrom(t.Context(), ev)
+		require.ErrorIs(t, err, ErrNoQuorum)
+	})
+
+	t.Run("announcer serves a block not matching its commit", func(t *testing.T) {
+		inconsistent := &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
+			return &SignedBlock{Header: blk.Header, Commit: forked}, nil
+		}}
+		ms := newMultiSource(tagged("announcer", inconsistent), tagged("a", agreeing()))
+		ms.quorum = 2
+
+		_, _, err := ms.GetSignedBlockFrom(t.Context(), ev)
+		var mismatchErr *QuorumMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		assert.Equal(t, "announcer", mismatchErr.Mismatching)
+	})
+
+	t.Run("latest block confirmed at its height", func(t *testing.T) {
+		atHeight := &fakeSource{commitFn: func(_ context.Context, h int64) (*types.Commit, error) {
+			if h != height {
+				return forked, nil
+			}
+			return blk.Commit, nil
+		}}
+		ms := newMultiSource(tagged("announcer", announcer), tagged("a", atHeight))
+		ms.quorum = 2
+
+		got, err := ms.GetSignedBlock(t.Context(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, blk, got)
+	})
+
+	t.Run("verify keeps the quorum reachable", func(t *testing.T) {
+		ms := newMultiSource(
+			tagged("a", &fakeSource{chainID: "arabica-11"}),
+			tagged("b", &fakeSource{chainID: "mocha-4"}),
+		)
+		ms.quorum = 2
+
+		require.Error(t, ms.Verify(t.Context(), "arabica-11"))
+	})
+}
+
+// TestExchange_RefusesQuorumMismatch checks the Exchange syncing heights on its own doesn't get
+// around the quorum the Listener is held to.
+func TestExchange_RefusesQuorumMismatch(t *testing.T) {
+	const height = 10
+	blk := &SignedBlock{Header: &types.Header{Height: height, ValidatorsHash: tmhash.Sum([]byte("vals"))}}
+	blk.Commit = &types.Commit{Height: height, BlockID: types.BlockID{Hash: blk.Header.Hash()}}
+	forked := &types.Commit{Height: height, BlockID: types.BlockID{Hash: tmhash.Sum([]byte("fork"))}}
+
+	ms := newMultiSource(
+		tagged("a", &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) { return blk, nil }}),
+		tagged("b", &fakeSource{commitFn: func(context.Context, int64) (*types.Commit, error) { return forked, nil }}),
+	)
+	ms.quorum = 2
+
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+	ce, err := NewExchange(ms, edsStore, header.MakeExtendedHeader)
+	require.NoError(t, err)
+
+	_, err = ce.GetByHeight(t.Context(), height)
+	var mismatchErr *QuorumMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	assert.Equal(t, "b", mismatchErr.Mismatching)
+}
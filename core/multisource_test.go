@@ -12,6 +12,8 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
 )
 
 // fakeSource is an in-memory Fetcher used to drive MultiSource without a live
@@ -32,6 +34,8 @@ type fakeSource struct {
 
 	// getFn serves GetSignedBlock.
 	getFn func(ctx context.Context, height int64) (*SignedBlock, error)
+	// commitFn serves Commit.
+	commitFn func(ctx context.Context, height int64) (*types.Commit, error)
 }
 
 func (f *fakeSource) SubscribeNewBlockEvent(context.Context) (chan BlockEvent, error) {
@@ -56,10 +60,29 @@ func (f *fakeSource) GetSignedBlock(ctx context.Context, height int64) (*SignedB
 	return fn(ctx, height)
 }
 
+func (f *fakeSource) Commit(ctx context.Context, height int64) (*types.Commit, error) {
+	f.mu.Lock()
+	fn := f.commitFn
+	f.mu.Unlock()
+	if fn == nil {
+		return nil, errors.New("fakeSource: commitFn not set")
+	}
+	return fn(ctx, height)
+}
+
+func (f *fakeSource) GetBlockByHash(context.Context, libhead.Hash) (*types.Block, error) {
+	return nil, errors.New("fakeSource: GetBlockByHash not supported")
+}
+
+func (f *fakeSource) GetBlockInfo(context.Context, int64) (*types.Commit, *types.ValidatorSet, error) {
+	return nil, nil, errors.New("fakeSource: GetBlockInfo not supported")
+}
+
 // GetSignedBlockFrom satisfies the Fetcher interface; a leaf fakeSource has a
 // single source, so it just fetches by height.
-func (f *fakeSource) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, error) {
-	return f.GetSignedBlock(ctx, ev.Height)
+func (f *fakeSource) GetSignedBlockFrom(ctx context.Context, ev BlockEvent) (*SignedBlock, string, error) {
+	blk, err := f.GetSignedBlock(ctx, ev.Height)
+	return blk, ev.addr, err
 }
 
 func (f *fakeSource) ChainID(context.Context) (string, error) { return f.chainID, f.chainIDErr }
@@ -215,14 +238,14 @@ func TestMultiSource_GetSignedBlockFrom(t *testing.T) {
 		}}
 		ms := newMultiSource(tagged("wrong", wrongSrc), tagged("announcer", announcer))
 
-		b, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 7, addr: "announcer"})
+		b, served, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 7, addr: "announcer"})
 		require.NoError(t, err)
 		assert.Equal(t, int64(7), b.Header.Height)
+		assert.Equal(t, "announcer", served)
 	})
 
-	// SRP: no fallback. If the announcing source fails, the error propagates and
-	// the OTHER source is NOT tried — the fan-in re-announces the height and the
-	// Listener retries from there.
+	// A single attempt: if the announcing source fails, the error propagates and
+	// the OTHER source is NOT tried — retrying is up to the Listener.
 	t.Run("announcer failure propagates without trying others", func(t *testing.T) {
 		var otherCalled atomic.Bool
 		other := &fakeSource{getFn: func(context.Context, int64) (*SignedBlock, error) {
@@ -235,14 +258,41 @@ func TestMultiSource_GetSignedBlockFrom(t *testing.T) {
 		}}
 		ms := newMultiSource(tagged("other", other), tagged("down", down))
 
-		_, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 9, addr: "down"})
+		_, served, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 9, addr: "down"})
 		require.Error(t, err)
+		assert.Equal(t, "down", served)
 		assert.False(t, otherCalled.Load(), "must not fall back to another source")
 	})
 
+	t.Run("retry falls over to the sources not tried yet", func(t *testing.T) {
+		serving := func() *fakeSource {
+			return &fakeSource{getFn: func(_ context.Context, h int64) (*SignedBlock, error) {
+				b := blockAt(h)
+				return &b, nil
+			}}
+		}
+		ms := newMultiSource(tagged("down", serving()), tagged("announced", serving()), tagged("behind", serving()))
+		ms.recordAnnounce("down", 9)
+		ms.recordAnnounce("announced", 9)
+		ms.recordAnnounce("behind", 8)
+		ev := BlockEvent{Height: 9, addr: "down"}
+
+		fetch := func() string {
+			_, addr, err := ms.GetSignedBlockFrom(ctx, ev)
+			require.NoError(t, err)
+			return addr
+		}
+		ev.tried = []string{"down"}
+		assert.Equal(t, "announced", fetch(), "the sources that announced the height go first")
+		ev.tried = []string{"down", "announced"}
+		assert.Equal(t, "behind", fetch(), "then the ones yet to announce it")
+		ev.tried = []string{"down", "announced", "behind"}
+		assert.Equal(t, "down", fetch(), "then it starts over")
+	})
+
 	t.Run("unknown source addr errors", func(t *testing.T) {
 		ms := newMultiSource(tagged("only", &fakeSource{}))
-		_, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 1, addr: "nonexistent"})
+		_, _, err := ms.GetSignedBlockFrom(ctx, BlockEvent{Height: 1, addr: "nonexistent"})
 		require.Error(t, err)
 	})
 }
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	libhead "github.com/celestiaorg/go-header"
@@ -10,6 +13,89 @@ import (
 	"github.com/celestiaorg/celestia-node/share/availability"
 )
 
+const (
+	// DefaultBackfillRate is the default maximum amount of blocks the Backfiller fetches per second.
+	DefaultBackfillRate = 5.0
+	// DefaultBackfillInterval is the default interval between the Backfiller's rounds.
+	DefaultBackfillInterval = 10 * time.Minute
+)
+
+// RetryPolicy configures how blocks are fetched from core by the Listener, the Exchange and the
+// Backfiller. A failed attempt is retried after an exponentially growing backoff, and for the
+// Listener a MultiSource falls over to the sources not tried yet for the announced height.
+type RetryPolicy struct {
+	// AttemptTimeout bounds a single fetch attempt.
+	AttemptTimeout time.Duration
+	// MaxAttempts is the maximum amount of fetch attempts, the first one included.
+	MaxAttempts int
+	// Backoff is the delay before the first retry. It doubles with every next retry.
+	Backoff time.Duration
+	// MaxBackoff caps the delay between the retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the default RetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		AttemptTimeout: 10 * time.Second,
+		MaxAttempts:    3,
+		Backoff:        500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+	}
+}
+
+// Validate checks the RetryPolicy is usable.
+func (rp RetryPolicy) Validate() error {
+	if rp.AttemptTimeout <= 0 {
+		return fmt.Errorf("retry policy: attempt timeout must be positive, got %v", rp.AttemptTimeout)
+	}
+	if rp.MaxAttempts < 1 {
+		return fmt.Errorf("retry policy: max attempts must be at least 1, got %d", rp.MaxAttempts)
+	}
+	if rp.Backoff < 0 {
+		return fmt.Errorf("retry policy: backoff must not be negative, got %v", rp.Backoff)
+	}
+	if rp.MaxBackoff < rp.Backoff {
+		return fmt.Errorf("retry policy: max backoff %v must not be lower than backoff %v", rp.MaxBackoff, rp.Backoff)
+	}
+	return nil
+}
+
+// backoff returns the delay before the given retry, counting from 1.
+func (rp RetryPolicy) backoff(retry int) time.Duration {
+	delay := rp.Backoff
+	for i := 1; i < retry && delay < rp.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, rp.MaxBackoff)
+}
+
+// do calls fn following the policy. Every attempt is bounded by the attempt timeout, and the
+// failed ones are retried after a backoff. A block refused by the quorum is not retried — the
+// sources disagree on it, and asking them again wouldn't change that.
+func (rp RetryPolicy) do(ctx context.Context, fn func(ctx context.Context, attempt int) error) error {
+	var errs error
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, rp.AttemptTimeout)
+		err := fn(attemptCtx, attempt)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		errs = errors.Join(errs, err)
+
+		var mismatchErr *QuorumMismatchError
+		if errors.As(err, &mismatchErr) || ctx.Err() != nil || attempt >= rp.MaxAttempts {
+			return errs
+		}
+		select {
+		case <-time.After(rp.backoff(attempt)):
+		case <-ctx.Done():
+			return errors.Join(errs, ctx.Err())
+		}
+	}
+}
+
 type Option func(*params)
 
 type params struct {
@@ -18,12 +104,18 @@ type params struct {
 	availabilityWindow time.Duration
 	archival           bool
 	p2pExchange        libhead.Exchange[*header.ExtendedHeader]
+	backfillRate       float64
+	backfillInterval   time.Duration
+	retryPolicy        RetryPolicy
 }
 
 func defaultParams() params {
 	return params{
 		availabilityWindow: availability.StorageWindow,
 		archival:           false,
+		backfillRate:       DefaultBackfillRate,
+		backfillInterval:   DefaultBackfillInterval,
+		retryPolicy:        DefaultRetryPolicy(),
 	}
 }
 
@@ -61,3 +153,24 @@ func WithP2PExchange(ex libhead.Exchange[*header.ExtendedHeader]) Option {
 		p.p2pExchange = ex
 	}
 }
+
+// WithBackfillRate sets the maximum amount of blocks the Backfiller fetches from core per second.
+func WithBackfillRate(blocksPerSecond float64) Option {
+	return func(p *params) {
+		p.backfillRate = blocksPerSecond
+	}
+}
+
+// WithBackfillInterval sets the interval between the Backfiller's rounds of scanning for gaps.
+func WithBackfillInterval(interval time.Duration) Option {
+	return func(p *params) {
+		p.backfillInterval = interval
+	}
+}
+
+// WithRetryPolicy sets the policy blocks are fetched from core with.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(p *params) {
+		p.retryPolicy = policy
+	}
+}
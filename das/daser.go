@@ -4,15 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 
 	libhead "github.com/celestiaorg/go-header"
 
+	"github.com/celestiaorg/celestia-node/fraud"
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 )
 
 var log = logging.Logger("das")
@@ -22,11 +26,13 @@ type DASer struct {
 	params Parameters
 
 	da     share.Availability
+	bcast  fraud.Broadcaster
 	hsub   libhead.Subscriber[*header.ExtendedHeader] // listens for new headers in the network
 	getter libhead.Store[*header.ExtendedHeader]      // retrieves past headers
 
 	sampler    *samplingCoordinator
 	store      checkpointStore
+	history    *historyStore
 	subscriber subscriber
 
 	cancel  context.CancelFunc
@@ -44,11 +50,13 @@ func NewDASer(
 	hsub libhead.Subscriber[*header.ExtendedHeader],
 	getter libhead.Store[*header.ExtendedHeader],
 	dstore datastore.Datastore,
+	bcast fraud.Broadcaster,
 	options ...Option,
 ) (*DASer, error) {
 	d := &DASer{
 		params:     DefaultParameters(),
 		da:         da,
+		bcast:      bcast,
 		hsub:       hsub,
 		getter:     getter,
 		store:      newCheckpointStore(dstore),
@@ -64,6 +72,7 @@ func NewDASer(
 		return nil, err
 	}
 
+	d.history = newHistoryStore(dstore, d.params.SamplingHistory)
 	d.sampler = newSamplingCoordinator(d.params, getter, d.sample)
 	return d, nil
 }
@@ -190,7 +199,53 @@ func (d *DASer) Stop(ctx context.Context) error {
 }
 
 func (d *DASer) sample(ctx context.Context, h *header.ExtendedHeader) error {
-	return d.da.SharesAvailable(ctx, h)
+	if !d.history.enabled() {
+		return d.checkAvailability(ctx, h)
+	}
+
+	var (
+		samplesLk sync.Mutex
+		samples   []share.SampleTrace
+	)
+	tracedCtx := share.WithSampleTracer(ctx, func(trace share.SampleTrace) {
+		samplesLk.Lock()
+		samples = append(samples, trace)
+		samplesLk.Unlock()
+	})
+
+	start := time.Now()
+	err := d.checkAvailability(tracedCtx, h)
+	if errors.Is(err, context.Canceled) {
+		// the DASer is stopping, so there is nothing to record
+		return err
+	}
+
+	samplesLk.Lock()
+	result := newSamplingResult(h, start, samples, err)
+	samplesLk.Unlock()
+	if c, ok := d.da.(confidencer); ok {
+		result.Confidence = c.Confidence(h)
+	}
+	if putErr := d.history.put(ctx, result); putErr != nil {
+		log.Errorw("storing sampling result", "height", h.Height(), "err", putErr)
+	}
+	return err
+}
+
+func (d *DASer) checkAvailability(ctx context.Context, h *header.ExtendedHeader) error {
+	err := d.da.SharesAvailable(ctx, h)
+	if err != nil {
+		var byzantineErr *byzantine.ErrByzantine
+		if errors.As(err, &byzantineErr) {
+			log.Warn("Propagating proof...")
+			sendErr := d.bcast.Broadcast(ctx, byzantine.CreateBadEncodingProof(h.Hash(), h.Height(), byzantineErr))
+			if sendErr != nil {
+				log.Errorw("fraud proof propagating failed", "err", sendErr)
+			}
+		}
+		return err
+	}
+	return nil
 }
 
 // SamplingStats returns the current statistics over the DA sampling process.
@@ -198,6 +253,49 @@ func (d *DASer) SamplingStats(ctx context.Context) (SamplingStats, error) {
 	return d.sampler.stats(ctx)
 }
 
+// SamplingResult returns the result of sampling the given height.
+// It fails with ErrNoSamplingResult if the height hasn't been sampled yet, or the result fell out of
+// the sampling history.
+func (d *DASer) SamplingResult(ctx context.Context, height uint64) (*SamplingResult, error) {
+	return d.history.get(ctx, height)
+}
+
+// SamplingHistory returns the results of sampling the heights within the given inclusive range.
+// The heights without a result kept are omitted.
+func (d *DASer) SamplingHistory(ctx context.Context, from, to uint64) ([]*SamplingResult, error) {
+	return d.history.getRange(ctx, from, to)
+}
+
+// RetainFrom returns the lowest height of the headers DASer still needs, i.e. the lowest height
+// not sampled yet or failed to be sampled. It implements pruner.HeaderRetainer.
+func (d *DASer) RetainFrom(ctx context.Context) (uint64, error) {
+	var (
+		cp  checkpoint
+		err error
+	)
+	if d.running.Load() {
+		cp, err = d.sampler.getCheckpoint(ctx)
+	} else {
+		// the headers are kept for DASer to resume from its checkpoint
+		cp, err = d.store.load(ctx)
+		if errors.Is(err, datastore.ErrNotFound) {
+			return 0, nil
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	from := cp.SampleFrom
+	for height := range cp.Failed {
+		from = min(from, height)
+	}
+	for _, w := range cp.Workers {
+		from = min(from, w.From)
+	}
+	return from, nil
+}
+
 // WaitCatchUp waits for DASer to indicate catchup is done
 func (d *DASer) WaitCatchUp(ctx context.Context) error {
 	return d.sampler.state.waitCatchUp(ctx)
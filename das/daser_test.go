@@ -14,10 +14,12 @@ import (
 
 	libhead "github.com/celestiaorg/go-header"
 
+	"github.com/celestiaorg/celestia-node/fraud"
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/availability/mocks"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 )
 
 var timeout = time.Second * 3
@@ -35,7 +37,7 @@ func TestDASerLifecycle(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	t.Cleanup(cancel)
 
-	daser, err := NewDASer(avail, sub, mockGet, ds)
+	daser, err := NewDASer(avail, sub, mockGet, ds, &broadcasterStub{})
 	require.NoError(t, err)
 
 	err = daser.Start(ctx)
@@ -66,7 +68,7 @@ func TestDASer_Restart(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	t.Cleanup(cancel)
 
-	daser, err := NewDASer(avail, sub, mockGet, ds)
+	daser, err := NewDASer(avail, sub, mockGet, ds, &broadcasterStub{})
 	require.NoError(t, err)
 
 	err = daser.Start(ctx)
@@ -87,7 +89,7 @@ func TestDASer_Restart(t *testing.T) {
 	restartCtx, restartCancel := context.WithTimeout(context.Background(), timeout)
 	t.Cleanup(restartCancel)
 
-	daser, err = NewDASer(avail, sub, mockGet, ds)
+	daser, err = NewDASer(avail, sub, mockGet, ds, &broadcasterStub{})
 	require.NoError(t, err)
 
 	err = daser.Start(restartCtx)
@@ -132,7 +134,7 @@ func TestDASerSampleTimeout(t *testing.T) {
 	sub := new(headertest.Subscriber)
 
 	// create and start DASer
-	daser, err := NewDASer(avail, sub, getter, ds,
+	daser, err := NewDASer(avail, sub, getter, ds, &broadcasterStub{},
 		WithSampleTimeout(1))
 	require.NoError(t, err)
 
@@ -146,6 +148,29 @@ func TestDASerSampleTimeout(t *testing.T) {
 	}
 }
 
+func TestDASer_BroadcastsBEFP(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	eh := headertest.RandExtendedHeader(t)
+	avail := mocks.NewMockAvailability(gomock.NewController(t))
+	avail.EXPECT().SharesAvailable(gomock.Any(), eh).Return(&byzantine.ErrByzantine{Index: 1})
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	bcast := &broadcasterStub{}
+	daser, err := NewDASer(avail, new(headertest.Subscriber), headertest.NewStore(t), ds, bcast)
+	require.NoError(t, err)
+
+	err = daser.sample(ctx, eh)
+	var errByz *byzantine.ErrByzantine
+	require.ErrorAs(t, err, &errByz)
+
+	require.Len(t, bcast.proofs, 1)
+	require.Equal(t, byzantine.BadEncoding, bcast.proofs[0].Type())
+	require.Equal(t, eh.Height(), bcast.proofs[0].Height())
+	require.EqualValues(t, eh.Hash(), bcast.proofs[0].HeaderHash())
+}
+
 // createDASerSubcomponents takes numGetter (number of headers
 // to store in mockGetter) and numSub (number of headers to store
 // in the mock header.Subscriber), returning a newly instantiated
@@ -177,6 +202,15 @@ func createMockGetterAndSub(
 	return store, headertest.NewSubscriber(t, store, hsuite, numSub)
 }
 
+type broadcasterStub struct {
+	proofs []fraud.Proof
+}
+
+func (b *broadcasterStub) Broadcast(_ context.Context, p fraud.Proof) error {
+	b.proofs = append(b.proofs, p)
+	return nil
+}
+
 type benchGetterStub struct {
 	getterStub
 	header *header.ExtendedHeader
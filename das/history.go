@@ -0,0 +1,259 @@
+package das
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// maxHistoryRange is the maximum amount of heights a single SamplingHistory request may span.
+const maxHistoryRange = 1000
+
+var historyPrefix = datastore.NewKey("history")
+
+// ErrNoSamplingResult is returned when no sampling result is kept for the requested height.
+var ErrNoSamplingResult = errors.New("das: no sampling result for the height")
+
+// SamplingOutcome is the outcome of sampling a single height.
+type SamplingOutcome string
+
+const (
+	// OutcomeAvailable means all the samples were received and verified.
+	OutcomeAvailable SamplingOutcome = "available"
+	// OutcomeNotAvailable means some of the samples couldn't be received or verified.
+	OutcomeNotAvailable SamplingOutcome = "not_available"
+	// OutcomeFailed means sampling failed for any other reason, e.g. it timed out.
+	OutcomeFailed SamplingOutcome = "failed"
+)
+
+// SamplingResult is the record of sampling a single height: the samples taken, the peers that
+// served them and the outcome. The outcome is the one of the last attempt for the height, while
+// the samples are accumulated over all the attempts.
+type SamplingResult struct {
+	Height    uint64          `json:"height"`
+	DataHash  string          `json:"data_hash"`
+	SampledAt time.Time       `json:"sampled_at"`
+	Latency   time.Duration   `json:"latency"`
+	Outcome   SamplingOutcome `json:"outcome"`
+	ErrMsg    string          `json:"error,omitempty"`
+	// Confidence is the confidence that the square is recoverable the samples achieve.
+	// It is omitted if the Availability doesn't sample.
+	Confidence float64 `json:"confidence,omitempty"`
+	// Samples are the samples taken during all the attempts. An attempt only retries
+	// the samples the previous ones failed to get, so a retried sample is recorded with its
+	// latest outcome.
+	Samples []share.SampleTrace `json:"samples,omitempty"`
+}
+
+// confidencer is implemented by the Availabilities choosing the samples for a confidence.
+type confidencer interface {
+	Confidence(*header.ExtendedHeader) float64
+}
+
+// historyStore keeps the SamplingResults of the most recently sampled heights. As the heights are
+// sampled out of order, the results of the lowest heights are evicted once the history is full.
+type historyStore struct {
+	ds datastore.Datastore
+	// length is the amount of the results kept
+	length uint64
+
+	lk sync.Mutex
+	// count is the amount of the results kept, counted on the first put
+	count   uint64
+	counted bool
+}
+
+func newHistoryStore(ds datastore.Datastore, length uint64) *historyStore {
+	return &historyStore{
+		ds:     namespace.Wrap(ds, storePrefix.Child(historyPrefix)),
+		length: length,
+	}
+}
+
+func (s *historyStore) enabled() bool {
+	return s.length > 0
+}
+
+// put stores the given result and evicts the results of the lowest heights falling out of
+// the history. The samples of the result kept for the same height are merged into the given one.
+func (s *historyStore) put(ctx context.Context, result *SamplingResult) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	prev, err := s.get(ctx, result.Height)
+	switch {
+	case err == nil:
+		if prev.DataHash == result.DataHash {
+			result.Samples = mergeSamples(prev.Samples, result.Samples)
+		}
+	case !errors.Is(err, ErrNoSamplingResult):
+		return fmt.Errorf("getting previous sampling result: %w", err)
+	}
+
+	bs, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshal sampling result: %w", err)
+	}
+
+	if !s.counted {
+		s.count, err = s.countKept(ctx)
+		if err != nil {
+			return fmt.Errorf("counting sampling results: %w", err)
+		}
+		s.counted = true
+	}
+
+	key := historyKey(result.Height)
+	has, err := s.ds.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := s.ds.Put(ctx, key, bs); err != nil {
+		return err
+	}
+	if !has {
+		s.count++
+	}
+	if s.count <= s.length {
+		return nil
+	}
+
+	// the keys are ordered by height, so the lowest heights go first
+	results, err := s.ds.Query(ctx, query.Query{
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+		Limit:    int(s.count - s.length),
+	})
+	if err != nil {
+		return fmt.Errorf("querying sampling results to evict: %w", err)
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := s.ds.Delete(ctx, datastore.NewKey(result.Key)); err != nil {
+			return fmt.Errorf("evicting sampling result: %w", err)
+		}
+		s.count--
+	}
+	return nil
+}
+
+// mergeSamples appends the samples of the latest attempt to the ones of the previous attempts,
+// replacing the previous samples with the same coordinates.
+func mergeSamples(prev, latest []share.SampleTrace) []share.SampleTrace {
+	type coords struct{ row, col int }
+	idx := make(map[coords]int, len(prev))
+	merged := make([]share.SampleTrace, 0, len(prev)+len(latest))
+	for _, trace := range slices.Concat(prev, latest) {
+		key := coords{trace.Row, trace.Col}
+		if i, ok := idx[key]; ok {
+			merged[i] = trace
+			continue
+		}
+		idx[key] = len(merged)
+		merged = append(merged, trace)
+	}
+	return merged
+}
+
+// countKept returns the amount of the kept results.
+func (s *historyStore) countKept(ctx context.Context) (uint64, error) {
+	results, err := s.ds.Query(ctx, query.Query{KeysOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+
+	var count uint64
+	for result := range results.Next() {
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (s *historyStore) get(ctx context.Context, height uint64) (*SamplingResult, error) {
+	bs, err := s.ds.Get(ctx, historyKey(height))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrNoSamplingResult
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SamplingResult{}
+	if err := json.Unmarshal(bs, result); err != nil {
+		return nil, fmt.Errorf("unmarshal sampling result: %w", err)
+	}
+	return result, nil
+}
+
+// getRange returns the kept results within the given inclusive range in ascending order.
+func (s *historyStore) getRange(ctx context.Context, from, to uint64) ([]*SamplingResult, error) {
+	if from == 0 || from > to {
+		return nil, fmt.Errorf("das: invalid range [%d:%d]", from, to)
+	}
+	if to-from >= maxHistoryRange {
+		return nil, fmt.Errorf("das: range [%d:%d] exceeds %d heights", from, to, maxHistoryRange)
+	}
+
+	results := make([]*SamplingResult, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		result, err := s.get(ctx, height)
+		if errors.Is(err, ErrNoSamplingResult) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// historyKey returns the key of the result of the given height. The heights are zero-padded, so
+// the keys are ordered by height.
+func historyKey(height uint64) datastore.Key {
+	return datastore.NewKey(fmt.Sprintf("%020d", height))
+}
+
+// newSamplingResult makes the SamplingResult out of the sampling attempt.
+func newSamplingResult(
+	h *header.ExtendedHeader,
+	start time.Time,
+	samples []share.SampleTrace,
+	err error,
+) *SamplingResult {
+	result := &SamplingResult{
+		Height:    h.Height(),
+		DataHash:  share.DataHash(h.DAH.Hash()).String(),
+		SampledAt: start,
+		Latency:   time.Since(start),
+		Outcome:   OutcomeAvailable,
+		Samples:   samples,
+	}
+	switch {
+	case errors.Is(err, share.ErrNotAvailable):
+		result.Outcome = OutcomeNotAvailable
+		result.ErrMsg = err.Error()
+	case err != nil:
+		result.Outcome = OutcomeFailed
+		result.ErrMsg = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+package das
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/mocks"
+)
+
+func TestDASer_SamplingHistory(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	const history = 3
+	hdrs := headertest.NewTestSuite(t).GenExtendedHeaders(5)
+	traces := []share.SampleTrace{
+		{Row: 1, Col: 2, Peer: "peer", Available: true},
+		{Row: 3, Col: 4},
+	}
+
+	avail := mocks.NewMockAvailability(gomock.NewController(t))
+	avail.EXPECT().SharesAvailable(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, h *header.ExtendedHeader) error {
+			for _, trace := range traces {
+				share.TraceSample(ctx, trace)
+			}
+			if h.Height() == hdrs[3].Height() {
+				return share.ErrNotAvailable
+			}
+			return nil
+		}).
+		Times(len(hdrs))
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	daser, err := NewDASer(avail, new(headertest.Subscriber), headertest.NewStore(t), ds, &broadcasterStub{},
+		WithSamplingHistory(history))
+	require.NoError(t, err)
+
+	for _, h := range hdrs {
+		_ = daser.sample(ctx, h)
+	}
+
+	result, err := daser.SamplingResult(ctx, hdrs[3].Height())
+	require.NoError(t, err)
+	require.Equal(t, hdrs[3].Height(), result.Height)
+	require.Equal(t, share.DataHash(hdrs[3].DAH.Hash()).String(), result.DataHash)
+	require.Equal(t, OutcomeNotAvailable, result.Outcome)
+	require.Equal(t, traces, result.Samples)
+
+	// the oldest results fell out of the history
+	_, err = daser.SamplingResult(ctx, hdrs[0].Height())
+	require.ErrorIs(t, err, ErrNoSamplingResult)
+
+	results, err := daser.SamplingHistory(ctx, hdrs[0].Height(), hdrs[4].Height())
+	require.NoError(t, err)
+	require.Len(t, results, history)
+	for i, result := range results {
+		require.Equal(t, hdrs[2+i].Height(), result.Height)
+	}
+	require.Equal(t, OutcomeAvailable, results[2].Outcome)
+
+	_, err = daser.SamplingHistory(ctx, 1, maxHistoryRange+1)
+	require.Error(t, err)
+}
+
+func TestHistoryStore_EvictsLowestHeights(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	history := newHistoryStore(ds, 3)
+	heights := func(store *historyStore) []uint64 {
+		results, err := store.getRange(ctx, 1, 100)
+		require.NoError(t, err)
+		heights := make([]uint64, len(results))
+		for i, result := range results {
+			heights[i] = result.Height
+		}
+		return heights
+	}
+
+	// the heights are sampled out of order
+	for _, height := range []uint64{10, 2, 7, 7, 5} {
+		require.NoError(t, history.put(ctx, &SamplingResult{Height: height}))
+	}
+	require.Equal(t, []uint64{5, 7, 10}, heights(history))
+
+	// the results kept before the restart are accounted
+	history = newHistoryStore(ds, 3)
+	require.NoError(t, history.put(ctx, &SamplingResult{Height: 1}))
+	require.Equal(t, []uint64{5, 7, 10}, heights(history))
+	require.NoError(t, history.put(ctx, &SamplingResult{Height: 11}))
+	require.Equal(t, []uint64{7, 10, 11}, heights(history))
+}
+
+func TestHistoryStore_MergesSamplesOfAttempts(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	history := newHistoryStore(ds_sync.MutexWrap(datastore.NewMapDatastore()), 3)
+	first := []share.SampleTrace{
+		{Row: 1, Col: 2, Peer: "peer", Available: true},
+		{Row: 3, Col: 4},
+	}
+	require.NoError(t, history.put(ctx, &SamplingResult{
+		Height:   1,
+		DataHash: "hash",
+		Outcome:  OutcomeNotAvailable,
+		Samples:  first,
+	}))
+
+	// the retry only takes the failed sample
+	retried := share.SampleTrace{Row: 3, Col: 4, Peer: "other", Available: true}
+	require.NoError(t, history.put(ctx, &SamplingResult{
+		Height:   1,
+		DataHash: "hash",
+		Outcome:  OutcomeAvailable,
+		Samples:  []share.SampleTrace{retried},
+	}))
+
+	result, err := history.get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, OutcomeAvailable, result.Outcome)
+	require.Equal(t, []share.SampleTrace{first[0], retried}, result.Samples)
+}
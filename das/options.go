@@ -38,6 +38,10 @@ type Parameters struct {
 	// divided between parallel workers. SampleTimeout should be adjusted proportionally to
 	// ConcurrencyLimit.
 	SampleTimeout time.Duration
+
+	// SamplingHistory is the amount of the per-height sampling results kept. Once exceeded, the
+	// results of the lowest heights are evicted. SamplingHistory = 0 disables keeping the results.
+	SamplingHistory uint64
 }
 
 // DefaultParameters returns the default configuration values for the daser parameters
@@ -52,6 +56,8 @@ func DefaultParameters() Parameters {
 		// SampleTimeout = approximate block time (with a bit of wiggle room) * max amount of catchup
 		// workers
 		SampleTimeout: 15 * time.Second * time.Duration(concurrencyLimit),
+		// roughly a day worth of blocks
+		SamplingHistory: 14400,
 	}
 }
 
@@ -59,6 +65,7 @@ func DefaultParameters() Parameters {
 //
 //	All parameters must be positive and non-zero, except:
 //		BackgroundStoreInterval = 0 disables background storer,
+//		SamplingHistory = 0 disables keeping the sampling results,
 //		PriorityQueueSize = 0 disables prioritization of recently produced blocks for sampling
 func (p *Parameters) Validate() error {
 	// SamplingRange = 0 will cause the jobs' queue to be empty
@@ -135,3 +142,11 @@ func WithSampleTimeout(sampleTimeout time.Duration) Option {
 		d.params.SampleTimeout = sampleTimeout
 	}
 }
+
+// WithSamplingHistory is a functional option to configure the daser's `SamplingHistory` parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithSamplingHistory(samplingHistory uint64) Option {
+	return func(d *DASer) {
+		d.params.SamplingHistory = samplingHistory
+	}
+}
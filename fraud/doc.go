@@ -0,0 +1,13 @@
+/*
+Package fraud contains the functionality for propagating and verifying fraud proofs in the network.
+
+A fraud proof is a compact, self-contained evidence that a block producer has committed to an
+invalid block. Currently, the only supported kind of fraud is the Bad Encoding Fraud Proof
+(see share/eds/byzantine), which proves that an extended data square was erasure coded incorrectly.
+
+ProofService gossips fraud proofs over a dedicated pubsub topic per ProofType. Every incoming proof
+is verified against the locally known ExtendedHeader before it is relayed further, so
+subscribers only ever receive valid proofs. Valid proofs are persisted, so that the node
+refuses to resume services that were halted because of fraud after a restart.
+*/
+package fraud
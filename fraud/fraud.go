@@ -0,0 +1,97 @@
+package fraud
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+// ErrFraudExists is returned when a valid fraud proof for the requested type is known to the node.
+// Services that are stopped because of fraud are not allowed to start again.
+type ErrFraudExists struct {
+	Proof []Proof
+}
+
+func (e *ErrFraudExists) Error() string {
+	return fmt.Sprintf("fraud: %s proof exists", e.Proof[0].Type())
+}
+
+// ErrNoUnmarshaler is returned when there is no registered unmarshaler for the given ProofType.
+var ErrNoUnmarshaler = errors.New("fraud: no unmarshaler for proof type")
+
+// ProofType is the unique identifier of a fraud proof kind.
+type ProofType string
+
+// String returns string representation of the ProofType.
+func (pt ProofType) String() string {
+	return string(pt)
+}
+
+// Proof is a generic interface that will be used for all types of fraud proofs in the network.
+type Proof interface {
+	// Type returns the exact type of fraud proof.
+	Type() ProofType
+	// HeaderHash returns the block hash.
+	HeaderHash() []byte
+	// Height returns the block height corresponding to the Proof.
+	Height() uint64
+	// Validate check the validity of fraud proof.
+	// Validate throws an error if some conditions don't pass and thus fraud proof is not valid.
+	// NOTE: header.ExtendedHeader should pass basic validation otherwise it will panic if it's
+	// malformed.
+	Validate(*header.ExtendedHeader) error
+
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// ProofUnmarshaler aggregates decoders for all supported ProofTypes.
+type ProofUnmarshaler interface {
+	// List returns all the registered ProofTypes.
+	List() []ProofType
+	// Unmarshal decodes bytes into the Proof of the given ProofType.
+	Unmarshal(ProofType, []byte) (Proof, error)
+}
+
+// Service encompasses Fraud Proof functionality of the node.
+type Service interface {
+	Subscriber
+	Broadcaster
+	Getter
+}
+
+// Broadcaster is a generic interface that sends a `Proof` to all nodes subscribed on the
+// Broadcaster's topic.
+type Broadcaster interface {
+	// Broadcast takes a fraud `Proof` data structure interface and broadcasts it to local
+	// subscriptions and peers. It may additionally cache/persist Proofs for future
+	// access via Getter and to serve Proof requests to peers in the network.
+	Broadcast(context.Context, Proof) error
+}
+
+// Subscriber encompasses the behavior necessary to
+// subscribe/unsubscribe from new FraudProof events from the
+// network.
+type Subscriber interface {
+	// Subscribe allows to subscribe on a Proof pub sub topic by its type.
+	Subscribe(ProofType) (Subscription, error)
+}
+
+// Getter encompasses the behavior to fetch stored fraud proofs.
+type Getter interface {
+	// Get fetches fraud proofs from the disk by its type.
+	Get(context.Context, ProofType) ([]Proof, error)
+}
+
+// Subscription returns a valid proof if one is received on the topic.
+type Subscription interface {
+	// Proof returns already verified valid proof.
+	Proof(context.Context) (Proof, error)
+	Cancel()
+}
+
+// HeaderFetcher aliases a function that is used to fetch an ExtendedHeader from store by height.
+type HeaderFetcher func(context.Context, uint64) (*header.ExtendedHeader, error)
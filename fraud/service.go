@@ -0,0 +1,222 @@
+package fraud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	logging "github.com/ipfs/go-log/v2"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var log = logging.Logger("fraud")
+
+// fetchHeaderTimeout duration of GetByHeight request to fetch an ExtendedHeader.
+const fetchHeaderTimeout = time.Minute * 2
+
+// storeNamespace is the datastore prefix under which all valid fraud proofs are persisted.
+var storeNamespace = datastore.NewKey("fraud")
+
+var _ Service = (*ProofService)(nil)
+
+// ProofService is responsible for validating and propagating Fraud Proofs.
+// It implements the Service interface.
+type ProofService struct {
+	networkID string
+
+	topicsLk sync.RWMutex
+	topics   map[ProofType]*pubsub.Topic
+
+	storesLk sync.Mutex
+	stores   map[ProofType]datastore.Datastore
+
+	pubsub       *pubsub.PubSub
+	headerGetter HeaderFetcher
+	unmarshal    ProofUnmarshaler
+	ds           datastore.Datastore
+}
+
+// NewProofService creates a new ProofService.
+func NewProofService(
+	p *pubsub.PubSub,
+	headerGetter HeaderFetcher,
+	unmarshal ProofUnmarshaler,
+	ds datastore.Datastore,
+	networkID string,
+) *ProofService {
+	return &ProofService{
+		networkID:    networkID,
+		topics:       make(map[ProofType]*pubsub.Topic),
+		stores:       make(map[ProofType]datastore.Datastore),
+		pubsub:       p,
+		headerGetter: headerGetter,
+		unmarshal:    unmarshal,
+		ds:           namespace.Wrap(ds, storeNamespace),
+	}
+}
+
+// Start joins fraud proofs topics for all the registered ProofTypes and registers the
+// validators for them.
+func (f *ProofService) Start(context.Context) error {
+	f.topicsLk.Lock()
+	defer f.topicsLk.Unlock()
+
+	for _, proofType := range f.unmarshal.List() {
+		topicID := pubsubTopicID(proofType, f.networkID)
+		err := f.pubsub.RegisterTopicValidator(topicID,
+			func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+				return f.processIncoming(ctx, proofType, from, msg)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("fraud: registering validator for %s: %w", proofType, err)
+		}
+
+		topic, err := f.pubsub.Join(topicID)
+		if err != nil {
+			return fmt.Errorf("fraud: joining topic for %s: %w", proofType, err)
+		}
+		f.topics[proofType] = topic
+	}
+	return nil
+}
+
+// Stop unregisters the validators and closes all the joined topics.
+func (f *ProofService) Stop(context.Context) error {
+	f.topicsLk.Lock()
+	defer f.topicsLk.Unlock()
+
+	var errs error
+	for proofType, topic := range f.topics {
+		err := f.pubsub.UnregisterTopicValidator(pubsubTopicID(proofType, f.networkID))
+		if err != nil {
+			log.Warnw("unregistering topic validator", "proofType", proofType, "err", err)
+		}
+		if err = topic.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("closing topic for %s: %w", proofType, err))
+		}
+		delete(f.topics, proofType)
+	}
+	return errs
+}
+
+// Subscribe subscribes to the topic of the given ProofType and returns a Subscription that yields
+// only validated Proofs.
+func (f *ProofService) Subscribe(proofType ProofType) (Subscription, error) {
+	topic, err := f.topic(proofType)
+	if err != nil {
+		return nil, err
+	}
+	return newSubscription(topic)
+}
+
+// Broadcast sends the Proof to all the nodes subscribed on the Proof's topic.
+func (f *ProofService) Broadcast(ctx context.Context, p Proof) error {
+	topic, err := f.topic(p.Type())
+	if err != nil {
+		return err
+	}
+
+	bin, err := p.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("fraud: marshaling %s proof: %w", p.Type(), err)
+	}
+	return topic.Publish(ctx, bin)
+}
+
+// Get fetches all the valid fraud proofs of the given type persisted on the disk.
+// It returns datastore.ErrNotFound if no proofs of the type are known.
+func (f *ProofService) Get(ctx context.Context, proofType ProofType) ([]Proof, error) {
+	raw, err := getAll(ctx, f.store(proofType))
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]Proof, 0, len(raw))
+	for _, data := range raw {
+		proof, err := f.unmarshal.Unmarshal(proofType, data)
+		if err != nil {
+			return nil, fmt.Errorf("fraud: unmarshaling stored %s proof: %w", proofType, err)
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs, nil
+}
+
+// processIncoming encompasses the logic for validating fraud proofs.
+func (f *ProofService) processIncoming(
+	ctx context.Context,
+	proofType ProofType,
+	from peer.ID,
+	msg *pubsub.Message,
+) pubsub.ValidationResult {
+	proof, err := f.unmarshal.Unmarshal(proofType, msg.Data)
+	if err != nil {
+		log.Errorw("unmarshaling fraud proof", "proofType", proofType, "from", from, "err", err)
+		return pubsub.ValidationReject
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchHeaderTimeout)
+	defer cancel()
+	extHeader, err := f.headerGetter(ctx, proof.Height())
+	if err != nil {
+		// TODO: if the header was not received yet, we could wait for it and re-validate the proof
+		//  instead of ignoring it.
+		log.Debugw("failed to fetch header to verify a fraud proof",
+			"proofType", proofType, "height", proof.Height(), "err", err)
+		return pubsub.ValidationIgnore
+	}
+	if !bytes.Equal(proof.HeaderHash(), extHeader.Hash()) {
+		log.Debugw("mismatched header hash in fraud proof",
+			"proofType", proofType, "height", proof.Height(), "from", from)
+		return pubsub.ValidationReject
+	}
+
+	if err = proof.Validate(extHeader); err != nil {
+		log.Debugw("invalid fraud proof",
+			"proofType", proofType, "height", proof.Height(), "from", from, "err", err)
+		return pubsub.ValidationReject
+	}
+
+	log.Warnw("received valid fraud proof", "proofType", proofType, "height", proof.Height(), "from", from)
+	msg.ValidatorData = proof
+
+	if err = put(ctx, f.store(proofType), heightKey(proof.Height()), msg.Data); err != nil {
+		log.Errorw("storing fraud proof", "proofType", proofType, "height", proof.Height(), "err", err)
+	}
+	return pubsub.ValidationAccept
+}
+
+func (f *ProofService) topic(proofType ProofType) (*pubsub.Topic, error) {
+	f.topicsLk.RLock()
+	defer f.topicsLk.RUnlock()
+
+	topic, ok := f.topics[proofType]
+	if !ok {
+		return nil, fmt.Errorf("fraud: topic for %s does not exist", proofType)
+	}
+	return topic, nil
+}
+
+func (f *ProofService) store(proofType ProofType) datastore.Datastore {
+	f.storesLk.Lock()
+	defer f.storesLk.Unlock()
+
+	store, ok := f.stores[proofType]
+	if !ok {
+		store = namespace.Wrap(f.ds, datastore.NewKey(proofType.String()))
+		f.stores[proofType] = store
+	}
+	return store
+}
+
+// pubsubTopicID returns the name of the fraud proof topic for the given ProofType and networkID.
+func pubsubTopicID(proofType ProofType, networkID string) string {
+	return fmt.Sprintf("%s/fraud-sub/%s/v0.0.1", networkID, proofType)
+}
@@ -0,0 +1,125 @@
+package fraud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+)
+
+const dummyProofType ProofType = "dummy"
+
+type dummyProof struct {
+	Valid       bool   `json:"valid"`
+	Hash        []byte `json:"hash"`
+	BlockHeight uint64 `json:"height"`
+}
+
+func (p *dummyProof) Type() ProofType    { return dummyProofType }
+func (p *dummyProof) HeaderHash() []byte { return p.Hash }
+func (p *dummyProof) Height() uint64     { return p.BlockHeight }
+
+func (p *dummyProof) Validate(*header.ExtendedHeader) error {
+	if !p.Valid {
+		return errors.New("invalid proof")
+	}
+	return nil
+}
+
+func (p *dummyProof) MarshalBinary() ([]byte, error)    { return json.Marshal(p) }
+func (p *dummyProof) UnmarshalBinary(data []byte) error { return json.Unmarshal(data, p) }
+
+func TestService_BroadcastAndSubscribe(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	eh := headertest.RandExtendedHeader(t)
+	servA, servB := newTestServices(ctx, t, eh)
+
+	sub, err := servB.Subscribe(dummyProofType)
+	require.NoError(t, err)
+	t.Cleanup(sub.Cancel)
+
+	// wait for the topic mesh to be formed
+	time.Sleep(time.Millisecond * 100)
+
+	// invalid proofs are rejected by the local validator as well
+	err = servA.Broadcast(ctx, &dummyProof{Valid: false, Hash: eh.Hash(), BlockHeight: eh.Height()})
+	require.Error(t, err)
+	err = servA.Broadcast(ctx, &dummyProof{Valid: true, Hash: eh.Hash(), BlockHeight: eh.Height()})
+	require.NoError(t, err)
+
+	proof, err := sub.Proof(ctx)
+	require.NoError(t, err)
+	require.True(t, proof.(*dummyProof).Valid)
+
+	proofs, err := servB.Get(ctx, dummyProofType)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, eh.Height(), proofs[0].Height())
+}
+
+func TestService_RejectsMismatchedHash(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	eh := headertest.RandExtendedHeader(t)
+	servA, servB := newTestServices(ctx, t, eh)
+
+	sub, err := servB.Subscribe(dummyProofType)
+	require.NoError(t, err)
+	t.Cleanup(sub.Cancel)
+
+	time.Sleep(time.Millisecond * 100)
+
+	err = servA.Broadcast(ctx, &dummyProof{Valid: true, Hash: []byte("other"), BlockHeight: eh.Height()})
+	require.Error(t, err)
+
+	subCtx, subCancel := context.WithTimeout(ctx, time.Millisecond*500)
+	defer subCancel()
+	_, err = sub.Proof(subCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = servB.Get(ctx, dummyProofType)
+	require.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func newTestServices(ctx context.Context, t *testing.T, eh *header.ExtendedHeader) (*ProofService, *ProofService) {
+	net, err := mocknet.FullMeshLinked(2)
+	require.NoError(t, err)
+
+	unmarshaler := MultiUnmarshaler{
+		Unmarshalers: map[ProofType]UnmarshalFn{
+			dummyProofType: func(data []byte) (Proof, error) {
+				proof := &dummyProof{}
+				return proof, proof.UnmarshalBinary(data)
+			},
+		},
+	}
+	getter := func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return eh, nil
+	}
+
+	services := make([]*ProofService, 0, 2)
+	for _, h := range net.Hosts() {
+		ps, err := pubsub.NewGossipSub(ctx, h, pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign))
+		require.NoError(t, err)
+
+		serv := NewProofService(ps, getter, unmarshaler, ds_sync.MutexWrap(datastore.NewMapDatastore()), "test")
+		require.NoError(t, serv.Start(ctx))
+		services = append(services, serv)
+	}
+	require.NoError(t, net.ConnectAllButSelf())
+
+	return services[0], services[1]
+}
@@ -0,0 +1,45 @@
+package fraud
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ipfs/go-datastore"
+	q "github.com/ipfs/go-datastore/query"
+)
+
+// put adds a Fraud Proof to the datastore with the given key.
+func put(ctx context.Context, ds datastore.Datastore, key datastore.Key, proof []byte) error {
+	return ds.Put(ctx, key, proof)
+}
+
+// getAll queries all Fraud Proofs by their type.
+// It returns datastore.ErrNotFound if there are no proofs stored.
+func getAll(ctx context.Context, ds datastore.Datastore) ([][]byte, error) {
+	entries, err := query(ctx, ds, q.Query{})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, datastore.ErrNotFound
+	}
+
+	proofs := make([][]byte, len(entries))
+	for i, entry := range entries {
+		proofs[i] = entry.Value
+	}
+	return proofs, nil
+}
+
+func query(ctx context.Context, ds datastore.Datastore, query q.Query) ([]q.Entry, error) {
+	results, err := ds.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return results.Rest()
+}
+
+// heightKey returns the datastore key of a Fraud Proof for the given height.
+func heightKey(height uint64) datastore.Key {
+	return datastore.NewKey(strconv.FormatUint(height, 10))
+}
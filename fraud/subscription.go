@@ -0,0 +1,62 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// subscription wraps pubsub subscription and handles Fraud Proof from the pubsub topic.
+type subscription struct {
+	subscription *pubsub.Subscription
+}
+
+func newSubscription(t *pubsub.Topic) (*subscription, error) {
+	sub, err := t.Subscribe()
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription{sub}, nil
+}
+
+func (s *subscription) Proof(ctx context.Context) (Proof, error) {
+	data, err := s.subscription.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proof, ok := data.ValidatorData.(Proof)
+	if !ok {
+		return nil, fmt.Errorf("fraud: unexpected type received %T", data.ValidatorData)
+	}
+	return proof, nil
+}
+
+func (s *subscription) Cancel() {
+	s.subscription.Cancel()
+}
+
+// OnProof subscribes on a single Fraud Proof.
+// In case a Fraud Proof is received, then the given handle function will be invoked.
+func OnProof(ctx context.Context, subscriber Subscriber, p ProofType, handle func(proof Proof)) {
+	subscription, err := subscriber.Subscribe(p)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer subscription.Cancel()
+
+	// At this point we receive already verified fraud proof,
+	// so there is no need to call Validate.
+	proof, err := subscription.Proof(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Errorw("reading next proof failed", "err", err)
+		}
+		return
+	}
+
+	handle(proof)
+}
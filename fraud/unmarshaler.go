@@ -0,0 +1,32 @@
+package fraud
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// UnmarshalFn decodes the raw bytes into the Proof.
+type UnmarshalFn func([]byte) (Proof, error)
+
+// MultiUnmarshaler is a ProofUnmarshaler that dispatches decoding to the registered UnmarshalFn
+// of each ProofType.
+type MultiUnmarshaler struct {
+	Unmarshalers map[ProofType]UnmarshalFn
+}
+
+// List returns all the ProofTypes with a registered UnmarshalFn.
+func (mu MultiUnmarshaler) List() []ProofType {
+	types := slices.Collect(maps.Keys(mu.Unmarshalers))
+	slices.Sort(types)
+	return types
+}
+
+// Unmarshal decodes the given bytes into the Proof of the requested ProofType.
+func (mu MultiUnmarshaler) Unmarshal(proofType ProofType, data []byte) (Proof, error) {
+	unmarshalFn, ok := mu.Unmarshalers[proofType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoUnmarshaler, proofType)
+	}
+	return unmarshalFn(data)
+}
@@ -1,7 +1,6 @@
 package authtoken
 
 import (
-	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -15,6 +14,17 @@ import (
 // ExtractSignedPermissions returns the permissions granted to the token by the passed signer.
 // If the token isn't signed by the signer, it will not pass verification.
 func ExtractSignedPermissions(verifier jwt.Verifier, token string) ([]auth.Permission, error) {
+	p, err := ExtractSignedPayload(verifier, token)
+	if err != nil {
+		return nil, err
+	}
+	return p.Allow, nil
+}
+
+// ExtractSignedPayload returns the payload of the token signed by the passed signer, including
+// its permissions and optional scope.
+// If the token isn't signed by the signer or is expired, it will not pass verification.
+func ExtractSignedPayload(verifier jwt.Verifier, token string) (*perms.JWTPayload, error) {
 	tk, err := jwt.Parse([]byte(token), verifier)
 	if err != nil {
 		return nil, err
@@ -27,26 +37,32 @@ func ExtractSignedPermissions(verifier jwt.Verifier, token string) ([]auth.Permi
 	if !p.ExpiresAt.IsZero() && p.ExpiresAt.Before(time.Now().UTC()) {
 		return nil, fmt.Errorf("token expired %s ago", time.Since(p.ExpiresAt))
 	}
-	return p.Allow, nil
+	return p, nil
 }
 
 // NewSignedJWT returns a signed JWT token with the passed permissions and signer.
 func NewSignedJWT(signer jwt.Signer, permissions []auth.Permission, ttl time.Duration) (string, error) {
-	nonce := make([]byte, 32)
-	if _, err := rand.Read(nonce); err != nil {
-		return "", err
-	}
+	return NewSignedScopedJWT(signer, permissions, nil, ttl)
+}
 
-	var expiresAt time.Time
-	if ttl != 0 {
-		expiresAt = time.Now().UTC().Add(ttl)
+// NewSignedScopedJWT returns a signed JWT token with the passed permissions restricted to the
+// given scope. A nil scope imposes no restrictions.
+func NewSignedScopedJWT(
+	signer jwt.Signer,
+	permissions []auth.Permission,
+	scope *perms.Scope,
+	ttl time.Duration,
+) (string, error) {
+	p, err := perms.NewJWTPayload(permissions, scope, ttl)
+	if err != nil {
+		return "", err
 	}
+	return SignPayload(signer, p)
+}
 
-	token, err := jwt.NewBuilder(signer).Build(&perms.JWTPayload{
-		Allow:     permissions,
-		Nonce:     nonce,
-		ExpiresAt: expiresAt,
-	})
+// SignPayload returns the JWT token with the given payload signed by the passed signer.
+func SignPayload(signer jwt.Signer, p *perms.JWTPayload) (string, error) {
+	token, err := jwt.NewBuilder(signer).Build(p)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,341 @@
+package authtoken
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+var log = logging.Logger("authtoken")
+
+var usagePrefix = datastore.NewKey("rpc_usage")
+
+// flushInterval is the interval the usage counters are persisted at.
+var flushInterval = time.Minute
+
+// anonymousIdentity is the identity the requests without a token are accounted under.
+const anonymousIdentity = "anonymous"
+
+var (
+	// ErrRateLimited is returned when the token exceeds its request rate.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrQuotaExceeded is returned when the token exhausts its daily quota.
+	ErrQuotaExceeded = errors.New("daily quota exceeded")
+)
+
+// Limits bounds the usage of the RPC by a single token. Zero values impose no limit.
+type Limits struct {
+	// RequestsPerSec is the sustained request rate of the token.
+	RequestsPerSec int
+	// Burst is the amount of requests above the sustained rate the token can make at once.
+	Burst int
+	// DailyRequests is the amount of requests the token can make per UTC day.
+	DailyRequests uint64
+	// DailyBytes is the amount of request and response bytes the token can transfer per UTC day.
+	DailyBytes uint64
+}
+
+func (l Limits) Validate() error {
+	if l.RequestsPerSec < 0 || l.Burst < 0 {
+		return errors.New("RequestsPerSec and Burst must not be negative")
+	}
+	if l.RequestsPerSec > 0 && l.Burst == 0 {
+		return errors.New("Burst must be > 0 when RequestsPerSec is set")
+	}
+	return nil
+}
+
+// Usage is the usage of the RPC by a single token within a UTC day.
+type Usage struct {
+	// Identity is the ID of the token. Tokens without an ID are accounted per their permission
+	// tier, e.g. "tier/write", and requests without a token are accounted as "anonymous".
+	Identity string    `json:"identity"`
+	Day      time.Time `json:"day"`
+	Requests uint64    `json:"requests"`
+	Bytes    uint64    `json:"bytes"`
+}
+
+// Quotas enforces per-token rate limits and daily quotas. The limits of a token are looked up by
+// its ID first, falling back to the limits of its highest permission tier. Usage counters are
+// kept in memory and persisted periodically.
+type Quotas struct {
+	ds     datastore.Datastore
+	tiers  map[string]Limits
+	tokens map[string]Limits
+
+	lk      sync.Mutex
+	entries map[string]*quotaEntry
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+type quotaEntry struct {
+	limiter *rate.Limiter
+	usage   Usage
+	dirty   bool
+}
+
+// NewQuotas creates new Quotas with the given limits per permission tier and per token ID.
+func NewQuotas(ds datastore.Datastore, tiers, tokens map[string]Limits) *Quotas {
+	return &Quotas{
+		ds:      namespace.Wrap(ds, usagePrefix),
+		tiers:   tiers,
+		tokens:  tokens,
+		entries: make(map[string]*quotaEntry),
+	}
+}
+
+// Start loads the usage counters of the current day, drops the ones of the past days and starts
+// persisting them periodically.
+func (q *Quotas) Start(ctx context.Context) error {
+	usages, err := q.load(ctx)
+	if err != nil {
+		return fmt.Errorf("loading rpc usage: %w", err)
+	}
+
+	today := day(time.Now())
+	var stale []string
+	q.lk.Lock()
+	for _, usage := range usages {
+		if !usage.Day.Equal(today) {
+			stale = append(stale, usage.Identity)
+			continue
+		}
+		// the requests served before the start are accounted on top of the persisted usage
+		if entry, ok := q.entries[usage.Identity]; ok && entry.usage.Day.Equal(today) {
+			entry.usage.Requests += usage.Requests
+			entry.usage.Bytes += usage.Bytes
+			continue
+		}
+		q.entries[usage.Identity] = &quotaEntry{usage: *usage}
+	}
+	q.lk.Unlock()
+
+	if err := q.delete(ctx, stale); err != nil {
+		return err
+	}
+
+	ctx, q.cancel = context.WithCancel(context.Background())
+	q.doneCh = make(chan struct{})
+	go q.run(ctx)
+	return nil
+}
+
+// Stop stops persisting the usage counters and persists them for the last time.
+func (q *Quotas) Stop(ctx context.Context) error {
+	q.cancel()
+	select {
+	case <-q.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return q.flush(ctx)
+}
+
+// Allow accounts a new call made with the token of the given payload, which is nil for the
+// calls without a token. It returns ErrRateLimited or ErrQuotaExceeded if the call must be
+// rejected.
+func (q *Quotas) Allow(p *perms.JWTPayload) error {
+	identity, limits := q.limits(p)
+
+	q.lk.Lock()
+	defer q.lk.Unlock()
+	entry := q.entry(identity, limits)
+	if entry.limiter != nil && !entry.limiter.Allow() {
+		return ErrRateLimited
+	}
+	if limits.DailyRequests != 0 && entry.usage.Requests >= limits.DailyRequests {
+		return ErrQuotaExceeded
+	}
+	if limits.DailyBytes != 0 && entry.usage.Bytes >= limits.DailyBytes {
+		return ErrQuotaExceeded
+	}
+	entry.usage.Requests++
+	entry.dirty = true
+	return nil
+}
+
+// Record accounts the bytes transferred with the token of the given payload. It returns
+// ErrQuotaExceeded once the daily bytes quota of the token is exhausted, so the callers can cut
+// the ongoing transfer short.
+func (q *Quotas) Record(p *perms.JWTPayload, bytes uint64) error {
+	identity, limits := q.limits(p)
+
+	q.lk.Lock()
+	defer q.lk.Unlock()
+	entry := q.entry(identity, limits)
+	entry.usage.Bytes += bytes
+	entry.dirty = true
+	if limits.DailyBytes != 0 && entry.usage.Bytes > limits.DailyBytes {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// Usage returns the usage of the current day of all the tokens ordered by identity.
+func (q *Quotas) Usage(context.Context) ([]*Usage, error) {
+	today := day(time.Now())
+
+	q.lk.Lock()
+	usages := make([]*Usage, 0, len(q.entries))
+	for _, entry := range q.entries {
+		if entry.usage.Day.Equal(today) {
+			usage := entry.usage
+			usages = append(usages, &usage)
+		}
+	}
+	q.lk.Unlock()
+
+	slices.SortFunc(usages, func(a, b *Usage) int {
+		return cmp.Compare(a.Identity, b.Identity)
+	})
+	return usages, nil
+}
+
+// limits returns the identity the token is accounted under and its limits.
+func (q *Quotas) limits(p *perms.JWTPayload) (string, Limits) {
+	if p == nil {
+		return anonymousIdentity, q.tiers[string(tier(perms.DefaultPerms))]
+	}
+	tr := string(tier(p.Allow))
+	if p.ID == "" {
+		return "tier/" + tr, q.tiers[tr]
+	}
+	if limits, ok := q.tokens[p.ID]; ok {
+		return p.ID, limits
+	}
+	return p.ID, q.tiers[tr]
+}
+
+// entry returns the quota entry of the identity, resetting its usage on the day change.
+// Must be called under the lock.
+func (q *Quotas) entry(identity string, limits Limits) *quotaEntry {
+	today := day(time.Now())
+	entry, ok := q.entries[identity]
+	if !ok {
+		entry = &quotaEntry{usage: Usage{Identity: identity, Day: today}}
+		q.entries[identity] = entry
+	}
+	if !entry.usage.Day.Equal(today) {
+		entry.usage = Usage{Identity: identity, Day: today}
+	}
+	if entry.limiter == nil && limits.RequestsPerSec > 0 {
+		entry.limiter = rate.NewLimiter(rate.Limit(limits.RequestsPerSec), limits.Burst)
+	}
+	return entry
+}
+
+func (q *Quotas) run(ctx context.Context) {
+	defer close(q.doneCh)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := q.flush(ctx); err != nil {
+				log.Warnw("persisting rpc usage", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// flush persists the usage counters changed since the last flush and expires the counters of the
+// past days, which are not served anymore.
+func (q *Quotas) flush(ctx context.Context) error {
+	today := day(time.Now())
+	q.lk.Lock()
+	var (
+		dirty []Usage
+		stale []string
+	)
+	for identity, entry := range q.entries {
+		if !entry.usage.Day.Equal(today) {
+			delete(q.entries, identity)
+			stale = append(stale, identity)
+			continue
+		}
+		if entry.dirty {
+			dirty = append(dirty, entry.usage)
+			entry.dirty = false
+		}
+	}
+	q.lk.Unlock()
+
+	if err := q.delete(ctx, stale); err != nil {
+		return err
+	}
+	for _, usage := range dirty {
+		data, err := json.Marshal(usage)
+		if err != nil {
+			return fmt.Errorf("marshaling usage of %s: %w", usage.Identity, err)
+		}
+		if err := q.ds.Put(ctx, datastore.NewKey(usage.Identity), data); err != nil {
+			return fmt.Errorf("persisting usage of %s: %w", usage.Identity, err)
+		}
+	}
+	return nil
+}
+
+// delete removes the persisted usage counters of the given identities.
+func (q *Quotas) delete(ctx context.Context, identities []string) error {
+	for _, identity := range identities {
+		if err := q.ds.Delete(ctx, datastore.NewKey(identity)); err != nil {
+			return fmt.Errorf("deleting usage of %s: %w", identity, err)
+		}
+	}
+	return nil
+}
+
+func (q *Quotas) load(ctx context.Context) ([]*Usage, error) {
+	results, err := q.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	usages := make([]*Usage, 0)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		usage := &Usage{}
+		if err := json.Unmarshal(result.Value, usage); err != nil {
+			return nil, fmt.Errorf("unmarshaling usage %s: %w", result.Key, err)
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// tier returns the highest of the given permissions.
+func tier(allow []auth.Permission) auth.Permission {
+	for i := len(perms.AllPerms) - 1; i >= 0; i-- {
+		if slices.Contains(allow, perms.AllPerms[i]) {
+			return perms.AllPerms[i]
+		}
+	}
+	return perms.DefaultPerms[0]
+}
+
+// day returns the start of the UTC day of the given time.
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,93 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+func TestQuotas(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	tiers := map[string]Limits{"read": {DailyRequests: 3}}
+	quotas := NewQuotas(ds, tiers, nil)
+	require.NoError(t, quotas.Start(ctx))
+
+	token := &perms.JWTPayload{ID: "token", Allow: perms.ReadPerms}
+	legacy := &perms.JWTPayload{Allow: perms.ReadWritePerms}
+	for range 2 {
+		require.NoError(t, quotas.Allow(token))
+	}
+	require.NoError(t, quotas.Record(token, 42))
+	require.NoError(t, quotas.Allow(legacy))
+
+	usage, err := quotas.Usage(ctx)
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	require.Equal(t, "tier/write", usage[0].Identity)
+	require.Equal(t, "token", usage[1].Identity)
+	require.EqualValues(t, 2, usage[1].Requests)
+	require.EqualValues(t, 42, usage[1].Bytes)
+	require.NoError(t, quotas.Stop(ctx))
+
+	// usage persists across restarts
+	quotas = NewQuotas(ds, tiers, nil)
+	require.NoError(t, quotas.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, quotas.Stop(ctx))
+	})
+	require.NoError(t, quotas.Allow(token))
+	require.ErrorIs(t, quotas.Allow(token), ErrQuotaExceeded)
+
+	usage, err = quotas.Usage(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, usage[1].Requests)
+}
+
+func TestQuotas_RecordExceeded(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	quotas := NewQuotas(ds, map[string]Limits{"read": {DailyBytes: 10}}, nil)
+
+	token := &perms.JWTPayload{ID: "token", Allow: perms.ReadPerms}
+	require.NoError(t, quotas.Record(token, 10))
+	require.ErrorIs(t, quotas.Record(token, 1), ErrQuotaExceeded)
+	require.ErrorIs(t, quotas.Allow(token), ErrQuotaExceeded)
+}
+
+func TestQuotas_ExpireStaleUsage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	quotas := NewQuotas(ds, nil, nil)
+	yesterday := day(time.Now()).Add(-24 * time.Hour)
+
+	// the usage of the past days is expired on the flush
+	stale := &quotaEntry{usage: Usage{Identity: "stale", Day: yesterday, Requests: 1}, dirty: true}
+	quotas.entries["stale"] = stale
+	require.NoError(t, quotas.flush(ctx))
+	require.NotContains(t, quotas.entries, "stale")
+	has, err := quotas.ds.Has(ctx, datastore.NewKey("stale"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the usage of the past days left in the store is dropped on the start
+	data := []byte(`{"identity":"old","day":"` + yesterday.Format(time.RFC3339) + `","requests":1}`)
+	require.NoError(t, quotas.ds.Put(ctx, datastore.NewKey("old"), data))
+	require.NoError(t, quotas.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, quotas.Stop(ctx))
+	})
+	has, err = quotas.ds.Has(ctx, datastore.NewKey("old"))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Empty(t, quotas.entries)
+}
@@ -0,0 +1,203 @@
+package authtoken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cristalhq/jwt/v5"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+var registryPrefix = datastore.NewKey("auth_tokens")
+
+var (
+	// ErrRevoked is returned when verifying a token, which was revoked.
+	ErrRevoked = errors.New("token revoked")
+	// ErrLegacyToken is returned when verifying a token without ID if such tokens are rejected.
+	ErrLegacyToken = errors.New("token has no ID, legacy tokens are rejected")
+)
+
+// TokenInfo describes the token issued by the node.
+type TokenInfo struct {
+	ID          string            `json:"id"`
+	Label       string            `json:"label,omitempty"`
+	Permissions []auth.Permission `json:"permissions,omitempty"`
+	Scope       *perms.Scope      `json:"scope,omitempty"`
+	IssuedAt    time.Time         `json:"issued_at,omitempty"`
+	// ExpiresAt is zero for the tokens that never expire.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Revoked   bool      `json:"revoked"`
+}
+
+// Registry persists the information about the tokens issued by the node, so they can be listed
+// and revoked. Revoked IDs are kept in memory, as they are checked on every authorized request.
+// Tokens are forgotten by the Registry once expired, as they can't be used anymore.
+type Registry struct {
+	ds datastore.Datastore
+
+	lk      sync.RWMutex
+	revoked map[string]struct{}
+
+	rejectLegacy bool
+}
+
+// NewRegistry loads the Registry from the given datastore.
+func NewRegistry(ctx context.Context, ds datastore.Datastore) (*Registry, error) {
+	r := &Registry{
+		ds:      namespace.Wrap(ds, registryPrefix),
+		revoked: make(map[string]struct{}),
+	}
+
+	tokens, err := r.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading token registry: %w", err)
+	}
+	for _, info := range tokens {
+		if info.Revoked {
+			r.revoked[info.ID] = struct{}{}
+		}
+	}
+	return r, nil
+}
+
+// Register records the token issued with the given payload and label.
+func (r *Registry) Register(ctx context.Context, p *perms.JWTPayload, label string) error {
+	if p.ID == "" {
+		return errors.New("token has no ID")
+	}
+	return r.put(ctx, &TokenInfo{
+		ID:          p.ID,
+		Label:       label,
+		Permissions: p.Allow,
+		Scope:       p.Scope,
+		IssuedAt:    time.Now().UTC(),
+		ExpiresAt:   p.ExpiresAt,
+	})
+}
+
+// Revoke marks the token with the given ID as revoked. Revoking an ID unknown to the Registry is
+// allowed, so the tokens issued while the node is offline can be revoked as well.
+func (r *Registry) Revoke(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("empty token ID")
+	}
+
+	info := &TokenInfo{ID: id}
+	data, err := r.ds.Get(ctx, datastore.NewKey(id))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, info); err != nil {
+			return fmt.Errorf("unmarshaling token %s: %w", id, err)
+		}
+	case !errors.Is(err, datastore.ErrNotFound):
+		return err
+	}
+
+	info.Revoked = true
+	if err := r.put(ctx, info); err != nil {
+		return err
+	}
+
+	r.lk.Lock()
+	r.revoked[id] = struct{}{}
+	r.lk.Unlock()
+	return nil
+}
+
+// RejectLegacyTokens makes the Registry reject the tokens issued before token IDs were introduced.
+// Such tokens can't be revoked individually, so rejecting them or rotating the JWT secret of the
+// node are the only ways to invalidate them. It must be called before the Registry is used.
+func (r *Registry) RejectLegacyTokens() {
+	r.rejectLegacy = true
+}
+
+// IsRevoked reports whether the token with the given ID was revoked.
+func (r *Registry) IsRevoked(id string) bool {
+	r.lk.RLock()
+	defer r.lk.RUnlock()
+	_, ok := r.revoked[id]
+	return ok
+}
+
+// Verify returns the payload of the token signed by the passed signer,
+// unless the token is expired or revoked. Legacy tokens without ID can't be revoked,
+// so they pass the verification unless RejectLegacyTokens is set.
+func (r *Registry) Verify(verifier jwt.Verifier, token string) (*perms.JWTPayload, error) {
+	p, err := ExtractSignedPayload(verifier, token)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.ID == "" && r.rejectLegacy:
+		return nil, ErrLegacyToken
+	case p.ID != "" && r.IsRevoked(p.ID):
+		return nil, ErrRevoked
+	}
+	return p, nil
+}
+
+// List returns the information about all the non-expired tokens ordered by issue time.
+// Expired tokens are removed from the Registry.
+func (r *Registry) List(ctx context.Context) ([]*TokenInfo, error) {
+	tokens, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	active := make([]*TokenInfo, 0, len(tokens))
+	for _, info := range tokens {
+		if info.ExpiresAt.IsZero() || info.ExpiresAt.After(now) {
+			active = append(active, info)
+			continue
+		}
+		if err := r.ds.Delete(ctx, datastore.NewKey(info.ID)); err != nil {
+			return nil, fmt.Errorf("removing expired token %s: %w", info.ID, err)
+		}
+	}
+
+	slices.SortFunc(active, func(a, b *TokenInfo) int {
+		return a.IssuedAt.Compare(b.IssuedAt)
+	})
+	return active, nil
+}
+
+func (r *Registry) load(ctx context.Context) ([]*TokenInfo, error) {
+	results, err := r.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	tokens := make([]*TokenInfo, 0)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		info := &TokenInfo{}
+		if err := json.Unmarshal(result.Value, info); err != nil {
+			return nil, fmt.Errorf("unmarshaling token %s: %w", result.Key, err)
+		}
+		tokens = append(tokens, info)
+	}
+	return tokens, nil
+}
+
+func (r *Registry) put(ctx context.Context, info *TokenInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("marshaling token %s: %w", info.ID, err)
+	}
+	return r.ds.Put(ctx, datastore.NewKey(info.ID), data)
+}
@@ -0,0 +1,113 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt/v5"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+func TestRegistry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	key := []byte("test-secret-key-for-jwt-signing-32")
+	signer, err := jwt.NewSignerHS(jwt.HS256, key)
+	require.NoError(t, err)
+	verifier, err := jwt.NewVerifierHS(jwt.HS256, key)
+	require.NoError(t, err)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	registry, err := NewRegistry(ctx, ds)
+	require.NoError(t, err)
+
+	issue := func(label string, ttl time.Duration) (string, *perms.JWTPayload) {
+		p, err := perms.NewJWTPayload(perms.ReadWritePerms, nil, ttl)
+		require.NoError(t, err)
+		token, err := SignPayload(signer, p)
+		require.NoError(t, err)
+		require.NoError(t, registry.Register(ctx, p, label))
+		return token, p
+	}
+
+	token, p := issue("rollup", 0)
+	_, _ = issue("expiring", time.Hour)
+
+	tokens, err := registry.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, p.ID, tokens[0].ID)
+	require.Equal(t, "rollup", tokens[0].Label)
+	require.Equal(t, perms.ReadWritePerms, tokens[0].Permissions)
+	require.False(t, tokens[0].Revoked)
+
+	_, err = registry.Verify(verifier, token)
+	require.NoError(t, err)
+
+	require.NoError(t, registry.Revoke(ctx, p.ID))
+	_, err = registry.Verify(verifier, token)
+	require.ErrorIs(t, err, ErrRevoked)
+
+	tokens, err = registry.List(ctx)
+	require.NoError(t, err)
+	require.True(t, tokens[0].Revoked)
+
+	// revocations persist
+	registry, err = NewRegistry(ctx, ds)
+	require.NoError(t, err)
+	_, err = registry.Verify(verifier, token)
+	require.ErrorIs(t, err, ErrRevoked)
+
+	// tokens unknown to the registry can be revoked as well
+	p, err = perms.NewJWTPayload(perms.ReadPerms, nil, 0)
+	require.NoError(t, err)
+	unknown, err := SignPayload(signer, p)
+	require.NoError(t, err)
+	require.NoError(t, registry.Revoke(ctx, p.ID))
+	_, err = registry.Verify(verifier, unknown)
+	require.ErrorIs(t, err, ErrRevoked)
+
+	// expired tokens are forgotten
+	_, expired := issue("expired", time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	tokens, err = registry.List(ctx)
+	require.NoError(t, err)
+	for _, info := range tokens {
+		require.NotEqual(t, expired.ID, info.ID)
+	}
+}
+
+func TestRegistry_LegacyTokens(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	key := []byte("test-secret-key-for-jwt-signing-32")
+	signer, err := jwt.NewSignerHS(jwt.HS256, key)
+	require.NoError(t, err)
+	verifier, err := jwt.NewVerifierHS(jwt.HS256, key)
+	require.NoError(t, err)
+
+	registry, err := NewRegistry(ctx, ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+
+	legacy, err := SignPayload(signer, &perms.JWTPayload{Allow: perms.ReadPerms})
+	require.NoError(t, err)
+	_, err = registry.Verify(verifier, legacy)
+	require.NoError(t, err)
+
+	registry.RejectLegacyTokens()
+	_, err = registry.Verify(verifier, legacy)
+	require.ErrorIs(t, err, ErrLegacyToken)
+
+	// the tokens with IDs are still accepted
+	token, err := NewSignedJWT(signer, perms.ReadPerms, 0)
+	require.NoError(t, err)
+	_, err = registry.Verify(verifier, token)
+	require.NoError(t, err)
+}
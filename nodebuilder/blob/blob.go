@@ -6,6 +6,7 @@ import (
 	libshare "github.com/celestiaorg/go-square/v4/share"
 
 	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/state"
 )
 
 var _ Module = (*API)(nil)
@@ -18,6 +19,20 @@ type Module interface {
 	// Allows sending multiple Blobs atomically synchronously.
 	// Uses default wallet registered on the Node.
 	Submit(_ context.Context, _ []*blob.Blob, _ *blob.SubmitOptions) (height uint64, _ error)
+	// SubmitWithReceipt sends Blobs and returns a receipt holding the tx hash, inclusion height,
+	// share index range, commitment and commitment proof of each Blob.
+	// Optionally, waits for the given amount of confirmations and re-submits Blobs
+	// if the transaction was evicted from the mempool.
+	SubmitWithReceipt(
+		_ context.Context,
+		_ []*blob.Blob,
+		_ *blob.SubmitOptions,
+		_ *blob.ReceiptOptions,
+	) (*blob.Receipt, error)
+	// PendingSubmissions returns the submissions that were accepted by the node,
+	// but are not confirmed yet. Pending submissions survive node restarts and are
+	// retried on start.
+	PendingSubmissions(context.Context) ([]*state.PendingSubmission, error)
 	// Get retrieves the blob by commitment under the given namespace and height.
 	Get(_ context.Context, height uint64, _ libshare.Namespace, _ blob.Commitment) (*blob.Blob, error)
 	// GetAll returns all blobs under the given namespaces at the given height.
@@ -44,6 +59,21 @@ type Module interface {
 	) (*blob.CommitmentProof, error)
 	// Subscribe to published blobs from the given namespace as they are included.
 	Subscribe(_ context.Context, _ libshare.Namespace) (<-chan *blob.SubscriptionResponse, error)
+	// SubscribeFrom works as Subscribe, but a starting height or a cursor from a previous response
+	// can be provided to replay historic heights before switching to live ones without gaps or
+	// duplicates.
+	SubscribeFrom(
+		_ context.Context,
+		_ libshare.Namespace,
+		_ *blob.SubscribeOptions,
+	) (<-chan *blob.SubscriptionResponse, error)
+	// SubscribeAll works as SubscribeFrom, but streams the blobs of multiple namespaces in a single
+	// subscription with the blobs grouped by namespace.
+	SubscribeAll(
+		_ context.Context,
+		_ []libshare.Namespace,
+		_ *blob.SubscribeOptions,
+	) (<-chan *blob.SubscriptionResponse, error)
 }
 
 type API struct {
@@ -53,6 +83,15 @@ type API struct {
 			[]*blob.Blob,
 			*blob.SubmitOptions,
 		) (uint64, error) `perm:"write"`
+		SubmitWithReceipt func(
+			context.Context,
+			[]*blob.Blob,
+			*blob.SubmitOptions,
+			*blob.ReceiptOptions,
+		) (*blob.Receipt, error) `perm:"write"`
+		PendingSubmissions func(
+			context.Context,
+		) ([]*state.PendingSubmission, error) `perm:"read"`
 		Get func(
 			context.Context,
 			uint64,
@@ -87,6 +126,16 @@ type API struct {
 			context.Context,
 			libshare.Namespace,
 		) (<-chan *blob.SubscriptionResponse, error) `perm:"read"`
+		SubscribeFrom func(
+			context.Context,
+			libshare.Namespace,
+			*blob.SubscribeOptions,
+		) (<-chan *blob.SubscriptionResponse, error) `perm:"read"`
+		SubscribeAll func(
+			context.Context,
+			[]libshare.Namespace,
+			*blob.SubscribeOptions,
+		) (<-chan *blob.SubscriptionResponse, error) `perm:"read"`
 	}
 }
 
@@ -94,6 +143,19 @@ func (api *API) Submit(ctx context.Context, blobs []*blob.Blob, options *blob.Su
 	return api.Internal.Submit(ctx, blobs, options)
 }
 
+func (api *API) SubmitWithReceipt(
+	ctx context.Context,
+	blobs []*blob.Blob,
+	options *blob.SubmitOptions,
+	receiptOptions *blob.ReceiptOptions,
+) (*blob.Receipt, error) {
+	return api.Internal.SubmitWithReceipt(ctx, blobs, options, receiptOptions)
+}
+
+func (api *API) PendingSubmissions(ctx context.Context) ([]*state.PendingSubmission, error) {
+	return api.Internal.PendingSubmissions(ctx)
+}
+
 func (api *API) Get(
 	ctx context.Context,
 	height uint64,
@@ -141,3 +203,19 @@ func (api *API) Subscribe(
 ) (<-chan *blob.SubscriptionResponse, error) {
 	return api.Internal.Subscribe(ctx, namespace)
 }
+
+func (api *API) SubscribeFrom(
+	ctx context.Context,
+	namespace libshare.Namespace,
+	options *blob.SubscribeOptions,
+) (<-chan *blob.SubscriptionResponse, error) {
+	return api.Internal.SubscribeFrom(ctx, namespace, options)
+}
+
+func (api *API) SubscribeAll(
+	ctx context.Context,
+	namespaces []libshare.Namespace,
+	options *blob.SubscribeOptions,
+) (<-chan *blob.SubscriptionResponse, error) {
+	return api.Internal.SubscribeAll(ctx, namespaces, options)
+}
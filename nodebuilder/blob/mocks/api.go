@@ -9,7 +9,7 @@ import (
 	reflect "reflect"
 
 	blob "github.com/celestiaorg/celestia-node/blob"
-	state "github.com/celestiaorg/celestia-node/state"
+	txclient "github.com/celestiaorg/celestia-node/state/txclient"
 	share "github.com/celestiaorg/go-square/v4/share"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -112,8 +112,23 @@ func (mr *MockModuleMockRecorder) Included(arg0, arg1, arg2, arg3, arg4 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Included", reflect.TypeOf((*MockModule)(nil).Included), arg0, arg1, arg2, arg3, arg4)
 }
 
+// PendingSubmissions mocks base method.
+func (m *MockModule) PendingSubmissions(arg0 context.Context) ([]*txclient.PendingSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingSubmissions", arg0)
+	ret0, _ := ret[0].([]*txclient.PendingSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingSubmissions indicates an expected call of PendingSubmissions.
+func (mr *MockModuleMockRecorder) PendingSubmissions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingSubmissions", reflect.TypeOf((*MockModule)(nil).PendingSubmissions), arg0)
+}
+
 // Submit mocks base method.
-func (m *MockModule) Submit(arg0 context.Context, arg1 []*blob.Blob, arg2 *state.TxConfig) (uint64, error) {
+func (m *MockModule) Submit(arg0 context.Context, arg1 []*blob.Blob, arg2 *txclient.TxConfig) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Submit", arg0, arg1, arg2)
 	ret0, _ := ret[0].(uint64)
@@ -127,6 +142,21 @@ func (mr *MockModuleMockRecorder) Submit(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockModule)(nil).Submit), arg0, arg1, arg2)
 }
 
+// SubmitWithReceipt mocks base method.
+func (m *MockModule) SubmitWithReceipt(arg0 context.Context, arg1 []*blob.Blob, arg2 *txclient.TxConfig, arg3 *blob.ReceiptOptions) (*blob.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitWithReceipt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*blob.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitWithReceipt indicates an expected call of SubmitWithReceipt.
+func (mr *MockModuleMockRecorder) SubmitWithReceipt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitWithReceipt", reflect.TypeOf((*MockModule)(nil).SubmitWithReceipt), arg0, arg1, arg2, arg3)
+}
+
 // Subscribe mocks base method.
 func (m *MockModule) Subscribe(arg0 context.Context, arg1 share.Namespace) (<-chan *blob.SubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -141,3 +171,33 @@ func (mr *MockModuleMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockModule)(nil).Subscribe), arg0, arg1)
 }
+
+// SubscribeAll mocks base method.
+func (m *MockModule) SubscribeAll(arg0 context.Context, arg1 []share.Namespace, arg2 *blob.SubscribeOptions) (<-chan *blob.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan *blob.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeAll indicates an expected call of SubscribeAll.
+func (mr *MockModuleMockRecorder) SubscribeAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeAll", reflect.TypeOf((*MockModule)(nil).SubscribeAll), arg0, arg1, arg2)
+}
+
+// SubscribeFrom mocks base method.
+func (m *MockModule) SubscribeFrom(arg0 context.Context, arg1 share.Namespace, arg2 *blob.SubscribeOptions) (<-chan *blob.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeFrom", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan *blob.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFrom indicates an expected call of SubscribeFrom.
+func (mr *MockModuleMockRecorder) SubscribeFrom(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFrom", reflect.TypeOf((*MockModule)(nil).SubscribeFrom), arg0, arg1, arg2)
+}
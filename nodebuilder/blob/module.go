@@ -10,6 +10,7 @@ import (
 	headerService "github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
 	"github.com/celestiaorg/celestia-node/share/shwap"
+	"github.com/celestiaorg/celestia-node/store"
 )
 
 func ConstructModule() fx.Option {
@@ -40,6 +41,21 @@ func ConstructModule() fx.Option {
 				return serv.Stop(ctx)
 			}),
 		)),
+		fx.Invoke(func(serv *blob.Service, header headerService.Module) {
+			serv.WithHeadGetter(header.LocalHead)
+		}),
+		// the store getter is only provided on the nodes storing the squares,
+		// so blobs can be read from the local namespace index
+		fx.Invoke(func(params struct {
+			fx.In
+			Service *blob.Service
+			Getter  *store.Getter `optional:"true"`
+		},
+		) {
+			if params.Getter != nil {
+				params.Service.WithNamespaceSharesGetter(params.Getter)
+			}
+		}),
 		fx.Provide(func(serv *blob.Service) Module {
 			return serv
 		}),
@@ -23,6 +23,15 @@ type Module interface {
 		ctx context.Context,
 		height, start, end uint64,
 	) (*DataRootTupleInclusionProof, error)
+
+	// GetDataRootTupleInclusionProofs creates the inclusion proofs, for the data root tuples of
+	// the blocks of `heights`, in the set of blocks defined by `start` and `end`. The proofs are
+	// in the order of the heights. The range is end exclusive.
+	GetDataRootTupleInclusionProofs(
+		ctx context.Context,
+		heights []uint64,
+		start, end uint64,
+	) ([]*DataRootTupleInclusionProof, error)
 }
 
 // API is a wrapper around the Module for RPC.
@@ -33,6 +42,11 @@ type API struct {
 			ctx context.Context,
 			height, start, end uint64,
 		) (*DataRootTupleInclusionProof, error) `perm:"read"`
+		GetDataRootTupleInclusionProofs func(
+			ctx context.Context,
+			heights []uint64,
+			start, end uint64,
+		) ([]*DataRootTupleInclusionProof, error) `perm:"read"`
 	}
 }
 
@@ -46,3 +60,11 @@ func (api *API) GetDataRootTupleInclusionProof(
 ) (*DataRootTupleInclusionProof, error) {
 	return api.Internal.GetDataRootTupleInclusionProof(ctx, height, start, end)
 }
+
+func (api *API) GetDataRootTupleInclusionProofs(
+	ctx context.Context,
+	heights []uint64,
+	start, end uint64,
+) ([]*DataRootTupleInclusionProof, error) {
+	return api.Internal.GetDataRootTupleInclusionProofs(ctx, heights, start, end)
+}
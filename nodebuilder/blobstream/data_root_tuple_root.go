@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/cometbft/cometbft/crypto/merkle"
@@ -22,6 +23,12 @@ type DataRootTupleRoot = bytes.HexBytes
 // inclusion proof of a height to a data commitment.
 type DataRootTupleInclusionProof merkle.Proof
 
+// dataRootTupleRange is an end exclusive range of heights a data root tuple root is
+// created over.
+type dataRootTupleRange struct {
+	start, end uint64
+}
+
 // padBytes Pad bytes to given length
 func padBytes(byt []byte, length int) ([]byte, error) {
 	l := len(byt)
@@ -133,14 +140,53 @@ func (s *Service) validateDataRootTupleRootRange(ctx context.Context, start, end
 	return nil
 }
 
-// hashDataRootTuples hashes a list of encoded blocks data root tuples, i.e., height, data root and
-// square size, then returns their merkle root.
-func hashDataRootTuples(encodedDataRootTuples [][]byte) ([]byte, error) {
+// dataRootTupleTree is the merkle tree over the encoded data root tuples of an end exclusive
+// range of heights. It keeps the inclusion proofs of every height, so proving any of them
+// once the tree is built doesn't cost any hashing.
+type dataRootTupleTree struct {
+	start  uint64
+	root   []byte
+	proofs []*merkle.Proof
+}
+
+// newDataRootTupleTree builds the merkle tree over a list of encoded blocks data root tuples,
+// i.e., height, data root and square size, of the consecutive heights starting from start.
+func newDataRootTupleTree(encodedDataRootTuples [][]byte, start uint64) (*dataRootTupleTree, error) {
 	if len(encodedDataRootTuples) == 0 {
 		return nil, fmt.Errorf("cannot hash an empty list of encoded data root tuples")
 	}
-	root := merkle.HashFromByteSlices(encodedDataRootTuples)
-	return root, nil
+	if start == 0 {
+		return nil, errHeightZero
+	}
+	root, proofs := merkle.ProofsFromByteSlices(encodedDataRootTuples)
+	return &dataRootTupleTree{
+		start:  start,
+		root:   root,
+		proofs: proofs,
+	}, nil
+}
+
+// prove returns the merkle inclusion proof for a height. The proof is a copy, so the cached tree
+// is not affected by the callers modifying it.
+func (t *dataRootTupleTree) prove(height uint64) (*merkle.Proof, error) {
+	if height == 0 {
+		return nil, errHeightZero
+	}
+	if height < t.start || height-t.start >= uint64(len(t.proofs)) {
+		return nil, fmt.Errorf(
+			"height %d should be in the end exclusive interval first_block %d last_block %d",
+			height,
+			t.start,
+			t.start+uint64(len(t.proofs)),
+		)
+	}
+	proof := *t.proofs[height-t.start]
+	proof.LeafHash = slices.Clone(proof.LeafHash)
+	proof.Aunts = make([][]byte, len(proof.Aunts))
+	for i, aunt := range t.proofs[height-t.start].Aunts {
+		proof.Aunts[i] = slices.Clone(aunt)
+	}
+	return &proof, nil
 }
 
 // validateDataRootInclusionProofRequest validates the request to generate a data root
@@ -153,6 +199,11 @@ func (s *Service) validateDataRootInclusionProofRequest(
 	if err != nil {
 		return err
 	}
+	return validateHeightInRange(height, start, end)
+}
+
+// validateHeightInRange checks the height is within the end exclusive range.
+func validateHeightInRange(height, start, end uint64) error {
 	if height < start || height >= end {
 		return fmt.Errorf(
 			"height %d should be in the end exclusive interval first_block %d last_block %d",
@@ -164,19 +215,6 @@ func (s *Service) validateDataRootInclusionProofRequest(
 	return nil
 }
 
-// proveDataRootTuples returns the merkle inclusion proof for a height.
-// expects the list of encoded data root tuples to be ordered and the heights to be consecutive.
-func proveDataRootTuples(encodedDataRootTuples [][]byte, rangeStartHeight, height uint64) (*merkle.Proof, error) {
-	if len(encodedDataRootTuples) == 0 {
-		return nil, fmt.Errorf("cannot prove an empty list of encoded data root tuples")
-	}
-	if height == 0 || rangeStartHeight == 0 {
-		return nil, errHeightZero
-	}
-	_, proofs := merkle.ProofsFromByteSlices(encodedDataRootTuples)
-	return proofs[height-rangeStartHeight], nil
-}
-
 // fetchEncodedDataRootTuples takes an end exclusive range of heights and fetches its
 // corresponding data root tuples.
 // end is not included in the range.
@@ -51,6 +51,21 @@ func (mr *MockModuleMockRecorder) GetDataRootTupleInclusionProof(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataRootTupleInclusionProof", reflect.TypeOf((*MockModule)(nil).GetDataRootTupleInclusionProof), arg0, arg1, arg2, arg3)
 }
 
+// GetDataRootTupleInclusionProofs mocks base method.
+func (m *MockModule) GetDataRootTupleInclusionProofs(arg0 context.Context, arg1 []uint64, arg2, arg3 uint64) ([]*blobstream.DataRootTupleInclusionProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataRootTupleInclusionProofs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*blobstream.DataRootTupleInclusionProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataRootTupleInclusionProofs indicates an expected call of GetDataRootTupleInclusionProofs.
+func (mr *MockModuleMockRecorder) GetDataRootTupleInclusionProofs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataRootTupleInclusionProofs", reflect.TypeOf((*MockModule)(nil).GetDataRootTupleInclusionProofs), arg0, arg1, arg2, arg3)
+}
+
 // GetDataRootTupleRoot mocks base method.
 func (m *MockModule) GetDataRootTupleRoot(arg0 context.Context, arg1, arg2 uint64) (bytes.HexBytes, error) {
 	m.ctrl.T.Helper()
@@ -2,8 +2,12 @@ package blobstream
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	logging "github.com/ipfs/go-log/v2"
+	"golang.org/x/sync/singleflight"
 
 	libhead "github.com/celestiaorg/go-header"
 
@@ -14,14 +18,33 @@ var _ Module = (*Service)(nil)
 
 var log = logging.Logger("go-blobstream")
 
+// dataRootTupleTreesCacheSize is the amount of the most recently requested ranges the data root
+// tuple trees are kept for. A tree of the largest allowed range takes a few megabytes.
+const dataRootTupleTreesCacheSize = 16
+
+// dataRootTupleTreeTimeout bounds building a data root tuple tree. The tree is shared by all the
+// requests waiting for it, so it is built independently of the request that started building it.
+const dataRootTupleTreeTimeout = time.Minute
+
 type Service struct {
 	headerGetter libhead.Getter[*header.ExtendedHeader]
+
+	// trees caches the data root tuple trees by their range, so the proofs over the same range
+	// are not recomputed from the header store on every request.
+	trees *lru.Cache[dataRootTupleRange, *dataRootTupleTree]
+	// building dedups the concurrent requests building the tree of the same range.
+	building singleflight.Group
 }
 
-func NewService(store libhead.Store[*header.ExtendedHeader]) *Service {
+func NewService(store libhead.Store[*header.ExtendedHeader]) (*Service, error) {
+	trees, err := lru.New[dataRootTupleRange, *dataRootTupleTree](dataRootTupleTreesCacheSize)
+	if err != nil {
+		return nil, err
+	}
 	return &Service{
 		headerGetter: store,
-	}
+		trees:        trees,
+	}, nil
 }
 
 // GetDataRootTupleRoot collects the data roots over a provided ordered range of blocks,
@@ -32,13 +55,11 @@ func (s *Service) GetDataRootTupleRoot(ctx context.Context, start, end uint64) (
 	if err != nil {
 		return nil, err
 	}
-	log.Debugw("fetching the data root tuples", "start", start, "end", end)
-	encodedDataRootTuples, err := s.fetchEncodedDataRootTuples(ctx, start, end)
+	tree, err := s.dataRootTupleTree(ctx, start, end)
 	if err != nil {
 		return nil, err
 	}
-	log.Debugw("hashing the data root tuples", "start", start, "end", end)
-	return hashDataRootTuples(encodedDataRootTuples)
+	return tree.root, nil
 }
 
 // GetDataRootTupleInclusionProof creates an inclusion proof for the data root of block
@@ -61,16 +82,98 @@ func (s *Service) GetDataRootTupleInclusionProof(
 	if err != nil {
 		return nil, err
 	}
-	log.Debugw("fetching the data root tuples", "start", start, "end", end)
-
-	encodedDataRootTuples, err := s.fetchEncodedDataRootTuples(ctx, start, end)
+	tree, err := s.dataRootTupleTree(ctx, start, end)
 	if err != nil {
 		return nil, err
 	}
 	log.Debugw("proving the data root tuples", "start", start, "end", end)
-	proof, err := proveDataRootTuples(encodedDataRootTuples, start, height)
+	proof, err := tree.prove(height)
 	if err != nil {
 		return nil, err
 	}
 	return (*DataRootTupleInclusionProof)(proof), nil
 }
+
+// GetDataRootTupleInclusionProofs creates the inclusion proofs for the data roots of the
+// blocks of the given heights in the set of blocks defined by `start` and `end`, in the
+// order of the heights. The range is end exclusive.
+func (s *Service) GetDataRootTupleInclusionProofs(
+	ctx context.Context,
+	heights []uint64,
+	start, end uint64,
+) ([]*DataRootTupleInclusionProof, error) {
+	log.Debugw("validating the data root inclusion proofs request",
+		"start", start, "end", end, "heights", len(heights))
+	if len(heights) == 0 {
+		return nil, fmt.Errorf("no heights to prove")
+	}
+	if len(heights) > dataRootTupleRootBlocksLimit {
+		return nil, fmt.Errorf("the query exceeds the limit of allowed heights %d", dataRootTupleRootBlocksLimit)
+	}
+	err := s.validateDataRootTupleRootRange(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, height := range heights {
+		if err := validateHeightInRange(height, start, end); err != nil {
+			return nil, err
+		}
+	}
+	tree, err := s.dataRootTupleTree(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugw("proving the data root tuples", "start", start, "end", end, "heights", len(heights))
+	proofs := make([]*DataRootTupleInclusionProof, len(heights))
+	for i, height := range heights {
+		proof, err := tree.prove(height)
+		if err != nil {
+			return nil, err
+		}
+		proofs[i] = (*DataRootTupleInclusionProof)(proof)
+	}
+	return proofs, nil
+}
+
+// dataRootTupleTree returns the data root tuple tree over the validated range, building it from
+// the header store if it isn't cached yet. The concurrent requests over the same range wait for
+// the same tree to be built, each until its own context is done.
+func (s *Service) dataRootTupleTree(ctx context.Context, start, end uint64) (*dataRootTupleTree, error) {
+	rng := dataRootTupleRange{start: start, end: end}
+	if tree, ok := s.trees.Get(rng); ok {
+		return tree, nil
+	}
+
+	key := fmt.Sprintf("%d-%d", start, end)
+	resCh := s.building.DoChan(key, func() (any, error) {
+		if tree, ok := s.trees.Get(rng); ok {
+			return tree, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), dataRootTupleTreeTimeout)
+		defer cancel()
+		log.Debugw("fetching the data root tuples", "start", start, "end", end)
+		encodedDataRootTuples, err := s.fetchEncodedDataRootTuples(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+		log.Debugw("hashing the data root tuples", "start", start, "end", end)
+		tree, err := newDataRootTupleTree(encodedDataRootTuples, start)
+		if err != nil {
+			return nil, err
+		}
+		s.trees.Add(rng, tree)
+		return tree, nil
+	})
+
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*dataRootTupleTree), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -1,15 +1,23 @@
 package blobstream
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
 )
 
 func TestPadBytes(t *testing.T) {
@@ -132,11 +140,12 @@ func TestHashDataRootTuples(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			result, err := hashDataRootTuples(tc.tuples)
+			tree, err := newDataRootTupleTree(tc.tuples, 1)
 			if tc.expectErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				result := tree.root
 				assert.Equal(t, tc.expectedHash, result)
 
 				res := bytes.HexBytes(result)
@@ -160,9 +169,10 @@ func TestProveDataRootTuples(t *testing.T) {
 		expectedProof merkle.Proof
 		expectErr     bool
 	}{
-		"empty tuples list":       {tuples: [][]byte{{0x1}}, expectErr: true},
-		"start height == 0":       {tuples: [][]byte{{0x1}}, expectErr: true},
-		"range start height == 0": {tuples: [][]byte{{0x1}}, expectErr: true},
+		"empty tuples list":       {tuples: nil, rangeStart: 1, expectErr: true},
+		"start height == 0":       {tuples: [][]byte{{0x1}}, rangeStart: 1, expectErr: true},
+		"range start height == 0": {tuples: [][]byte{{0x1}}, height: 1, expectErr: true},
+		"height out of range":     {tuples: [][]byte{{0x1}}, height: 2, rangeStart: 1, expectErr: true},
 		"valid proof": {
 			height:     3,
 			rangeStart: 1,
@@ -186,7 +196,11 @@ func TestProveDataRootTuples(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			result, err := proveDataRootTuples(tc.tuples, tc.rangeStart, tc.height)
+			tree, err := newDataRootTupleTree(tc.tuples, tc.rangeStart)
+			var result *merkle.Proof
+			if err == nil {
+				result, err = tree.prove(tc.height)
+			}
 			if tc.expectErr {
 				assert.Error(t, err)
 			} else {
@@ -200,7 +214,118 @@ func TestProveDataRootTuples(t *testing.T) {
 				err = json.Unmarshal(data, &newDtProof)
 				require.NoError(t, err)
 				assert.Equal(t, dtProof, newDtProof)
+
+				// the tree hands out copies of its proofs
+				result.Aunts[0][0] ^= 0xff
+				again, err := tree.prove(tc.height)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedProof, *again)
 			}
 		})
 	}
 }
+
+func TestService_DataRootTupleInclusionProofs(t *testing.T) {
+	ctx := t.Context()
+
+	store := headertest.NewStore(t)
+	service, err := NewService(store)
+	require.NoError(t, err)
+	getter := &countingGetter{Getter: store}
+	service.headerGetter = getter
+
+	const start, end = 1, 9
+	root, err := service.GetDataRootTupleRoot(ctx, start, end)
+	require.NoError(t, err)
+
+	heights := []uint64{3, 1, 8}
+	getter.heads.Store(0)
+	proofs, err := service.GetDataRootTupleInclusionProofs(ctx, heights, start, end)
+	require.NoError(t, err)
+	require.Len(t, proofs, len(heights))
+	// the range is validated once rather than per height
+	assert.EqualValues(t, 1, getter.heads.Load())
+	for i, height := range heights {
+		eh, err := store.GetByHeight(ctx, height)
+		require.NoError(t, err)
+		tuple, err := encodeDataRootTuple(height, *(*[32]byte)(eh.DataHash))
+		require.NoError(t, err)
+		require.NoError(t, (*merkle.Proof)(proofs[i]).Verify(root, tuple))
+
+		proof, err := service.GetDataRootTupleInclusionProof(ctx, height, start, end)
+		require.NoError(t, err)
+		assert.Equal(t, proof, proofs[i])
+	}
+	// the tree over the range is built from the headers once
+	assert.EqualValues(t, 1, getter.ranges.Load())
+
+	_, err = service.GetDataRootTupleInclusionProofs(ctx, []uint64{2, end}, start, end)
+	require.Error(t, err, "a height outside the range must be refused")
+	_, err = service.GetDataRootTupleInclusionProofs(ctx, nil, start, end)
+	require.Error(t, err)
+}
+
+func TestService_DataRootTupleTreeOutlivesCanceledRequest(t *testing.T) {
+	store := headertest.NewStore(t)
+	service, err := NewService(store)
+	require.NoError(t, err)
+	getter := &countingGetter{Getter: store, release: make(chan struct{})}
+	service.headerGetter = getter
+
+	const start, end = 1, 9
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := service.GetDataRootTupleRoot(ctx, start, end)
+		errCh <- err
+	}()
+	require.Eventually(t, func() bool { return getter.ranges.Load() == 1 }, time.Second, time.Millisecond)
+
+	// the request that started building the tree gives up
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+
+	// while the tree keeps being built for the others
+	rootCh := make(chan DataRootTupleRoot, 1)
+	go func() {
+		root, err := service.GetDataRootTupleRoot(t.Context(), start, end)
+		assert.NoError(t, err)
+		rootCh <- root
+	}()
+	close(getter.release)
+	assert.NotEmpty(t, <-rootCh)
+	assert.EqualValues(t, 1, getter.ranges.Load())
+}
+
+// countingGetter counts the heads and the ranges of headers requested from the wrapped Getter.
+// If release is set, the ranges are held back until it is closed.
+type countingGetter struct {
+	libhead.Getter[*header.ExtendedHeader]
+	heads   atomic.Int32
+	ranges  atomic.Int32
+	release chan struct{}
+}
+
+func (g *countingGetter) Head(
+	ctx context.Context,
+	opts ...libhead.HeadOption[*header.ExtendedHeader],
+) (*header.ExtendedHeader, error) {
+	g.heads.Add(1)
+	return g.Getter.Head(ctx, opts...)
+}
+
+func (g *countingGetter) GetRangeByHeight(
+	ctx context.Context,
+	from *header.ExtendedHeader,
+	to uint64,
+) ([]*header.ExtendedHeader, error) {
+	g.ranges.Add(1)
+	if g.release != nil {
+		select {
+		case <-g.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return g.Getter.GetRangeByHeight(ctx, from, to)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	cmdnode "github.com/celestiaorg/celestia-node/cmd"
+	"github.com/celestiaorg/celestia-node/nodebuilder/core"
+)
+
+const (
+	tlsFlag        = "tls"
+	xtokenPathFlag = "xtoken.path"
+)
+
+func init() {
+	addSourceCmd.Flags().Bool(tlsFlag, false, "Enables TLS for the connection to the core endpoint")
+	addSourceCmd.Flags().String(
+		xtokenPathFlag,
+		"",
+		"Path to the directory with the JSON file holding the X-Token for the core endpoint. Requires TLS",
+	)
+	Cmd.AddCommand(sourcesCmd, addSourceCmd, removeSourceCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:               "core [command]",
+	Short:             "Allows to manage the core endpoints of the bridge node via JSON-RPC",
+	Args:              cobra.NoArgs,
+	PersistentPreRunE: cmdnode.InitClient,
+}
+
+var sourcesCmd = &cobra.Command{
+	Use:   "sources",
+	Short: "Returns the health of every core endpoint the bridge node ingests blocks from",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		health, err := client.Core.SourcesHealth(cmd.Context())
+		return cmdnode.PrintOutput(health, err, nil)
+	},
+}
+
+var addSourceCmd = &cobra.Command{
+	Use:   "add-source [ip] [port]",
+	Short: "Starts ingesting blocks from the given core endpoint. The endpoint isn't persisted to the config.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		tls, err := cmd.Flags().GetBool(tlsFlag)
+		if err != nil {
+			return err
+		}
+		xtokenPath, err := cmd.Flags().GetString(xtokenPathFlag)
+		if err != nil {
+			return err
+		}
+
+		err = client.Core.AddSource(cmd.Context(), core.EndpointConfig{
+			IP:         args[0],
+			Port:       args[1],
+			TLSEnabled: tls,
+			XTokenPath: xtokenPath,
+		})
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+		return sourcesCmd.RunE(cmd, nil)
+	},
+}
+
+var removeSourceCmd = &cobra.Command{
+	Use:   "remove-source [ip:port]",
+	Short: "Stops ingesting blocks from the given core endpoint",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		err = client.Core.RemoveSource(cmd.Context(), args[0])
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+		return sourcesCmd.RunE(cmd, nil)
+	},
+}
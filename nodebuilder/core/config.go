@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 
+	"github.com/celestiaorg/celestia-node/core"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 )
 
@@ -26,8 +28,64 @@ type Config struct {
 	// Configurable only via the config file — there is intentionally no CLI flag. Each entry is
 	// a full EndpointConfig (IP, Port, TLSEnabled, XTokenPath): the primary endpoint keeps its
 	// --core.* flags for quick start, while this structured, possibly-secured list belongs in
-	// config rather than being flattened into flags.
+	// config rather than being flattened into flags. Block sources can also be added and removed
+	// at runtime over the "core" RPC module; those changes aren't persisted to the config.
 	AdditionalCoreEndpoints []EndpointConfig
+	// BlockQuorum is the amount of core endpoints, the primary one included, that must commit to
+	// the same block before the bridge constructs and broadcasts its header. Endpoints disagreeing
+	// on a block make the bridge refuse it. BlockQuorum of 0 or 1 trusts whichever endpoint
+	// announces a block first.
+	BlockQuorum int `toml:",omitempty"`
+	// Backfill configures filling the gaps in the stored blocks of the bridge from the core
+	// endpoints, e.g. the ones left by an outage (see core.Backfiller).
+	Backfill BackfillConfig
+	// BlockFetch configures fetching blocks from the core endpoints (see core.RetryPolicy).
+	BlockFetch BlockFetchConfig
+}
+
+// BackfillConfig configures the backfilling of the blocks missing from the bridge's store.
+type BackfillConfig struct {
+	// Enabled enables the backfilling.
+	Enabled bool
+	// BlocksPerSecond is the maximum amount of blocks fetched from the core endpoint per second.
+	BlocksPerSecond float64
+	// Interval is the interval between the scans for missing blocks.
+	Interval time.Duration
+}
+
+// BlockFetchConfig configures the retry policy for fetching blocks from the core endpoints. The zero
+// values, e.g. in configs predating it, keep the defaults of core.DefaultRetryPolicy.
+type BlockFetchConfig struct {
+	// AttemptTimeout bounds a single fetch attempt. Large blocks over slow links may need more.
+	AttemptTimeout time.Duration
+	// MaxAttempts is the maximum amount of attempts to fetch a block, the first one included.
+	// With multiple core endpoints, every retry falls over to an endpoint not tried yet.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubling with every next one.
+	Backoff time.Duration
+	// MaxBackoff caps the delay between the retries. Left unset, it is raised to Backoff if the
+	// default is lower.
+	MaxBackoff time.Duration
+}
+
+// RetryPolicy returns the core.RetryPolicy configured by the BlockFetchConfig.
+func (cfg BlockFetchConfig) RetryPolicy() core.RetryPolicy {
+	policy := core.DefaultRetryPolicy()
+	if cfg.AttemptTimeout != 0 {
+		policy.AttemptTimeout = cfg.AttemptTimeout
+	}
+	if cfg.MaxAttempts != 0 {
+		policy.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.Backoff != 0 {
+		policy.Backoff = cfg.Backoff
+	}
+	if cfg.MaxBackoff != 0 {
+		policy.MaxBackoff = cfg.MaxBackoff
+	} else {
+		policy.MaxBackoff = max(policy.MaxBackoff, policy.Backoff)
+	}
+	return policy
 }
 
 type EndpointConfig struct {
@@ -52,6 +110,22 @@ func DefaultConfig() Config {
 			Port: DefaultPort,
 		},
 		AdditionalCoreEndpoints: make([]EndpointConfig, 0),
+		Backfill: BackfillConfig{
+			Enabled:         true,
+			BlocksPerSecond: core.DefaultBackfillRate,
+			Interval:        core.DefaultBackfillInterval,
+		},
+		BlockFetch: defaultBlockFetchConfig(),
+	}
+}
+
+func defaultBlockFetchConfig() BlockFetchConfig {
+	policy := core.DefaultRetryPolicy()
+	return BlockFetchConfig{
+		AttemptTimeout: policy.AttemptTimeout,
+		MaxAttempts:    policy.MaxAttempts,
+		Backoff:        policy.Backoff,
+		MaxBackoff:     policy.MaxBackoff,
 	}
 }
 
@@ -84,6 +158,23 @@ func (cfg *Config) Validate() error {
 		seen[key] = struct{}{}
 	}
 
+	if endpoints := 1 + len(cfg.AdditionalCoreEndpoints); cfg.BlockQuorum < 0 || cfg.BlockQuorum > endpoints {
+		return fmt.Errorf("nodebuilder/core: BlockQuorum %d must be within [0, %d]", cfg.BlockQuorum, endpoints)
+	}
+
+	if cfg.Backfill.Enabled {
+		if cfg.Backfill.BlocksPerSecond <= 0 {
+			return fmt.Errorf("nodebuilder/core: Backfill.BlocksPerSecond must be positive")
+		}
+		if cfg.Backfill.Interval <= 0 {
+			return fmt.Errorf("nodebuilder/core: Backfill.Interval must be positive")
+		}
+	}
+
+	if err := cfg.BlockFetch.RetryPolicy().Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/core: invalid BlockFetch: %w", err)
+	}
+
 	return nil
 }
 
@@ -2,8 +2,11 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/core"
 )
 
 func TestValidate(t *testing.T) {
@@ -142,6 +145,94 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "block quorum within the endpoints",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				AdditionalCoreEndpoints: []EndpointConfig{
+					{
+						IP:   "248.249.255.138",
+						Port: "4040",
+					},
+				},
+				BlockQuorum: 2,
+			},
+			expectErr: false,
+		},
+		{
+			name: "block quorum exceeds the endpoints",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				AdditionalCoreEndpoints: []EndpointConfig{
+					{
+						IP:   "248.249.255.138",
+						Port: "4040",
+					},
+				},
+				BlockQuorum: 3,
+			},
+			expectErr: true,
+		},
+		{
+			name: "backfill without rate",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				Backfill: BackfillConfig{
+					Enabled:  true,
+					Interval: core.DefaultBackfillInterval,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "block fetch with custom timeout",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				BlockFetch: BlockFetchConfig{
+					AttemptTimeout: time.Minute,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "block fetch with backoff above default max backoff",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				BlockFetch: BlockFetchConfig{
+					Backoff: time.Minute,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "block fetch with max backoff below backoff",
+			cfg: Config{
+				EndpointConfig: EndpointConfig{
+					IP:   "127.0.0.1",
+					Port: DefaultPort,
+				},
+				BlockFetch: BlockFetchConfig{
+					Backoff:    time.Minute,
+					MaxBackoff: time.Second,
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
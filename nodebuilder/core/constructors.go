@@ -49,17 +49,52 @@ type AdditionalCoreConns []*grpc.ClientConn
 // the primary core endpoint with any AdditionalCoreEndpoints into a MultiSource
 // so the Listener ingests new blocks from all configured endpoints concurrently
 // and tolerates any single endpoint failing. With no additional endpoints it is
-// equivalent to a single-source fetcher.
-func newCoreFetcher(primary *grpc.ClientConn, additional AdditionalCoreConns) core.Fetcher {
+// equivalent to a single-source fetcher. With BlockQuorum configured, the
+// MultiSource hands out only the blocks enough of the endpoints agree on.
+func newCoreFetcher(cfg Config, primary *grpc.ClientConn, additional AdditionalCoreConns) (*core.MultiSource, error) {
 	conns := make([]*grpc.ClientConn, 0, 1+len(additional))
 	conns = append(conns, primary)
 	conns = append(conns, additional...)
-	return core.NewMultiSource(conns...)
+	var multiSource *core.MultiSource
+	if cfg.BlockQuorum > 1 {
+		var err error
+		multiSource, err = core.NewQuorumMultiSource(cfg.BlockQuorum, conns...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		multiSource = core.NewMultiSource(conns...)
+	}
+	if MetricsEnabled {
+		if err := multiSource.WithMetrics(); err != nil {
+			return nil, err
+		}
+	}
+	return multiSource, nil
 }
 
 // TODO @renaynay: should we make this reusable so we can have all auth + other features
 // for the estimator service too?
 func grpcClient(lc fx.Lifecycle, cfg EndpointConfig) (*grpc.ClientConn, error) {
+	conn, err := dialCore(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return connect(ctx, conn)
+		},
+		OnStop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+	return conn, nil
+}
+
+// dialCore creates the gRPC client of the core endpoint. The connection is
+// established lazily, see connect.
+func dialCore(cfg EndpointConfig) (*grpc.ClientConn, error) {
 	var opts []grpc.DialOption
 	if cfg.TLSEnabled {
 		opts = append(opts, grpc.WithTransportCredentials(
@@ -109,34 +144,25 @@ func grpcClient(lc fx.Lifecycle, cfg EndpointConfig) (*grpc.ClientConn, error) {
 		}),
 	)
 
-	conn, err := grpc.NewClient(net.JoinHostPort(cfg.IP, cfg.Port), opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	endpoint := net.JoinHostPort(cfg.IP, cfg.Port)
+	return grpc.NewClient(net.JoinHostPort(cfg.IP, cfg.Port), opts...)
+}
 
-	lc.Append(fx.Hook{
-		OnStart: func(ctx context.Context) error {
-			conn.Connect()
-			for {
-				state := conn.GetState()
-				if state == connectivity.Ready {
-					return nil
-				}
-				if !conn.WaitForStateChange(ctx, state) {
-					return fmt.Errorf(
-						"couldn't connect to core endpoint %s; verify --core.ip is correct "+
-							"and the consensus node is reachable", endpoint,
-					)
-				}
-			}
-		},
-		OnStop: func(context.Context) error {
-			return conn.Close()
-		},
-	})
-	return conn, nil
+// connect connects the client to its core endpoint, blocking until the
+// connection is ready or ctx is done.
+func connect(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf(
+				"couldn't connect to core endpoint %s; verify --core.ip is correct "+
+					"and the consensus node is reachable", conn.Target(),
+			)
+		}
+	}
 }
 
 func additionalCoreEndpointGrpcClients(lc fx.Lifecycle, cfg Config) (AdditionalCoreConns, error) {
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-node/core"
+)
+
+var _ Module = (*API)(nil)
+
+//go:generate mockgen -destination=mocks/api.go -package=mocks . Module
+type Module interface {
+	// SourcesHealth returns the health of every core endpoint, the ones pruned for failing
+	// verification included.
+	SourcesHealth(ctx context.Context) ([]core.SourceHealth, error)
+	// AddSource connects to the given core endpoint and starts ingesting blocks from it once it
+	// is verified to be on the expected network. The endpoint isn't persisted to the config.
+	AddSource(ctx context.Context, endpoint EndpointConfig) error
+	// RemoveSource stops ingesting blocks from the core endpoint under the given address
+	// ("ip:port"). The last active endpoint can't be removed.
+	RemoveSource(ctx context.Context, addr string) error
+}
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		SourcesHealth func(ctx context.Context) ([]core.SourceHealth, error)   `perm:"admin"`
+		AddSource     func(ctx context.Context, endpoint EndpointConfig) error `perm:"admin"`
+		RemoveSource  func(ctx context.Context, addr string) error             `perm:"admin"`
+	}
+}
+
+func (api *API) SourcesHealth(ctx context.Context) ([]core.SourceHealth, error) {
+	return api.Internal.SourcesHealth(ctx)
+}
+
+func (api *API) AddSource(ctx context.Context, endpoint EndpointConfig) error {
+	return api.Internal.AddSource(ctx, endpoint)
+}
+
+func (api *API) RemoveSource(ctx context.Context, addr string) error {
+	return api.Internal.RemoveSource(ctx, addr)
+}
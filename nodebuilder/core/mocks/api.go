@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/celestiaorg/celestia-node/nodebuilder/core (interfaces: Module)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/celestiaorg/celestia-node/core"
+	core0 "github.com/celestiaorg/celestia-node/nodebuilder/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModule is a mock of Module interface.
+type MockModule struct {
+	ctrl     *gomock.Controller
+	recorder *MockModuleMockRecorder
+}
+
+// MockModuleMockRecorder is the mock recorder for MockModule.
+type MockModuleMockRecorder struct {
+	mock *MockModule
+}
+
+// NewMockModule creates a new mock instance.
+func NewMockModule(ctrl *gomock.Controller) *MockModule {
+	mock := &MockModule{ctrl: ctrl}
+	mock.recorder = &MockModuleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModule) EXPECT() *MockModuleMockRecorder {
+	return m.recorder
+}
+
+// AddSource mocks base method.
+func (m *MockModule) AddSource(arg0 context.Context, arg1 core0.EndpointConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSource indicates an expected call of AddSource.
+func (mr *MockModuleMockRecorder) AddSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSource", reflect.TypeOf((*MockModule)(nil).AddSource), arg0, arg1)
+}
+
+// RemoveSource mocks base method.
+func (m *MockModule) RemoveSource(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSource indicates an expected call of RemoveSource.
+func (mr *MockModuleMockRecorder) RemoveSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSource", reflect.TypeOf((*MockModule)(nil).RemoveSource), arg0, arg1)
+}
+
+// SourcesHealth mocks base method.
+func (m *MockModule) SourcesHealth(arg0 context.Context) ([]core.SourceHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourcesHealth", arg0)
+	ret0, _ := ret[0].([]core.SourceHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourcesHealth indicates an expected call of SourcesHealth.
+func (mr *MockModuleMockRecorder) SourcesHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourcesHealth", reflect.TypeOf((*MockModule)(nil).SourcesHealth), arg0)
+}
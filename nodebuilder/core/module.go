@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/fx"
 
 	libhead "github.com/celestiaorg/go-header"
@@ -33,23 +34,66 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 		fx.Options(options...),
 	)
 
+	backfill := fx.Options()
+	if cfg.Backfill.Enabled {
+		backfill = fx.Invoke(fx.Annotate(
+			func(
+				fetcher *core.MultiSource,
+				headers libhead.Store[*header.ExtendedHeader],
+				store *store.Store,
+				pubsub *shrexsub.PubSub,
+				ds datastore.Batching,
+				opts []core.Option,
+			) (*core.Backfiller, error) {
+				opts = append(opts,
+					core.WithBackfillRate(cfg.Backfill.BlocksPerSecond),
+					core.WithBackfillInterval(cfg.Backfill.Interval),
+					core.WithRetryPolicy(cfg.BlockFetch.RetryPolicy()),
+				)
+				return core.NewBackfiller(fetcher, headers, store, pubsub.Broadcast, ds, opts...)
+			},
+			fx.OnStart(func(ctx context.Context, backfiller *core.Backfiller) error {
+				return backfiller.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, backfiller *core.Backfiller) error {
+				return backfiller.Stop(ctx)
+			}),
+		))
+	}
+
 	switch tp {
-	case node.Light:
-		return fx.Module("core", baseComponents)
+	case node.Light, node.Full:
+		return fx.Module("core",
+			baseComponents,
+			fx.Provide(func() Module {
+				return sourcesStub{}
+			}),
+		)
 	case node.Bridge:
 		return fx.Module("core",
 			baseComponents,
-			fx.Provide(core.NewBlockFetcher),
 			fx.Provide(newCoreFetcher),
+			fx.Provide(func(multiSource *core.MultiSource) core.Fetcher {
+				return multiSource
+			}),
+			fx.Provide(fx.Annotate(
+				newSources,
+				fx.OnStop(func(ctx context.Context, sources *sources) error {
+					return sources.stop(ctx)
+				}),
+			)),
+			fx.Provide(func(sources *sources) Module {
+				return sources
+			}),
 			fx.Provide(func(
-				fetcher *core.BlockFetcher,
+				fetcher *core.MultiSource,
 				store *store.Store,
 				construct header.ConstructFn,
 				p2pEx *headp2p.Exchange[*header.ExtendedHeader],
 				chainID p2p.Network,
 				opts []core.Option,
 			) (*core.Exchange, error) {
-				opts = append(opts, core.WithChainID(chainID))
+				opts = append(opts, core.WithChainID(chainID), core.WithRetryPolicy(cfg.BlockFetch.RetryPolicy()))
 
 				if MetricsEnabled {
 					opts = append(opts, core.WithMetrics())
@@ -82,7 +126,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 					chainID p2p.Network,
 					opts []core.Option,
 				) (*core.Listener, error) {
-					opts = append(opts, core.WithChainID(chainID))
+					opts = append(opts, core.WithChainID(chainID), core.WithRetryPolicy(cfg.BlockFetch.RetryPolicy()))
 
 					if MetricsEnabled {
 						opts = append(opts, core.WithMetrics())
@@ -97,6 +141,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 					return listener.Stop(ctx)
 				}),
 			)),
+			backfill,
 		)
 	default:
 		panic("invalid node type")
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/celestia-node/core"
+)
+
+var (
+	_ Module = (*sources)(nil)
+	_ Module = (*sourcesStub)(nil)
+)
+
+var errStub = errors.New("module/core: stubbed: core endpoints are only managed by bridge nodes")
+
+// sources manages the core endpoints of the MultiSource at runtime. It owns
+// the connections to the endpoints added at runtime, while the configured
+// ones are owned by the node's lifecycle.
+type sources struct {
+	multiSource *core.MultiSource
+
+	lk    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func newSources(multiSource *core.MultiSource) *sources {
+	return &sources{
+		multiSource: multiSource,
+		conns:       make(map[string]*grpc.ClientConn),
+	}
+}
+
+func (s *sources) SourcesHealth(context.Context) ([]core.SourceHealth, error) {
+	return s.multiSource.Health(), nil
+}
+
+func (s *sources) AddSource(ctx context.Context, endpoint EndpointConfig) error {
+	if err := endpoint.validate(); err != nil {
+		return err
+	}
+	conn, err := dialCore(endpoint)
+	if err != nil {
+		return err
+	}
+	if err := connect(ctx, conn); err != nil {
+		return errors.Join(err, conn.Close())
+	}
+	if err := s.multiSource.AddSource(ctx, conn); err != nil {
+		return errors.Join(err, conn.Close())
+	}
+
+	s.lk.Lock()
+	s.conns[conn.Target()] = conn
+	s.lk.Unlock()
+	return nil
+}
+
+func (s *sources) RemoveSource(_ context.Context, addr string) error {
+	if err := s.multiSource.RemoveSource(addr); err != nil {
+		return err
+	}
+
+	s.lk.Lock()
+	conn, ok := s.conns[addr]
+	delete(s.conns, addr)
+	s.lk.Unlock()
+	if ok {
+		return conn.Close()
+	}
+	return nil
+}
+
+// stop closes the connections to the endpoints added at runtime.
+func (s *sources) stop(context.Context) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	var errs error
+	for addr, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("closing %s: %w", addr, err))
+		}
+	}
+	clear(s.conns)
+	return errs
+}
+
+// sourcesStub is a stub implementation of the Module for the nodes that don't ingest blocks from
+// core endpoints, so that we can provide a friendlier error when users try to access it over
+// the API.
+type sourcesStub struct{}
+
+func (sourcesStub) SourcesHealth(context.Context) ([]core.SourceHealth, error) {
+	return nil, errStub
+}
+
+func (sourcesStub) AddSource(context.Context, EndpointConfig) error {
+	return errStub
+}
+
+func (sourcesStub) RemoveSource(context.Context, string) error {
+	return errStub
+}
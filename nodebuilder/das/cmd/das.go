@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	cmdnode "github.com/celestiaorg/celestia-node/cmd"
 )
 
 func init() {
-	Cmd.AddCommand(samplingStatsCmd)
+	Cmd.AddCommand(samplingStatsCmd, samplingResultCmd, samplingHistoryCmd)
 }
 
 var Cmd = &cobra.Command{
@@ -32,3 +35,49 @@ var samplingStatsCmd = &cobra.Command{
 		return cmdnode.PrintOutput(stats, err, nil)
 	},
 }
+
+var samplingResultCmd = &cobra.Command{
+	Use:   "sampling-result [height]",
+	Short: "Returns the result of sampling the given height: the samples, their peers, latency and outcome",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		height, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing a height: %w", err)
+		}
+
+		result, err := client.DAS.SamplingResult(cmd.Context(), height)
+		return cmdnode.PrintOutput(result, err, nil)
+	},
+}
+
+var samplingHistoryCmd = &cobra.Command{
+	Use:   "sampling-history [from] [to]",
+	Short: "Returns the results of sampling the heights within the given inclusive range",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		from, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing a height: %w", err)
+		}
+		to, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing a height: %w", err)
+		}
+
+		results, err := client.DAS.SamplingHistory(cmd.Context(), from, to)
+		return cmdnode.PrintOutput(results, err, nil)
+	},
+}
@@ -9,8 +9,11 @@ import (
 	libhead "github.com/celestiaorg/go-header"
 
 	"github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/fraud"
 	"github.com/celestiaorg/celestia-node/header"
+	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 )
 
 var _ Module = (*daserStub)(nil)
@@ -29,6 +32,14 @@ func (d daserStub) WaitCatchUp(context.Context) error {
 	return errStub
 }
 
+func (d daserStub) SamplingResult(context.Context, uint64) (*das.SamplingResult, error) {
+	return nil, errStub
+}
+
+func (d daserStub) SamplingHistory(context.Context, uint64, uint64) ([]*das.SamplingResult, error) {
+	return nil, errStub
+}
+
 func newDaserStub() Module {
 	return &daserStub{}
 }
@@ -38,7 +49,17 @@ func newDASer(
 	hsub libhead.Subscriber[*header.ExtendedHeader],
 	store libhead.Store[*header.ExtendedHeader],
 	batching datastore.Batching,
+	fraudServ fraud.Service,
 	options ...das.Option,
-) (*das.DASer, error) {
-	return das.NewDASer(da, hsub, store, batching, options...)
+) (*das.DASer, *modfraud.ServiceBreaker[*das.DASer], error) {
+	ds, err := das.NewDASer(da, hsub, store, batching, fraudServ, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ds, &modfraud.ServiceBreaker[*das.DASer]{
+		Service:   ds,
+		FraudServ: fraudServ,
+		FraudType: byzantine.BadEncoding,
+	}, nil
 }
@@ -14,13 +14,20 @@ type Module interface {
 	SamplingStats(ctx context.Context) (das.SamplingStats, error)
 	// WaitCatchUp blocks until DASer finishes catching up to the network head.
 	WaitCatchUp(ctx context.Context) error
+	// SamplingResult returns the result of sampling the given height: the samples taken, the peers
+	// that served them, the latency and the outcome.
+	SamplingResult(ctx context.Context, height uint64) (*das.SamplingResult, error)
+	// SamplingHistory returns the results of sampling the heights within the given inclusive range.
+	SamplingHistory(ctx context.Context, from, to uint64) ([]*das.SamplingResult, error)
 }
 
 // API is a wrapper around Module for the RPC.
 type API struct {
 	Internal struct {
-		SamplingStats func(ctx context.Context) (das.SamplingStats, error) `perm:"read"`
-		WaitCatchUp   func(ctx context.Context) error                      `perm:"read"`
+		SamplingStats   func(ctx context.Context) (das.SamplingStats, error)                      `perm:"read"`
+		WaitCatchUp     func(ctx context.Context) error                                           `perm:"read"`
+		SamplingResult  func(ctx context.Context, height uint64) (*das.SamplingResult, error)     `perm:"read"`
+		SamplingHistory func(ctx context.Context, from, to uint64) ([]*das.SamplingResult, error) `perm:"read"`
 	}
 }
 
@@ -31,3 +38,11 @@ func (api *API) SamplingStats(ctx context.Context) (das.SamplingStats, error) {
 func (api *API) WaitCatchUp(ctx context.Context) error {
 	return api.Internal.WaitCatchUp(ctx)
 }
+
+func (api *API) SamplingResult(ctx context.Context, height uint64) (*das.SamplingResult, error) {
+	return api.Internal.SamplingResult(ctx, height)
+}
+
+func (api *API) SamplingHistory(ctx context.Context, from, to uint64) ([]*das.SamplingResult, error) {
+	return api.Internal.SamplingHistory(ctx, from, to)
+}
@@ -35,6 +35,36 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// SamplingHistory mocks base method.
+func (m *MockModule) SamplingHistory(arg0 context.Context, arg1, arg2 uint64) ([]*das.SamplingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SamplingHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*das.SamplingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SamplingHistory indicates an expected call of SamplingHistory.
+func (mr *MockModuleMockRecorder) SamplingHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingHistory", reflect.TypeOf((*MockModule)(nil).SamplingHistory), arg0, arg1, arg2)
+}
+
+// SamplingResult mocks base method.
+func (m *MockModule) SamplingResult(arg0 context.Context, arg1 uint64) (*das.SamplingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SamplingResult", arg0, arg1)
+	ret0, _ := ret[0].(*das.SamplingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SamplingResult indicates an expected call of SamplingResult.
+func (mr *MockModuleMockRecorder) SamplingResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingResult", reflect.TypeOf((*MockModule)(nil).SamplingResult), arg0, arg1)
+}
+
 // SamplingStats mocks base method.
 func (m *MockModule) SamplingStats(arg0 context.Context) (das.SamplingStats, error) {
 	m.ctrl.T.Helper()
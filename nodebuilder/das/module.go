@@ -6,6 +6,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/celestiaorg/celestia-node/das"
+	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 )
 
 func ConstructModule(cfg *Config) fx.Option {
@@ -28,16 +29,17 @@ func ConstructModule(cfg *Config) fx.Option {
 					das.WithConcurrencyLimit(c.ConcurrencyLimit),
 					das.WithBackgroundStoreInterval(c.BackgroundStoreInterval),
 					das.WithSampleTimeout(c.SampleTimeout),
+					das.WithSamplingHistory(c.SamplingHistory),
 				}
 			},
 		),
 		fx.Provide(fx.Annotate(
 			newDASer,
-			fx.OnStart(func(ctx context.Context, daser *das.DASer) error {
-				return daser.Start(ctx)
+			fx.OnStart(func(ctx context.Context, breaker *modfraud.ServiceBreaker[*das.DASer]) error {
+				return breaker.Start(ctx)
 			}),
-			fx.OnStop(func(ctx context.Context, daser *das.DASer) error {
-				return daser.Stop(ctx)
+			fx.OnStop(func(ctx context.Context, breaker *modfraud.ServiceBreaker[*das.DASer]) error {
+				return breaker.Stop(ctx)
 			}),
 		)),
 		// Module is needed for the RPC handler
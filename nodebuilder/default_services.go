@@ -3,11 +3,14 @@ package nodebuilder
 import (
 	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blobstream"
+	"github.com/celestiaorg/celestia-node/nodebuilder/core"
 	"github.com/celestiaorg/celestia-node/nodebuilder/da"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/nodebuilder/pruner"
 	"github.com/celestiaorg/celestia-node/nodebuilder/share"
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
 )
@@ -18,10 +21,13 @@ var PackageToAPI = map[string]any{
 	"state":      &state.API{},
 	"share":      &share.API{},
 	"header":     &header.API{},
+	"fraud":      &fraud.API{},
 	"das":        &das.API{},
 	"p2p":        &p2p.API{},
 	"blob":       &blob.API{},
 	"node":       &node.API{},
 	"blobstream": &blobstream.API{},
 	"da":         &da.API{},
+	"pruner":     &pruner.API{},
+	"core":       &core.API{},
 }
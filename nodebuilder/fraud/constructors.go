@@ -0,0 +1,36 @@
+package fraud
+
+import (
+	"github.com/ipfs/go-datastore"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"go.uber.org/fx"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/fraud"
+	"github.com/celestiaorg/celestia-node/header"
+	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
+)
+
+// defaultProofUnmarshaler aggregates decoders for all fraud proofs supported by the node.
+var defaultProofUnmarshaler fraud.ProofUnmarshaler = fraud.MultiUnmarshaler{
+	Unmarshalers: map[fraud.ProofType]fraud.UnmarshalFn{
+		byzantine.BadEncoding: byzantine.UnmarshalBadEncodingProof,
+	},
+}
+
+func newFraudService(
+	lc fx.Lifecycle,
+	sub *pubsub.PubSub,
+	hstore libhead.Store[*header.ExtendedHeader],
+	ds datastore.Batching,
+	network modp2p.Network,
+) (Module, fraud.Service, error) {
+	pservice := fraud.NewProofService(sub, hstore.GetByHeight, defaultProofUnmarshaler, ds, network.String())
+	lc.Append(fx.Hook{
+		OnStart: pservice.Start,
+		OnStop:  pservice.Stop,
+	})
+	return &module{pservice}, pservice, nil
+}
@@ -0,0 +1,15 @@
+package fraud
+
+import (
+	logging "github.com/ipfs/go-log/v2"
+	"go.uber.org/fx"
+)
+
+var log = logging.Logger("module/fraud")
+
+func ConstructModule() fx.Option {
+	return fx.Module(
+		"fraud",
+		fx.Provide(newFraudService),
+	)
+}
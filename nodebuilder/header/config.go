@@ -30,6 +30,11 @@ type Config struct {
 
 	Store  store.Parameters
 	Syncer sync.Parameters
+	// Retention is the amount of the most recent headers the pruner keeps in the store. Older
+	// headers are pruned, unless the block data they commit to is not pruned yet, DASer still needs
+	// them or the Syncer is configured to sync from them. Zero disables pruning of the headers by the
+	// pruner, leaving them to the Syncer.PruningWindow only.
+	Retention uint64
 
 	Server p2p_exchange.ServerParameters
 	Client p2p_exchange.ClientParameters `toml:",omitempty"`
@@ -45,7 +50,7 @@ func DefaultConfig(tp node.Type) Config {
 	}
 
 	switch tp {
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		cfg.Store.StoreCacheSize = 2048
 		cfg.Store.IndexCacheSize = 4096
 		cfg.Syncer.PruningWindow = availability.StorageWindow
@@ -84,8 +89,8 @@ func (cfg *Config) trustedPeers(bpeers p2p.Bootstrappers) (infos []peer.AddrInfo
 // Validate performs basic validation of the config.
 func (cfg *Config) Validate(tp node.Type) error {
 	switch tp {
-	case node.Bridge:
-		// Bridge nodes can prune headers but must have a valid pruning window
+	case node.Bridge, node.Full:
+		// Bridge and Full nodes can prune headers but must have a valid pruning window
 		if cfg.Syncer.PruningWindow != 0 && cfg.Syncer.PruningWindow < availability.StorageWindow {
 			return fmt.Errorf("module/header: Syncer.PruningWindow must not be less than storage window (%s)",
 				availability.StorageWindow)
@@ -16,8 +16,11 @@ import (
 	"github.com/celestiaorg/go-header/store"
 	"github.com/celestiaorg/go-header/sync"
 
+	"github.com/celestiaorg/celestia-node/fraud"
+	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 )
 
 // maxBlockTime is the upper bound of expected block production time.
@@ -79,15 +82,16 @@ func newSyncer[H libhead.Header[H]](
 	sub libhead.Subscriber[H],
 	cfg Config,
 	isArchival node.ArchivalMode,
-) (*sync.Syncer[H], error) {
+	fraudServ fraud.Service,
+) (*sync.Syncer[H], *modfraud.ServiceBreaker[*sync.Syncer[H]], error) {
 	switch ndtp {
-	case node.Bridge:
-		// Bridge nodes: check if archival mode is enabled via --archival flag
+	case node.Bridge, node.Full:
+		// Bridge and Full nodes: check if archival mode is enabled via --archival flag
 		if isArchival {
 			// Archival mode: disable header pruning and sync from genesis
 			genesis, err := modp2p.GenesisFor(net)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			cfg.Syncer.PruningWindow = 0
 			cfg.Syncer.SyncFromHash = genesis
@@ -112,10 +116,14 @@ func newSyncer[H libhead.Header[H]](
 
 	syncer, err := sync.NewSyncer[H](ex, store, sub, opts...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return syncer, nil
+	return syncer, &modfraud.ServiceBreaker[*sync.Syncer[H]]{
+		Service:   syncer,
+		FraudType: byzantine.BadEncoding,
+		FraudServ: fraudServ,
+	}, nil
 }
 
 // newStore constructs an initialized store
@@ -16,6 +16,7 @@ import (
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/libs/pidstore"
+	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 )
@@ -65,7 +66,7 @@ func ConstructModule[H libhead.Header[H]](tp node.Type, cfg *Config) fx.Option {
 			newSyncer[H],
 			fx.OnStart(func(
 				ctx context.Context,
-				syncer *sync.Syncer[H],
+				breaker *modfraud.ServiceBreaker[*sync.Syncer[H]],
 			) error {
 				// TODO(@Wondertan): This fix flakes in e2e tests
 				//  This is coming from the store asynchronity.
@@ -74,13 +75,13 @@ func ConstructModule[H libhead.Header[H]](tp node.Type, cfg *Config) fx.Option {
 				//  However, the Store doesn't makes it immediately available causing flakes
 				//  The proper fix will be in a follow up release after pruning.
 				defer time.Sleep(time.Millisecond * 100)
-				return syncer.Start(ctx)
+				return breaker.Start(ctx)
 			}),
 			fx.OnStop(func(
 				ctx context.Context,
-				syncer *sync.Syncer[H],
+				breaker *modfraud.ServiceBreaker[*sync.Syncer[H]],
 			) error {
-				return syncer.Stop(ctx)
+				return breaker.Stop(ctx)
 			}),
 		)),
 		newSubscriber[H](tp),
@@ -115,7 +116,7 @@ func ConstructModule[H libhead.Header[H]](tp node.Type, cfg *Config) fx.Option {
 	)
 
 	switch tp {
-	case node.Light:
+	case node.Light, node.Full:
 		return fx.Module(
 			"header",
 			baseComponents,
@@ -1,6 +1,8 @@
 package nodebuilder
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -16,6 +18,7 @@ import (
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
+	"github.com/celestiaorg/celestia-node/store"
 )
 
 // PrintKeyringInfo whether to print keyring information during init.
@@ -123,6 +126,37 @@ func Reset(path string, tp node.Type) error {
 	return nil
 }
 
+// CompressStore converts the uncompressed EDS files of the Node Store under the given 'path'
+// into the compressed format. The Node must not be running during the conversion.
+func CompressStore(ctx context.Context, path string, tp node.Type) error {
+	if tp == node.Light {
+		return errors.New("light nodes do not store EDS files")
+	}
+
+	path, err := storePath(path)
+	if err != nil {
+		return err
+	}
+
+	flk := flock.New(lockPath(path))
+	ok, err := flk.TryLock()
+	if err != nil {
+		return fmt.Errorf("locking file: %w", err)
+	}
+	if !ok {
+		return ErrOpened
+	}
+	defer flk.Unlock() //nolint:errcheck
+
+	log.Infof("Compressing %s Node Store over '%s'", tp, path)
+	converted, err := store.CompressFiles(ctx, path)
+	if err != nil {
+		return fmt.Errorf("compressing EDS files: %w", err)
+	}
+	log.Infow("Node Store compressed", "converted", converted)
+	return nil
+}
+
 // IsInit checks whether FileSystem Store was setup under given 'path'.
 // If any required file/subdirectory does not exist, then false is reported.
 func IsInit(path string) bool {
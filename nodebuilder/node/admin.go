@@ -2,12 +2,15 @@ package node
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cristalhq/jwt/v5"
 	"github.com/filecoin-project/go-jsonrpc/auth"
 	logging "github.com/ipfs/go-log/v2"
 
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
@@ -17,13 +20,23 @@ type module struct {
 	tp       Type
 	signer   jwt.Signer
 	verifier jwt.Verifier
+	registry *authtoken.Registry
+	quotas   *authtoken.Quotas
 }
 
-func newModule(tp Type, signer jwt.Signer, verifier jwt.Verifier) Module {
+func newModule(
+	tp Type,
+	signer jwt.Signer,
+	verifier jwt.Verifier,
+	registry *authtoken.Registry,
+	quotas *authtoken.Quotas,
+) Module {
 	return &module{
 		tp:       tp,
 		signer:   signer,
 		verifier: verifier,
+		registry: registry,
+		quotas:   quotas,
 	}
 }
 
@@ -54,15 +67,57 @@ func (m *module) LogLevelSet(_ context.Context, name, level string) error {
 }
 
 func (m *module) AuthVerify(_ context.Context, token string) ([]auth.Permission, error) {
-	return authtoken.ExtractSignedPermissions(m.verifier, token)
+	p, err := m.registry.Verify(m.verifier, token)
+	if err != nil {
+		return nil, err
+	}
+	return p.Allow, nil
 }
 
-func (m *module) AuthNew(_ context.Context, permissions []auth.Permission) (string, error) {
-	return authtoken.NewSignedJWT(m.signer, permissions, 0)
+func (m *module) AuthNew(ctx context.Context, permissions []auth.Permission) (string, error) {
+	return m.AuthNewWithLabel(ctx, "", permissions, nil, 0)
 }
 
-func (m *module) AuthNewWithExpiry(_ context.Context,
+func (m *module) AuthNewWithExpiry(ctx context.Context,
 	permissions []auth.Permission, ttl time.Duration,
 ) (string, error) {
-	return authtoken.NewSignedJWT(m.signer, permissions, ttl)
+	return m.AuthNewWithLabel(ctx, "", permissions, nil, ttl)
+}
+
+func (m *module) AuthNewWithScope(ctx context.Context,
+	permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+) (string, error) {
+	return m.AuthNewWithLabel(ctx, "", permissions, scope, ttl)
+}
+
+func (m *module) AuthNewWithLabel(ctx context.Context,
+	label string, permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+) (string, error) {
+	p, err := perms.NewJWTPayload(permissions, scope, ttl)
+	if err != nil {
+		return "", err
+	}
+	token, err := authtoken.SignPayload(m.signer, p)
+	if err != nil {
+		return "", err
+	}
+	if err := m.registry.Register(ctx, p, label); err != nil {
+		return "", fmt.Errorf("registering token: %w", err)
+	}
+	return token, nil
+}
+
+func (m *module) AuthList(ctx context.Context) ([]*authtoken.TokenInfo, error) {
+	return m.registry.List(ctx)
+}
+
+func (m *module) AuthRevoke(ctx context.Context, id string) error {
+	return m.registry.Revoke(ctx, id)
+}
+
+func (m *module) AuthUsage(ctx context.Context) ([]*authtoken.Usage, error) {
+	if m.quotas == nil {
+		return nil, errors.New("token limits are disabled")
+	}
+	return m.quotas.Usage(ctx)
 }
@@ -7,11 +7,25 @@ import (
 	"github.com/filecoin-project/go-jsonrpc/auth"
 	"github.com/spf13/cobra"
 
+	rpcperms "github.com/celestiaorg/celestia-node/api/rpc/perms"
 	cmdnode "github.com/celestiaorg/celestia-node/cmd"
 )
 
 func init() {
-	Cmd.AddCommand(nodeInfoCmd, logCmd, verifyCmd, authCmd)
+	Cmd.AddCommand(nodeInfoCmd, logCmd, verifyCmd, authCmd, listTokensCmd, revokeTokenCmd, usageCmd)
+
+	authCmd.Flags().Duration("ttl", 0, "Set a Time-to-live (TTL) for the token")
+	authCmd.Flags().StringSlice(
+		"methods",
+		nil,
+		"Restrict the token to the given RPC methods, e.g. 'blob.Submit' or 'share.*'",
+	)
+	authCmd.Flags().StringSlice(
+		"namespaces",
+		nil,
+		"Restrict the token to the given hex or base64 encoded namespaces",
+	)
+	authCmd.Flags().String("label", "", "Label the token in the token registry")
 }
 
 var Cmd = &cobra.Command{
@@ -100,6 +114,25 @@ var authCmd = &cobra.Command{
 		}
 
 		ttl, _ := cmd.Flags().GetDuration("ttl")
+		methods, _ := cmd.Flags().GetStringSlice("methods")
+		namespaces, _ := cmd.Flags().GetStringSlice("namespaces")
+		label, _ := cmd.Flags().GetString("label")
+		if len(methods) != 0 || len(namespaces) != 0 || label != "" {
+			var scope *rpcperms.Scope
+			if len(methods) != 0 || len(namespaces) != 0 {
+				scope = &rpcperms.Scope{Methods: methods}
+			}
+			for _, ns := range namespaces {
+				namespace, err := cmdnode.ParseV0Namespace(ns)
+				if err != nil {
+					return err
+				}
+				scope.Namespaces = append(scope.Namespaces, namespace)
+			}
+			result, err := client.Node.AuthNewWithLabel(cmd.Context(), label, perms, scope, ttl)
+			return cmdnode.PrintOutput(result, err, nil)
+		}
+
 		if ttl != 0 {
 			result, err := client.Node.AuthNewWithExpiry(cmd.Context(), perms, ttl)
 			return cmdnode.PrintOutput(result, err, nil)
@@ -109,3 +142,51 @@ var authCmd = &cobra.Command{
 		return cmdnode.PrintOutput(result, err, nil)
 	},
 }
+
+var listTokensCmd = &cobra.Command{
+	Use:   "tokens",
+	Args:  cobra.NoArgs,
+	Short: "Lists the non-expired tokens issued by the node.",
+	RunE: func(c *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(c.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		tokens, err := client.Node.AuthList(c.Context())
+		return cmdnode.PrintOutput(tokens, err, nil)
+	},
+}
+
+var revokeTokenCmd = &cobra.Command{
+	Use:   "revoke-token [id]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Revokes the token with the given ID.",
+	RunE: func(c *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(c.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		err = client.Node.AuthRevoke(c.Context(), args[0])
+		return cmdnode.PrintOutput(nil, err, nil)
+	},
+}
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Args:  cobra.NoArgs,
+	Short: "Returns the RPC usage of the current day per token.",
+	RunE: func(c *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(c.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		usage, err := client.Node.AuthUsage(c.Context())
+		return cmdnode.PrintOutput(usage, err, nil)
+	},
+}
@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 	time "time"
 
+	perms "github.com/celestiaorg/celestia-node/api/rpc/perms"
+	authtoken "github.com/celestiaorg/celestia-node/libs/authtoken"
 	node "github.com/celestiaorg/celestia-node/nodebuilder/node"
 	auth "github.com/filecoin-project/go-jsonrpc/auth"
 	gomock "github.com/golang/mock/gomock"
@@ -37,6 +39,21 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// AuthList mocks base method.
+func (m *MockModule) AuthList(arg0 context.Context) ([]*authtoken.TokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthList", arg0)
+	ret0, _ := ret[0].([]*authtoken.TokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthList indicates an expected call of AuthList.
+func (mr *MockModuleMockRecorder) AuthList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthList", reflect.TypeOf((*MockModule)(nil).AuthList), arg0)
+}
+
 // AuthNew mocks base method.
 func (m *MockModule) AuthNew(arg0 context.Context, arg1 []auth.Permission) (string, error) {
 	m.ctrl.T.Helper()
@@ -67,6 +84,65 @@ func (mr *MockModuleMockRecorder) AuthNewWithExpiry(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNewWithExpiry", reflect.TypeOf((*MockModule)(nil).AuthNewWithExpiry), arg0, arg1, arg2)
 }
 
+// AuthNewWithLabel mocks base method.
+func (m *MockModule) AuthNewWithLabel(arg0 context.Context, arg1 string, arg2 []auth.Permission, arg3 *perms.Scope, arg4 time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthNewWithLabel", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthNewWithLabel indicates an expected call of AuthNewWithLabel.
+func (mr *MockModuleMockRecorder) AuthNewWithLabel(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNewWithLabel", reflect.TypeOf((*MockModule)(nil).AuthNewWithLabel), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AuthNewWithScope mocks base method.
+func (m *MockModule) AuthNewWithScope(arg0 context.Context, arg1 []auth.Permission, arg2 *perms.Scope, arg3 time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthNewWithScope", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthNewWithScope indicates an expected call of AuthNewWithScope.
+func (mr *MockModuleMockRecorder) AuthNewWithScope(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNewWithScope", reflect.TypeOf((*MockModule)(nil).AuthNewWithScope), arg0, arg1, arg2, arg3)
+}
+
+// AuthRevoke mocks base method.
+func (m *MockModule) AuthRevoke(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRevoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthRevoke indicates an expected call of AuthRevoke.
+func (mr *MockModuleMockRecorder) AuthRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRevoke", reflect.TypeOf((*MockModule)(nil).AuthRevoke), arg0, arg1)
+}
+
+// AuthUsage mocks base method.
+func (m *MockModule) AuthUsage(arg0 context.Context) ([]*authtoken.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthUsage", arg0)
+	ret0, _ := ret[0].([]*authtoken.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthUsage indicates an expected call of AuthUsage.
+func (mr *MockModuleMockRecorder) AuthUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUsage", reflect.TypeOf((*MockModule)(nil).AuthUsage), arg0)
+}
+
 // AuthVerify mocks base method.
 func (m *MockModule) AuthVerify(arg0 context.Context, arg1 string) ([]auth.Permission, error) {
 	m.ctrl.T.Helper()
@@ -1,16 +1,32 @@
 package node
 
 import (
+	"context"
+
 	"github.com/cristalhq/jwt/v5"
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/fx"
+
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
 func ConstructModule(tp Type) fx.Option {
 	return fx.Module(
 		"node",
-		fx.Provide(func(signer jwt.Signer, verifier jwt.Verifier) Module {
-			return newModule(tp, signer, verifier)
+		fx.Provide(func(params struct {
+			fx.In
+			Signer   jwt.Signer
+			Verifier jwt.Verifier
+			Registry *authtoken.Registry
+			// Quotas are only provided when token limits are enabled
+			Quotas *authtoken.Quotas `optional:"true"`
+		},
+		) Module {
+			return newModule(tp, params.Signer, params.Verifier, params.Registry, params.Quotas)
 		}),
 		fx.Provide(jwtSignerAndVerifier),
+		fx.Provide(func(ds datastore.Batching) (*authtoken.Registry, error) {
+			return authtoken.NewRegistry(context.Background(), ds)
+		}),
 	)
 }
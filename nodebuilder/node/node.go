@@ -5,6 +5,9 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
 // Module defines the API related to interacting with the "administrative"
@@ -27,6 +30,23 @@ type Module interface {
 	AuthNew(ctx context.Context, perms []auth.Permission) (string, error)
 	// AuthNewWithExpiry signs and returns a new token with the given permissions and TTL.
 	AuthNewWithExpiry(ctx context.Context, perms []auth.Permission, ttl time.Duration) (string, error)
+	// AuthNewWithScope signs and returns a new token with the given permissions and TTL, restricted
+	// to the RPC methods and namespaces of the given scope. A zero TTL means the token never expires.
+	AuthNewWithScope(
+		ctx context.Context, permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+	) (string, error)
+	// AuthNewWithLabel signs and returns a new token like AuthNewWithScope, recording the given
+	// label for it in the token registry. A nil scope imposes no restrictions.
+	AuthNewWithLabel(
+		ctx context.Context, label string, permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+	) (string, error)
+	// AuthList returns the information about all the non-expired tokens issued by the node.
+	AuthList(ctx context.Context) ([]*authtoken.TokenInfo, error)
+	// AuthRevoke revokes the token with the given ID, so it is rejected by the node from now on.
+	AuthRevoke(ctx context.Context, id string) error
+	// AuthUsage returns the RPC usage of the current day per token. It fails if token limits
+	// are disabled.
+	AuthUsage(ctx context.Context) ([]*authtoken.Usage, error)
 }
 
 var _ Module = (*API)(nil)
@@ -39,6 +59,15 @@ type API struct {
 		AuthVerify        func(ctx context.Context, token string) ([]auth.Permission, error)                    `perm:"admin"`
 		AuthNew           func(ctx context.Context, perms []auth.Permission) (string, error)                    `perm:"admin"`
 		AuthNewWithExpiry func(ctx context.Context, perms []auth.Permission, ttl time.Duration) (string, error) `perm:"admin"`
+		AuthNewWithScope  func(
+			ctx context.Context, permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+		) (string, error) `perm:"admin"`
+		AuthNewWithLabel func(
+			ctx context.Context, label string, permissions []auth.Permission, scope *perms.Scope, ttl time.Duration,
+		) (string, error) `perm:"admin"`
+		AuthList   func(ctx context.Context) ([]*authtoken.TokenInfo, error) `perm:"admin"`
+		AuthRevoke func(ctx context.Context, id string) error                `perm:"admin"`
+		AuthUsage  func(ctx context.Context) ([]*authtoken.Usage, error)     `perm:"admin"`
 	}
 }
 
@@ -65,3 +94,34 @@ func (api *API) AuthNew(ctx context.Context, perms []auth.Permission) (string, e
 func (api *API) AuthNewWithExpiry(ctx context.Context, perms []auth.Permission, ttl time.Duration) (string, error) {
 	return api.Internal.AuthNewWithExpiry(ctx, perms, ttl)
 }
+
+func (api *API) AuthNewWithScope(
+	ctx context.Context,
+	permissions []auth.Permission,
+	scope *perms.Scope,
+	ttl time.Duration,
+) (string, error) {
+	return api.Internal.AuthNewWithScope(ctx, permissions, scope, ttl)
+}
+
+func (api *API) AuthNewWithLabel(
+	ctx context.Context,
+	label string,
+	permissions []auth.Permission,
+	scope *perms.Scope,
+	ttl time.Duration,
+) (string, error) {
+	return api.Internal.AuthNewWithLabel(ctx, label, permissions, scope, ttl)
+}
+
+func (api *API) AuthList(ctx context.Context) ([]*authtoken.TokenInfo, error) {
+	return api.Internal.AuthList(ctx)
+}
+
+func (api *API) AuthRevoke(ctx context.Context, id string) error {
+	return api.Internal.AuthRevoke(ctx, id)
+}
+
+func (api *API) AuthUsage(ctx context.Context) ([]*authtoken.Usage, error) {
+	return api.Internal.AuthUsage(ctx)
+}
@@ -7,7 +7,7 @@ import (
 	"strings"
 )
 
-// Type defines the Node type (e.g. `light`, `bridge`) for identity purposes.
+// Type defines the Node type (e.g. `light`, `full`, `bridge`) for identity purposes.
 // The zero value for Type is invalid.
 type Type uint8
 
@@ -26,6 +26,10 @@ const (
 	// Light is a stripped-down Celestia Node which aims to be lightweight while preserving the highest
 	// possible security guarantees.
 	Light
+	// Full is a Celestia Node that stores blocks in their entirety. It syncs headers over the p2p
+	// network like a Light node, but fetches and stores whole data squares and serves them to others.
+	// Unlike Bridge, it does not require a connection to a Celestia Core node.
+	Full
 )
 
 // String converts Type to its string representation.
@@ -77,17 +81,19 @@ func ParseType(str string) Type {
 // typeToString keeps string representations of all valid Types.
 var typeToString = map[Type]string{
 	Bridge: "Bridge",
+	Full:   "Full",
 	Light:  "Light",
 }
 
 // typeToString maps strings representations of all valid Types.
 var stringToType = map[string]Type{
 	"bridge": Bridge,
+	"full":   Full,
 	"light":  Light,
 }
 
 // orderedTypes is a slice of all valid types in order of priority.
-var orderedTypes = []Type{Bridge, Light}
+var orderedTypes = []Type{Bridge, Full, Light}
 
 // GetTypes returns a list of all known types in order of priority.
 func GetTypes() []Type {
@@ -0,0 +1,44 @@
+package nodebuilder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	nodebuilder "github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/nodebuilder/state"
+	"github.com/celestiaorg/celestia-node/share/availability/full"
+	"github.com/celestiaorg/celestia-node/store"
+)
+
+func TestFull_WithNetwork(t *testing.T) {
+	node := TestNode(t, nodebuilder.Full)
+	require.NotNil(t, node)
+	assert.Equal(t, p2p.Private, node.Network)
+	assert.Equal(t, nodebuilder.Full, node.Type)
+}
+
+// TestFull_WithStubbedCoreAccessor ensures that a Full node does not require
+// a core connection and returns a stubbed StateModule without it.
+func TestFull_WithStubbedCoreAccessor(t *testing.T) {
+	node := TestNode(t, nodebuilder.Full)
+	_, err := node.StateServ.Balance(context.Background())
+	assert.ErrorIs(t, state.ErrNoStateAccess, err)
+}
+
+// TestFull_StoresSquares ensures that Full nodes are provided with the EDS store and
+// full availability, so that they store whole squares.
+func TestFull_StoresSquares(t *testing.T) {
+	var (
+		edsStore *store.Store
+		avail    *full.ShareAvailability
+	)
+	node := TestNode(t, nodebuilder.Full, fx.Populate(&edsStore, &avail))
+	require.NotNil(t, node)
+	require.NotNil(t, edsStore)
+	require.NotNil(t, avail)
+}
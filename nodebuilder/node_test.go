@@ -25,6 +25,7 @@ func TestLifecycle(t *testing.T) {
 		tp node.Type
 	}{
 		{tp: node.Bridge},
+		{tp: node.Full},
 		{tp: node.Light},
 	}
 
@@ -72,6 +73,7 @@ func TestLifecycle_WithMetrics(t *testing.T) {
 		coreExpected bool
 	}{
 		{tp: node.Bridge},
+		{tp: node.Full},
 		{tp: node.Light},
 	}
 
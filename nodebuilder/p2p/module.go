@@ -36,7 +36,7 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 	)
 
 	switch tp {
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		return fx.Module(
 			"p2p",
 			baseComponents,
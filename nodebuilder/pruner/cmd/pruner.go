@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	cmdnode "github.com/celestiaorg/celestia-node/cmd"
+)
+
+func init() {
+	Cmd.AddCommand(statusCmd, dryRunCmd, pruneNowCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:               "pruner [command]",
+	Short:             "Allows to interact with the Pruner via JSON-RPC",
+	Args:              cobra.NoArgs,
+	PersistentPreRunE: cmdnode.InitClient,
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Returns the last pruned height and the heights failed to be pruned",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		status, err := client.Pruner.Status(cmd.Context())
+		return cmdnode.PrintOutput(status, err, nil)
+	},
+}
+
+var dryRunCmd = &cobra.Command{
+	Use:   "dry-run [until-height]",
+	Short: "Lists the blocks the pruner would prune and the bytes pruning them frees, without pruning them.",
+	Long: "Lists the blocks up to the given height the pruner would prune and the bytes pruning them frees.\n" +
+		"If the height is omitted, the blocks outside the availability window are listed.\n" +
+		"The number of listed blocks is capped; the result is marked as truncated if the cap is hit.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		var height uint64
+		if len(args) == 1 {
+			height, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing a height: %w", err)
+			}
+		}
+
+		result, err := client.Pruner.DryRun(cmd.Context(), height)
+		return cmdnode.PrintOutput(result, err, nil)
+	},
+}
+
+var pruneNowCmd = &cobra.Command{
+	Use:   "prune-now",
+	Short: "Runs a pruning round right away and returns the state of the pruner after it.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		err = client.Pruner.PruneNow(cmd.Context())
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+
+		status, err := client.Pruner.Status(cmd.Context())
+		return cmdnode.PrintOutput(status, err, nil)
+	},
+}
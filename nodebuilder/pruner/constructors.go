@@ -1,12 +1,19 @@
 package pruner
 
 import (
+	"context"
+	"fmt"
+	"math"
+
 	"github.com/ipfs/go-datastore"
+	"go.uber.org/fx"
 
 	libhead "github.com/celestiaorg/go-header"
 	headsync "github.com/celestiaorg/go-header/sync"
 
+	"github.com/celestiaorg/celestia-node/das"
 	"github.com/celestiaorg/celestia-node/header"
+	modhead "github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 	modshare "github.com/celestiaorg/celestia-node/nodebuilder/share"
 	"github.com/celestiaorg/celestia-node/pruner"
@@ -15,14 +22,39 @@ import (
 // ensures Pruner always starts after Syncer
 type syncerAnchor = headsync.Syncer[*header.ExtendedHeader]
 
+// headerRetainers are the services whose headers must be kept by the pruner.
+type headerRetainers struct {
+	fx.In
+
+	DASer *das.DASer `optional:"true"`
+}
+
+func newHeaderRetainers(r headerRetainers) []pruner.HeaderRetainer {
+	if r.DASer == nil {
+		return nil
+	}
+	return []pruner.HeaderRetainer{r.DASer}
+}
+
 func newPrunerService(
 	p pruner.Pruner,
 	window modshare.Window,
 	getter libhead.Store[*header.ExtendedHeader],
 	_ *syncerAnchor,
 	ds datastore.Batching,
+	headerCfg modhead.Config,
+	retainers []pruner.HeaderRetainer,
 	opts []pruner.Option,
 ) (*pruner.Service, error) {
+	opts = append(opts, pruner.WithHeaderRetention(headerCfg.Retention))
+	if headerCfg.Retention != 0 {
+		opts = append(opts, pruner.WithHeaderRetainers(&trustedTail{
+			store:  getter,
+			params: headerCfg.Syncer,
+		}))
+		opts = append(opts, pruner.WithHeaderRetainers(retainers...))
+	}
+
 	serv, err := pruner.NewService(p, window.Duration(), getter, ds, p2p.BlockTime, opts...)
 	if err != nil {
 		return nil, err
@@ -37,3 +69,26 @@ func newPrunerService(
 
 	return serv, nil
 }
+
+// trustedTail keeps the header the Syncer is configured to sync from, as the Syncer
+// would re-sync the headers down to it otherwise.
+type trustedTail struct {
+	store  libhead.Store[*header.ExtendedHeader]
+	params headsync.Parameters
+}
+
+func (t *trustedTail) RetainFrom(ctx context.Context) (uint64, error) {
+	if t.params.SyncFromHeight != 0 {
+		return t.params.SyncFromHeight, nil
+	}
+
+	hash, err := t.params.Hash()
+	if err != nil || len(hash) == 0 {
+		return math.MaxUint64, err
+	}
+	eh, err := t.store.Get(ctx, hash)
+	if err != nil {
+		return 0, fmt.Errorf("getting SyncFromHash header: %w", err)
+	}
+	return eh.Height(), nil
+}
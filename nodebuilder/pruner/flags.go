@@ -24,8 +24,8 @@ func Flags() *flag.FlagSet {
 func ParseFlags(cmd *cobra.Command, tp node.Type) fx.Option {
 	archivalChanged := cmd.Flag(archivalFlag).Changed
 	if archivalChanged {
-		if tp != node.Bridge {
-			log.Fatal("Archival mode is only supported for Bridge nodes")
+		if tp == node.Light {
+			log.Fatal("Archival mode is only supported for Bridge and Full nodes")
 		}
 		log.Info("ARCHIVAL MODE ENABLED. All blocks will be synced and stored, however archival blocks will " +
 			"be trimmed after the storage window. Expect to see pruning logs as the pruner will still run to trim")
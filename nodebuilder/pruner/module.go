@@ -22,6 +22,7 @@ var log = logging.Logger("module/pruner")
 func ConstructModule(tp node.Type) fx.Option {
 	cfg := DefaultConfig()
 	prunerService := fx.Options(
+		fx.Provide(newHeaderRetainers),
 		fx.Provide(fx.Annotate(
 			newPrunerService,
 			fx.OnStart(func(ctx context.Context, p *pruner.Service) error {
@@ -34,6 +35,9 @@ func ConstructModule(tp node.Type) fx.Option {
 		// This is necessary to invoke the pruner service as independent thanks to a
 		// quirk in FX.
 		fx.Invoke(func(_ *pruner.Service) {}),
+		fx.Provide(func(serv *pruner.Service) Module {
+			return serv
+		}),
 	)
 
 	baseComponents := fx.Options(
@@ -61,7 +65,7 @@ func ConstructModule(tp node.Type) fx.Option {
 			//  note this provide exists in pruner module to avoid cyclical imports
 			fx.Provide(func(la *light.ShareAvailability) pruner.Pruner { return la }),
 		)
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		return fx.Module("prune",
 			baseComponents,
 			fx.Provide(func(cfg *Config) ([]core.Option, []fullavail.Option) {
@@ -81,7 +85,7 @@ func ConstructModule(tp node.Type) fx.Option {
 
 func advertiseArchival() fx.Option {
 	return fx.Provide(func(tp node.Type, pruneCfg *Config) discovery.Option {
-		if tp == node.Bridge && !pruneCfg.EnableService {
+		if tp != node.Light && !pruneCfg.EnableService {
 			return discovery.WithAdvertise()
 		}
 		var opt discovery.Option
@@ -0,0 +1,44 @@
+package pruner
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-node/pruner"
+)
+
+var _ Module = (*API)(nil)
+
+//go:generate mockgen -destination=mocks/api.go -package=mocks . Module
+type Module interface {
+	// Status returns the state of the pruner: the last pruned height and the heights
+	// failed to be pruned.
+	Status(ctx context.Context) (pruner.Status, error)
+	// DryRun lists the blocks up to the given height the pruner would prune and the bytes
+	// pruning them frees, without pruning them. Zero height lists the blocks outside
+	// the availability window. The number of listed blocks is capped and the result is
+	// marked as truncated if the cap is hit.
+	DryRun(ctx context.Context, untilHeight uint64) (*pruner.DryRunResult, error)
+	// PruneNow runs a pruning round right away and blocks until it is done.
+	PruneNow(ctx context.Context) error
+}
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		Status   func(ctx context.Context) (pruner.Status, error)                            `perm:"read"`
+		DryRun   func(ctx context.Context, untilHeight uint64) (*pruner.DryRunResult, error) `perm:"admin"`
+		PruneNow func(ctx context.Context) error                                             `perm:"admin"`
+	}
+}
+
+func (api *API) Status(ctx context.Context) (pruner.Status, error) {
+	return api.Internal.Status(ctx)
+}
+
+func (api *API) DryRun(ctx context.Context, untilHeight uint64) (*pruner.DryRunResult, error) {
+	return api.Internal.DryRun(ctx, untilHeight)
+}
+
+func (api *API) PruneNow(ctx context.Context) error {
+	return api.Internal.PruneNow(ctx)
+}
@@ -3,9 +3,14 @@ package rpc
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 )
 
@@ -34,6 +39,27 @@ type RateLimitConfig struct {
 	CacheSize int
 }
 
+// TokenLimitConfig configures the rate limits and daily quotas bound to the tokens of the requests.
+// Unlike the RateLimitConfig, it works behind a reverse proxy as well.
+type TokenLimitConfig struct {
+	Enabled bool
+	// Tiers maps the permission tiers ("public", "read", "write" and "admin") to their limits.
+	// A token falls into the tier of its highest permission, while the requests without a token
+	// fall into the "public" tier and share its limits.
+	Tiers map[string]authtoken.Limits
+	// Tokens maps the IDs of the tokens to their limits, overriding the limits of their tier.
+	Tokens map[string]authtoken.Limits
+}
+
+// GRPCConfig configures the gRPC server and its HTTP/REST gateway serving the share, blob, header
+// and das modules. They share the address, TLS and auth with the RPC.
+type GRPCConfig struct {
+	Enabled bool
+	Port    string
+	// GatewayPort is the port of the HTTP/REST gateway. Empty disables the gateway.
+	GatewayPort string
+}
+
 type Config struct {
 	Address     string
 	Port        string
@@ -43,16 +69,24 @@ type Config struct {
 	TLSCertPath string
 	TLSKeyPath  string
 	RateLimit   RateLimitConfig
+	TokenLimit  TokenLimitConfig
+	GRPC        GRPCConfig
+
+	// RejectLegacyTokens rejects the tokens issued before token IDs were introduced. Such tokens
+	// can't be revoked individually, so they stay valid until the JWT secret of the node is rotated.
+	RejectLegacyTokens bool
 }
 
 func DefaultConfig() Config {
 	return Config{
 		Address: defaultBindAddress,
 		// do NOT expose the same port as celestia-core by default so that both can run on the same machine
-		Port:      defaultPort,
-		SkipAuth:  false,
-		CORS:      DefaultCORSConfig(),
-		RateLimit: DefaultRateLimitConfig(),
+		Port:       defaultPort,
+		SkipAuth:   false,
+		CORS:       DefaultCORSConfig(),
+		RateLimit:  DefaultRateLimitConfig(),
+		TokenLimit: DefaultTokenLimitConfig(),
+		GRPC:       DefaultGRPCConfig(),
 	}
 }
 
@@ -68,6 +102,24 @@ func DefaultRateLimitConfig() RateLimitConfig {
 	}
 }
 
+// DefaultTokenLimitConfig disables token limits by default.
+func DefaultTokenLimitConfig() TokenLimitConfig {
+	return TokenLimitConfig{
+		Enabled: false,
+		Tiers:   map[string]authtoken.Limits{},
+		Tokens:  map[string]authtoken.Limits{},
+	}
+}
+
+// DefaultGRPCConfig disables the gRPC server by default.
+func DefaultGRPCConfig() GRPCConfig {
+	return GRPCConfig{
+		Enabled:     false,
+		Port:        defaultGRPCPort,
+		GatewayPort: defaultGatewayPort,
+	}
+}
+
 func DefaultCORSConfig() CORSConfig {
 	return CORSConfig{
 		Enabled:        false,
@@ -123,5 +175,30 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for tier, limits := range cfg.TokenLimit.Tiers {
+		if !slices.Contains(perms.AllPerms, auth.Permission(tier)) {
+			return fmt.Errorf("service/rpc: unknown token limit tier %s", tier)
+		}
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("service/rpc: invalid token limits of tier %s: %w", tier, err)
+		}
+	}
+	for id, limits := range cfg.TokenLimit.Tokens {
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("service/rpc: invalid token limits of token %s: %w", id, err)
+		}
+	}
+
+	if cfg.GRPC.Enabled {
+		if _, err := strconv.Atoi(cfg.GRPC.Port); err != nil {
+			return fmt.Errorf("service/rpc: invalid gRPC port: %s", err.Error())
+		}
+		if cfg.GRPC.GatewayPort != "" {
+			if _, err := strconv.Atoi(cfg.GRPC.GatewayPort); err != nil {
+				return fmt.Errorf("service/rpc: invalid gRPC gateway port: %s", err.Error())
+			}
+		}
+	}
+
 	return nil
 }
@@ -18,7 +18,13 @@ func TestDefaultConfig(t *testing.T) {
 			AllowedHeaders: []string{},
 			AllowedMethods: []string{},
 		},
-		RateLimit: DefaultRateLimitConfig(),
+		RateLimit:  DefaultRateLimitConfig(),
+		TokenLimit: DefaultTokenLimitConfig(),
+		GRPC: GRPCConfig{
+			Enabled:     false,
+			Port:        defaultGRPCPort,
+			GatewayPort: defaultGatewayPort,
+		},
 	}
 
 	assert.Equal(t, expected, DefaultConfig())
@@ -54,6 +60,19 @@ func TestConfigValidate(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "invalid gRPC gateway port",
+			cfg: Config{
+				Address: "127.0.0.1",
+				Port:    "8080",
+				GRPC: GRPCConfig{
+					Enabled:     true,
+					Port:        "9090",
+					GatewayPort: "invalid",
+				},
+			},
+			err: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,14 +3,19 @@ package rpc
 import (
 	"github.com/cristalhq/jwt/v5"
 
+	"github.com/celestiaorg/celestia-node/api/grpc"
 	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blobstream"
+	"github.com/celestiaorg/celestia-node/nodebuilder/core"
 	"github.com/celestiaorg/celestia-node/nodebuilder/da"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/nodebuilder/pruner"
 	"github.com/celestiaorg/celestia-node/nodebuilder/share"
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
 )
@@ -21,13 +26,17 @@ func registerEndpoints(
 	shareMod share.Module,
 	headerMod header.Module,
 	daserMod das.Module,
+	fraudMod fraud.Module,
 	p2pMod p2p.Module,
 	nodeMod node.Module,
 	blobMod blob.Module,
 	daMod da.Module, //nolint: staticcheck
 	blobstreamMod blobstream.Module,
+	prunerMod pruner.Module,
+	coreMod core.Module,
 	serv *rpc.Server,
 ) {
+	serv.RegisterService("fraud", fraudMod, &fraud.API{})
 	serv.RegisterService("das", daserMod, &das.API{})
 	serv.RegisterService("header", headerMod, &header.API{})
 	serv.RegisterService("state", stateMod, &state.API{})
@@ -37,10 +46,38 @@ func registerEndpoints(
 	serv.RegisterService("blob", blobMod, &blob.API{})
 	serv.RegisterService("da", daMod, &da.API{})
 	serv.RegisterService("blobstream", blobstreamMod, &blobstream.API{})
+	serv.RegisterService("pruner", prunerMod, &pruner.API{})
+	serv.RegisterService("core", coreMod, &core.API{})
 }
 
-func server(cfg *Config, signer jwt.Signer, verifier jwt.Verifier) *rpc.Server {
-	return rpc.NewServer(cfg.Address, cfg.Port, cfg.SkipAuth, rpc.CORSConfig{
+// registerGRPCEndpoints registers the services served over gRPC, wrapping them with the same
+// permission checks as the RPC.
+func registerGRPCEndpoints(
+	headerMod header.Module,
+	shareMod share.Module,
+	blobMod blob.Module,
+	daserMod das.Module,
+	rpcServ *rpc.Server,
+	serv *grpc.Server,
+) {
+	serv.RegisterServices(
+		rpcServ.PermissionedService("header", headerMod, &header.API{}).(header.Module),
+		rpcServ.PermissionedService("share", shareMod, &share.API{}).(share.Module),
+		rpcServ.PermissionedService("blob", blobMod, &blob.API{}).(blob.Module),
+		rpcServ.PermissionedService("das", daserMod, &das.API{}).(das.Module),
+	)
+}
+
+func grpcServer(cfg *Config, rpcServ *rpc.Server) *grpc.Server {
+	return grpc.NewServer(cfg.Address, cfg.GRPC.Port, cfg.GRPC.GatewayPort, rpc.TLSConfig{
+		Enabled:  cfg.TLSEnabled,
+		CertPath: cfg.TLSCertPath,
+		KeyPath:  cfg.TLSKeyPath,
+	}, rpcServ)
+}
+
+func server(cfg *Config, signer jwt.Signer, verifier jwt.Verifier, registry *authtoken.Registry) *rpc.Server {
+	srv := rpc.NewServer(cfg.Address, cfg.Port, cfg.SkipAuth, rpc.CORSConfig{
 		Enabled:        cfg.CORS.Enabled,
 		AllowedOrigins: cfg.CORS.AllowedOrigins,
 		AllowedMethods: cfg.CORS.AllowedMethods,
@@ -55,4 +92,9 @@ func server(cfg *Config, signer jwt.Signer, verifier jwt.Verifier) *rpc.Server {
 		Burst:          cfg.RateLimit.Burst,
 		CacheSize:      cfg.RateLimit.CacheSize,
 	}, signer, verifier)
+	if cfg.RejectLegacyTokens {
+		registry.RejectLegacyTokens()
+	}
+	srv.WithTokenRegistry(registry)
+	return srv
 }
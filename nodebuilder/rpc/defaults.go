@@ -3,6 +3,8 @@ package rpc
 const (
 	defaultBindAddress = "localhost"
 	defaultPort        = "26658"
+	defaultGRPCPort    = "26661"
+	defaultGatewayPort = "26662"
 )
 
 var (
@@ -22,6 +22,9 @@ var (
 	tlsCertPathFlag        = "rpc.tls-cert"
 	tlsKeyPathFlag         = "rpc.tls-key"
 	rateLimitFlag          = "rpc.rate-limit"
+	grpcFlag               = "rpc.grpc"
+	grpcPortFlag           = "rpc.grpc-port"
+	grpcGatewayPortFlag    = "rpc.grpc-gateway-port"
 )
 
 // Flags gives a set of hardcoded node/rpc package flags.
@@ -92,6 +95,22 @@ func Flags() *flag.FlagSet {
 		"Enable per-IP rate limiting on RPC server. Leave disabled when running behind a reverse proxy",
 	)
 
+	flags.Bool(
+		grpcFlag,
+		false,
+		"Enable the gRPC server and its HTTP/REST gateway for the share, blob, header and das modules",
+	)
+	flags.String(
+		grpcPortFlag,
+		"",
+		fmt.Sprintf("Set a custom gRPC port (default: %s)", defaultGRPCPort),
+	)
+	flags.String(
+		grpcGatewayPortFlag,
+		"",
+		fmt.Sprintf("Set a custom gRPC HTTP/REST gateway port (default: %s)", defaultGatewayPort),
+	)
+
 	return flags
 }
 
@@ -197,5 +216,21 @@ func ParseFlags(cmd *cobra.Command, cfg *Config) error {
 		cfg.RateLimit.Enabled = true
 	}
 
+	if val, err := cmd.Flags().GetBool(grpcFlag); err != nil {
+		return err
+	} else if val {
+		cfg.GRPC.Enabled = true
+	}
+	if port, err := cmd.Flags().GetString(grpcPortFlag); err != nil {
+		return err
+	} else if port != "" {
+		cfg.GRPC.Port = port
+	}
+	if port, err := cmd.Flags().GetString(grpcGatewayPortFlag); err != nil {
+		return err
+	} else if port != "" {
+		cfg.GRPC.GatewayPort = port
+	}
+
 	return nil
 }
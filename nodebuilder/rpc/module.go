@@ -3,9 +3,12 @@ package rpc
 import (
 	"context"
 
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/fx"
 
+	"github.com/celestiaorg/celestia-node/api/grpc"
 	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
@@ -28,13 +31,55 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 	)
 
 	switch tp {
-	case node.Light, node.Bridge:
+	case node.Light, node.Full, node.Bridge:
 		return fx.Module(
 			"rpc",
 			baseComponents,
+			tokenLimitComponents(cfg),
+			grpcComponents(cfg),
 			fx.Invoke(registerEndpoints),
 		)
 	default:
 		panic("invalid node type")
 	}
 }
+
+func tokenLimitComponents(cfg *Config) fx.Option {
+	if !cfg.TokenLimit.Enabled {
+		return fx.Options()
+	}
+	return fx.Options(
+		fx.Provide(fx.Annotate(
+			func(ds datastore.Batching) *authtoken.Quotas {
+				return authtoken.NewQuotas(ds, cfg.TokenLimit.Tiers, cfg.TokenLimit.Tokens)
+			},
+			fx.OnStart(func(ctx context.Context, quotas *authtoken.Quotas) error {
+				return quotas.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, quotas *authtoken.Quotas) error {
+				return quotas.Stop(ctx)
+			}),
+		)),
+		fx.Invoke(func(quotas *authtoken.Quotas, server *rpc.Server) {
+			server.WithTokenQuotas(quotas)
+		}),
+	)
+}
+
+func grpcComponents(cfg *Config) fx.Option {
+	if !cfg.GRPC.Enabled {
+		return fx.Options()
+	}
+	return fx.Options(
+		fx.Provide(fx.Annotate(
+			grpcServer,
+			fx.OnStart(func(ctx context.Context, server *grpc.Server) error {
+				return server.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, server *grpc.Server) error {
+				return server.Stop(ctx)
+			}),
+		)),
+		fx.Invoke(registerGRPCEndpoints),
+	)
+}
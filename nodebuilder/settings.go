@@ -127,10 +127,11 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type) fx.Opti
 	)
 
 	opts := baseComponents
-	if nodeType == node.Bridge {
+	if nodeType != node.Light {
 		opts = fx.Options(
 			baseComponents,
 			fx.Invoke(share.WithStoreMetrics),
+			fx.Invoke(share.WithScrubberMetrics),
 			fx.Invoke(share.WithShrexServerMetrics),
 		)
 	}
@@ -20,6 +20,9 @@ func init() {
 		getShare,
 		getEDS,
 		getRange,
+		pinNamespaceCmd,
+		unpinNamespaceCmd,
+		pinnedNamespacesCmd,
 	)
 }
 
@@ -205,3 +208,69 @@ var getRange = &cobra.Command{
 		return cmdnode.PrintOutput(rng, err, nil)
 	},
 }
+
+var pinNamespaceCmd = &cobra.Command{
+	Use:   "pin namespace",
+	Short: "Pins the namespace, so its shares are kept after the blocks are pruned.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ns, err := cmdnode.ParseV0Namespace(args[0])
+		if err != nil {
+			return err
+		}
+
+		err = client.Share.PinNamespace(cmd.Context(), ns)
+		return cmdnode.PrintOutput(nil, err, nil)
+	},
+}
+
+var unpinNamespaceCmd = &cobra.Command{
+	Use:   "unpin namespace",
+	Short: "Unpins the namespace and removes the shares kept for it.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ns, err := cmdnode.ParseV0Namespace(args[0])
+		if err != nil {
+			return err
+		}
+
+		err = client.Share.UnpinNamespace(cmd.Context(), ns)
+		return cmdnode.PrintOutput(nil, err, nil)
+	},
+}
+
+var pinnedNamespacesCmd = &cobra.Command{
+	Use:   "pinned",
+	Short: "Lists the pinned namespaces.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		pinned, err := client.Share.PinnedNamespaces(cmd.Context())
+		formatter := func(data any) any {
+			namespaces := data.([]libshare.Namespace)
+			encoded := make([]string, len(namespaces))
+			for i, ns := range namespaces {
+				encoded[i] = hex.EncodeToString(ns.Bytes())
+			}
+			return encoded
+		}
+		return cmdnode.PrintOutput(pinned, err, formatter)
+	},
+}
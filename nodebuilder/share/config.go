@@ -1,8 +1,12 @@
 package share
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/share/availability/light"
 	"github.com/celestiaorg/celestia-node/share/shwap/p2p/discovery"
@@ -31,6 +35,13 @@ type Config struct {
 
 	LightAvailability *light.Parameters `toml:",omitempty"`
 	Discovery         *discovery.Parameters
+
+	// Scrubber sets configuration parameters of the background verification of stored files
+	Scrubber *store.ScrubberParams `toml:",omitempty"`
+	// PinnedNamespaces are the hex-encoded namespaces, whose shares and their proofs are kept
+	// after the blocks leave the availability window and are pruned.
+	// Only supported by bridge and full nodes.
+	PinnedNamespaces []string `toml:",omitempty"`
 }
 
 func DefaultConfig(tp node.Type) Config {
@@ -47,6 +58,8 @@ func DefaultConfig(tp node.Type) Config {
 
 	if tp == node.Light {
 		cfg.LightAvailability = light.DefaultParameters()
+	} else {
+		cfg.Scrubber = store.DefaultScrubberParams()
 	}
 
 	return cfg
@@ -79,5 +92,38 @@ func (cfg *Config) Validate(tp node.Type) error {
 	if err := cfg.EDSStoreParams.Validate(); err != nil {
 		return fmt.Errorf("eds store: %w", err)
 	}
+
+	if cfg.Scrubber != nil {
+		if err := cfg.Scrubber.Validate(); err != nil {
+			return fmt.Errorf("scrubber: %w", err)
+		}
+	}
+
+	if len(cfg.PinnedNamespaces) != 0 && tp == node.Light {
+		return errors.New("pinned namespaces: only supported by bridge and full nodes")
+	}
+	if _, err := cfg.Pinned(); err != nil {
+		return fmt.Errorf("pinned namespaces: %w", err)
+	}
 	return nil
 }
+
+// Pinned decodes the PinnedNamespaces.
+func (cfg *Config) Pinned() ([]libshare.Namespace, error) {
+	pinned := make([]libshare.Namespace, 0, len(cfg.PinnedNamespaces))
+	for _, nsString := range cfg.PinnedNamespaces {
+		nsBytes, err := hex.DecodeString(nsString)
+		if err != nil {
+			return nil, fmt.Errorf("decoding namespace %s: %w", nsString, err)
+		}
+		ns, err := libshare.NewNamespaceFromBytes(nsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("decoding namespace %s: %w", nsString, err)
+		}
+		if err := ns.ValidateForData(); err != nil {
+			return nil, fmt.Errorf("namespace %s: %w", nsString, err)
+		}
+		pinned = append(pinned, ns)
+	}
+	return pinned, nil
+}
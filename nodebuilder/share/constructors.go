@@ -7,6 +7,7 @@ import (
 
 	headerServ "github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/light"
 	"github.com/celestiaorg/celestia-node/share/shwap"
 	"github.com/celestiaorg/celestia-node/share/shwap/getters"
 	"github.com/celestiaorg/celestia-node/share/shwap/p2p/bitswap"
@@ -14,8 +15,18 @@ import (
 	"github.com/celestiaorg/celestia-node/store"
 )
 
-func newShareModule(getter shwap.Getter, avail share.Availability, header headerServ.Module) Module {
-	return &module{getter, avail, header}
+type shareModuleParams struct {
+	fx.In
+
+	Getter shwap.Getter
+	Avail  share.Availability
+	Header headerServ.Module
+	// Pins are only provided on the nodes storing the blocks
+	Pins *store.Pins `optional:"true"`
+}
+
+func newShareModule(p shareModuleParams) Module {
+	return &module{p.Getter, p.Avail, p.Header, p.Pins}
 }
 
 func bitswapGetter(
@@ -30,11 +41,13 @@ func bitswapGetter(
 }
 
 func lightGetter(
+	nsCache *light.NamespaceCache,
 	shrexGetter *shrex_getter.Getter,
 	bitswapGetter *bitswap.Getter,
 	cfg Config,
 ) shwap.Getter {
-	var cascade []shwap.Getter
+	// the data of the namespaces of interest is kept along with the samples
+	cascade := []shwap.Getter{nsCache}
 	if cfg.UseShareExchange {
 		cascade = append(cascade, shrexGetter)
 	}
@@ -44,17 +57,19 @@ func lightGetter(
 	return getters.NewCascadeGetter(cascade)
 }
 
-// Getter is added to bridge nodes for the case where Bridge nodes are
+// Getter is added to bridge and full nodes for the case where they are
 // running in a pruned mode. This ensures the block can be retrieved from
 // the network if it was pruned from the local store.
 func bridgeGetter(
 	storeGetter *store.Getter,
+	pins *store.Pins,
 	shrexGetter *shrex_getter.Getter,
 	bitswapGetter *bitswap.Getter,
 	cfg Config,
 ) shwap.Getter {
 	var cascade []shwap.Getter
-	cascade = append(cascade, storeGetter)
+	// the data of the pinned namespaces is kept after the block is pruned from the store
+	cascade = append(cascade, storeGetter, store.NewPinsGetter(pins))
 	if cfg.UseShareExchange {
 		cascade = append(cascade, shrexGetter)
 	}
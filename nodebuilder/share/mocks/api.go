@@ -8,7 +8,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	header "github.com/celestiaorg/celestia-node/header"
 	share "github.com/celestiaorg/celestia-node/nodebuilder/share"
 	shwap "github.com/celestiaorg/celestia-node/share/shwap"
 	share0 "github.com/celestiaorg/go-square/v4/share"
@@ -100,7 +99,7 @@ func (mr *MockModuleMockRecorder) GetRow(arg0, arg1, arg2 interface{}) *gomock.C
 }
 
 // GetSamples mocks base method.
-func (m *MockModule) GetSamples(arg0 context.Context, arg1 *header.ExtendedHeader, arg2 []shwap.SampleCoords) ([]shwap.Sample, error) {
+func (m *MockModule) GetSamples(arg0 context.Context, arg1 uint64, arg2 []shwap.SampleCoords) ([]shwap.Sample, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSamples", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]shwap.Sample)
@@ -129,6 +128,35 @@ func (mr *MockModuleMockRecorder) GetShare(arg0, arg1, arg2, arg3 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShare", reflect.TypeOf((*MockModule)(nil).GetShare), arg0, arg1, arg2, arg3)
 }
 
+// PinNamespace mocks base method.
+func (m *MockModule) PinNamespace(arg0 context.Context, arg1 share0.Namespace) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinNamespace", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinNamespace indicates an expected call of PinNamespace.
+func (mr *MockModuleMockRecorder) PinNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinNamespace", reflect.TypeOf((*MockModule)(nil).PinNamespace), arg0, arg1)
+}
+
+// PinnedNamespaces mocks base method.
+func (m *MockModule) PinnedNamespaces(arg0 context.Context) ([]share0.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinnedNamespaces", arg0)
+	ret0, _ := ret[0].([]share0.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinnedNamespaces indicates an expected call of PinnedNamespaces.
+func (mr *MockModuleMockRecorder) PinnedNamespaces(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinnedNamespaces", reflect.TypeOf((*MockModule)(nil).PinnedNamespaces), arg0)
+}
+
 // SharesAvailable mocks base method.
 func (m *MockModule) SharesAvailable(arg0 context.Context, arg1 uint64) error {
 	m.ctrl.T.Helper()
@@ -142,3 +170,17 @@ func (mr *MockModuleMockRecorder) SharesAvailable(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharesAvailable", reflect.TypeOf((*MockModule)(nil).SharesAvailable), arg0, arg1)
 }
+
+// UnpinNamespace mocks base method.
+func (m *MockModule) UnpinNamespace(arg0 context.Context, arg1 share0.Namespace) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinNamespace", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinNamespace indicates an expected call of UnpinNamespace.
+func (mr *MockModuleMockRecorder) UnpinNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinNamespace", reflect.TypeOf((*MockModule)(nil).UnpinNamespace), arg0, arg1)
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/libp2p/go-libp2p/core/host"
 	"go.uber.org/fx"
 
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 	"github.com/celestiaorg/celestia-node/share"
@@ -42,7 +45,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 	)
 
 	switch tp {
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		return fx.Module(
 			"share",
 			baseComponents,
@@ -77,7 +80,7 @@ func bitswapComponents(tp node.Type, cfg *Config) fx.Option {
 				),
 			),
 		)
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		return fx.Options(
 			opts,
 			fx.Provide(
@@ -142,6 +145,16 @@ func shrexComponents(tp node.Type, cfg *Config) fx.Option {
 				}
 			}),
 		)
+	case node.Full:
+		return fx.Options(
+			opts,
+			shrexServerComponents(cfg),
+			fx.Provide(store.NewGetter),
+			// shrexsub lifecycle is managed by the peer manager as FNs use shrexsub peer pools
+			fx.Provide(func(shrexSub *shrexsub.PubSub) shrexsub.BroadcastFn {
+				return shrexSub.Broadcast
+			}),
+		)
 	case node.Bridge:
 		return fx.Options(
 			opts,
@@ -195,6 +208,39 @@ func edsStoreComponents(cfg *Config) fx.Option {
 				return store.Stop(ctx)
 			}),
 		)),
+		fx.Provide(func(ds datastore.Batching) (*store.Pins, error) {
+			pinned, err := cfg.Pinned()
+			if err != nil {
+				return nil, err
+			}
+			return store.NewPins(context.Background(), ds, pinned)
+		}),
+		scrubberComponents(cfg),
+	)
+}
+
+func scrubberComponents(cfg *Config) fx.Option {
+	if cfg.Scrubber == nil || !cfg.Scrubber.Enabled {
+		return fx.Options()
+	}
+	return fx.Options(
+		fx.Provide(fx.Annotate(
+			func(
+				edsStore *store.Store,
+				headers libhead.Store[*header.ExtendedHeader],
+				getter *shrex_getter.Getter,
+				ds datastore.Batching,
+			) *store.Scrubber {
+				return store.NewScrubber(cfg.Scrubber, edsStore, headers, getter, ds)
+			},
+			fx.OnStart(func(ctx context.Context, scrubber *store.Scrubber) error {
+				return scrubber.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, scrubber *store.Scrubber) error {
+				return scrubber.Stop(ctx)
+			}),
+		)),
+		fx.Invoke(func(_ *store.Scrubber) {}),
 	)
 }
 
@@ -203,13 +249,23 @@ func availabilityComponents(tp node.Type, cfg *Config) fx.Option {
 	case node.Light:
 		return fx.Options(
 			fx.Provide(fx.Annotate(
-				func(getter shwap.Getter, ds datastore.Batching, bs blockstore.Blockstore) *light.ShareAvailability {
+				func(
+					getter shwap.Getter,
+					ds datastore.Batching,
+					bs blockstore.Blockstore,
+				) (*light.ShareAvailability, error) {
+					namespaces, err := cfg.LightAvailability.DecodeNamespaces()
+					if err != nil {
+						return nil, err
+					}
 					return light.NewShareAvailability(
 						getter,
 						ds,
 						bs,
 						light.WithSampleAmount(cfg.LightAvailability.SampleAmount),
-					)
+						light.WithConfidence(cfg.LightAvailability.Confidence, cfg.LightAvailability.LightNodes),
+						light.WithNamespaces(namespaces...),
+					), nil
 				},
 				fx.As(fx.Self()),
 				fx.As(new(share.Availability)),
@@ -217,14 +273,19 @@ func availabilityComponents(tp node.Type, cfg *Config) fx.Option {
 					return la.Close(ctx)
 				}),
 			)),
+			fx.Provide(func(ds datastore.Batching) *light.NamespaceCache {
+				return light.NewNamespaceCache(ds)
+			}),
 		)
-	case node.Bridge:
+	case node.Bridge, node.Full:
 		return fx.Options(
 			fx.Provide(func(
 				s *store.Store,
 				getter shwap.Getter,
+				pins *store.Pins,
 				opts []full.Option,
 			) *full.ShareAvailability {
+				opts = append(opts, full.WithPins(pins))
 				return full.NewShareAvailability(s, getter, opts...)
 			}),
 			fx.Provide(func(avail *full.ShareAvailability) share.Availability {
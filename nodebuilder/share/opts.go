@@ -3,6 +3,8 @@ package share
 import (
 	"errors"
 
+	"go.uber.org/fx"
+
 	"github.com/celestiaorg/celestia-node/share/shwap/p2p/bitswap"
 	"github.com/celestiaorg/celestia-node/share/shwap/p2p/discovery"
 	"github.com/celestiaorg/celestia-node/share/shwap/p2p/shrex"
@@ -47,6 +49,18 @@ func WithStoreMetrics(s *store.Store) error {
 	return s.WithMetrics()
 }
 
+// WithScrubberMetrics turns on the store scrubber metrics if the scrubber is enabled.
+func WithScrubberMetrics(params struct {
+	fx.In
+	Scrubber *store.Scrubber `optional:"true"`
+},
+) error {
+	if params.Scrubber == nil {
+		return nil
+	}
+	return params.Scrubber.WithMetrics()
+}
+
 func WithBlockStoreMetrics(bs *bitswap.BlockstoreWithMetrics) error {
 	return bs.WithMetrics()
 }
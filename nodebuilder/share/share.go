@@ -2,6 +2,7 @@ package share
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	libshare "github.com/celestiaorg/go-square/v4/share"
@@ -10,6 +11,7 @@ import (
 	headerServ "github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/shwap"
+	"github.com/celestiaorg/celestia-node/store"
 )
 
 var _ Module = (*API)(nil)
@@ -72,6 +74,20 @@ type Module interface {
 		height uint64,
 		start, end int,
 	) (*GetRangeResult, error)
+
+	// PinNamespace pins the namespace, so its shares and their proofs are kept by the node after
+	// the blocks leave the availability window and are pruned. It is only supported by the nodes
+	// storing the blocks. The blocks pruned before the namespace was pinned are not recovered.
+	// Once a block is pruned, its pinned data is only served by GetNamespaceData and the blob
+	// methods built on it: blob.Get, blob.GetAll, blob.GetProof, blob.Included and the blob
+	// subscriptions. The methods needing the rest of the square, e.g. GetEDS, GetRow, GetSamples,
+	// GetRange and blob.GetCommitmentProof, fail for the pruned blocks.
+	PinNamespace(ctx context.Context, namespace libshare.Namespace) error
+	// UnpinNamespace unpins the namespace and removes the data kept for it.
+	// The namespaces pinned in the config can't be unpinned.
+	UnpinNamespace(ctx context.Context, namespace libshare.Namespace) error
+	// PinnedNamespaces returns the pinned namespaces.
+	PinnedNamespaces(ctx context.Context) ([]libshare.Namespace, error)
 }
 
 // API is a wrapper around Module for the RPC.
@@ -107,6 +123,9 @@ type API struct {
 			height uint64,
 			start, end int,
 		) (*GetRangeResult, error) `perm:"read"`
+		PinNamespace     func(ctx context.Context, namespace libshare.Namespace) error `perm:"admin"`
+		UnpinNamespace   func(ctx context.Context, namespace libshare.Namespace) error `perm:"admin"`
+		PinnedNamespaces func(ctx context.Context) ([]libshare.Namespace, error)       `perm:"read"`
 	}
 }
 
@@ -145,10 +164,26 @@ func (api *API) GetNamespaceData(
 	return api.Internal.GetNamespaceData(ctx, height, namespace)
 }
 
+func (api *API) PinNamespace(ctx context.Context, namespace libshare.Namespace) error {
+	return api.Internal.PinNamespace(ctx, namespace)
+}
+
+func (api *API) UnpinNamespace(ctx context.Context, namespace libshare.Namespace) error {
+	return api.Internal.UnpinNamespace(ctx, namespace)
+}
+
+func (api *API) PinnedNamespaces(ctx context.Context) ([]libshare.Namespace, error) {
+	return api.Internal.PinnedNamespaces(ctx)
+}
+
+var errPinningUnsupported = errors.New("share: namespace pinning is only supported by bridge and full nodes")
+
 type module struct {
 	getter shwap.Getter
 	avail  share.Availability
 	hs     headerServ.Module
+	// pins is nil on the nodes not storing the blocks
+	pins *store.Pins
 }
 
 func (m module) GetShare(ctx context.Context, height uint64, row, col int) (libshare.Share, error) {
@@ -235,3 +270,24 @@ func (m module) GetRow(ctx context.Context, height uint64, rowIdx int) (shwap.Ro
 	}
 	return m.getter.GetRow(ctx, header, rowIdx)
 }
+
+func (m module) PinNamespace(ctx context.Context, namespace libshare.Namespace) error {
+	if m.pins == nil {
+		return errPinningUnsupported
+	}
+	return m.pins.Pin(ctx, namespace)
+}
+
+func (m module) UnpinNamespace(ctx context.Context, namespace libshare.Namespace) error {
+	if m.pins == nil {
+		return errPinningUnsupported
+	}
+	return m.pins.Unpin(ctx, namespace)
+}
+
+func (m module) PinnedNamespaces(context.Context) ([]libshare.Namespace, error) {
+	if m.pins == nil {
+		return nil, errPinningUnsupported
+	}
+	return m.pins.Pinned(), nil
+}
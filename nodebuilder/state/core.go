@@ -2,6 +2,7 @@ package state
 
 import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ipfs/go-datastore"
 	"google.golang.org/grpc"
 
 	"github.com/celestiaorg/go-header/sync"
@@ -19,8 +20,9 @@ func newTxClient(
 	keyname AccountName,
 	client *grpc.ClientConn,
 	additionalConns core.AdditionalCoreConns,
+	ds datastore.Batching,
 ) (*txclient.TxClient, error) {
-	var opts []txclient.Option
+	opts := []txclient.Option{txclient.WithSubmissionQueue(ds)}
 	if len(additionalConns) > 0 {
 		opts = append(opts, txclient.WithAdditionalCoreEndpoints(additionalConns))
 	}
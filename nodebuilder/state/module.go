@@ -54,7 +54,7 @@ func ConstructModule(tp node.Type, cfg *Config, coreCfg *core.Config) fx.Option
 	)
 
 	switch tp {
-	case node.Light, node.Bridge:
+	case node.Light, node.Full, node.Bridge:
 		return fx.Module(
 			"state",
 			baseComponents,
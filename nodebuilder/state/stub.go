@@ -43,6 +43,10 @@ func (s stubbedStateModule) Transfer(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) PendingSubmissions(context.Context) ([]*state.PendingSubmission, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) SubmitPayForBlob(
 	context.Context,
 	[]*libshare.Blob,
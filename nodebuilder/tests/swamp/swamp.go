@@ -183,6 +183,13 @@ func (s *Swamp) NewBridgeNode(options ...fx.Option) *nodebuilder.Node {
 	return s.NewNodeWithConfig(node.Bridge, cfg, options...)
 }
 
+// NewFullNode creates a new instance of a FullNode providing a default config
+// and a mockstore to the MustNewNodeWithStore method
+func (s *Swamp) NewFullNode(options ...fx.Option) *nodebuilder.Node {
+	cfg := s.DefaultTestConfig(node.Full)
+	return s.NewNodeWithConfig(node.Full, cfg, options...)
+}
+
 // NewLightNode creates a new instance of a LightNode providing a default config
 // and a mockstore to the MustNewNodeWithStore method
 func (s *Swamp) NewLightNode(options ...fx.Option) *nodebuilder.Node {
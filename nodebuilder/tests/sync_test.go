@@ -307,7 +307,7 @@ func TestSyncLightAgainstFull(t *testing.T) {
 	assert.EqualValues(t, h.Commit.BlockID.Hash, sw.GetCoreBlockHashByHeight(ctx, numBlocks))
 
 	// create a FN with BN as a trusted peer
-	full := sw.NewBridgeNode()
+	full := sw.NewFullNode()
 	// start FN and wait for it to sync up to head of BN
 	err = full.Start(ctx)
 	require.NoError(t, err)
@@ -384,7 +384,7 @@ func TestSyncLightWithTrustedPeers(t *testing.T) {
 	require.NoError(t, err)
 
 	// create a FN with BN as trusted peer
-	full := sw.NewBridgeNode()
+	full := sw.NewFullNode()
 
 	// let FN sync to network head
 	err = full.Start(ctx)
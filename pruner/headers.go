@@ -0,0 +1,76 @@
+package pruner
+
+import (
+	"context"
+	"fmt"
+)
+
+// HeaderRetainer is implemented by the services that need the headers to be kept in the header
+// store until they are done with them, e.g. DASer sampling them.
+type HeaderRetainer interface {
+	// RetainFrom returns the lowest height of the headers still needed.
+	RetainFrom(context.Context) (uint64, error)
+}
+
+// pruneHeaders advances the tail of the header store up to the header retention height.
+// The headers are kept if the block data they commit to is not pruned yet or if any of
+// the HeaderRetainers still needs them.
+func (s *Service) pruneHeaders(ctx context.Context) {
+	if s.params.headerRetention == 0 {
+		return
+	}
+	s.headersMu.Lock()
+	defer s.headersMu.Unlock()
+
+	tail, err := s.hstore.Tail(ctx)
+	if err != nil {
+		log.Errorw("getting header store tail", "err", err)
+		return
+	}
+
+	cutoff, err := s.headerCutoff(ctx)
+	if err != nil {
+		log.Errorw("finding header cutoff", "err", err)
+		return
+	}
+	if cutoff <= tail.Height() {
+		return
+	}
+
+	// the deletion is split, so the store doesn't have to delete too many headers at once
+	for from := tail.Height(); from < cutoff; {
+		to := min(from+uint64(maxHeadersPerLoop), cutoff)
+		if err := s.hstore.DeleteRange(ctx, from, to); err != nil {
+			log.Errorw("pruning headers", "from", from, "to", to, "err", err)
+			return
+		}
+		from = to
+	}
+	log.Infow("pruned headers", "from", tail.Height(), "to", cutoff-1)
+}
+
+// headerCutoff returns the height of the lowest header to be kept in the header store.
+func (s *Service) headerCutoff(ctx context.Context) (uint64, error) {
+	head := s.hstore.Height()
+	if head <= s.params.headerRetention {
+		return 0, nil
+	}
+	cutoff := head - s.params.headerRetention + 1
+
+	// the headers of the blocks with data not pruned yet are needed to prune it later
+	s.checkpointMu.Lock()
+	cutoff = min(cutoff, s.checkpoint.LastPrunedHeight+1)
+	for height := range s.checkpoint.FailedHeaders {
+		cutoff = min(cutoff, height)
+	}
+	s.checkpointMu.Unlock()
+
+	for _, retainer := range s.params.retainers {
+		from, err := retainer.RetainFrom(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("getting height to retain headers from: %w", err)
+		}
+		cutoff = min(cutoff, from)
+	}
+	return cutoff, nil
+}
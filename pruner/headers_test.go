@@ -0,0 +1,66 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header/headertest"
+)
+
+func TestService_PruneHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	blockTime := time.Millisecond
+	suite := headertest.NewTestSuite(t, headertest.WithValidators(1), headertest.WithBlockTime(blockTime))
+	store := headertest.NewCustomStore(t, suite, 100)
+
+	retainer := &mockRetainer{from: 5}
+	serv, err := NewService(
+		&mockPruner{},
+		time.Millisecond*20,
+		store,
+		sync.MutexWrap(datastore.NewMapDatastore()),
+		blockTime,
+		WithHeaderRetention(10),
+		WithHeaderRetainers(retainer),
+	)
+	require.NoError(t, err)
+	serv.ctx = ctx
+
+	err = serv.loadCheckpoint(ctx)
+	require.NoError(t, err)
+
+	// ensures the block data of the old headers is prune-able
+	time.Sleep(time.Millisecond * 50)
+	serv.prune(ctx)
+	lastPruned := serv.checkpoint.LastPrunedHeight
+	require.Greater(t, lastPruned, uint64(retainer.from))
+
+	// the headers the retainer needs are kept
+	serv.pruneHeaders(ctx)
+	tail, err := store.Tail(ctx)
+	require.NoError(t, err)
+	require.Equal(t, retainer.from, tail.Height())
+
+	// the headers of the block data not pruned yet are kept
+	retainer.from = store.Height()
+	serv.pruneHeaders(ctx)
+	tail, err = store.Tail(ctx)
+	require.NoError(t, err)
+	require.Equal(t, min(lastPruned+1, store.Height()-10+1), tail.Height())
+	require.Equal(t, lastPruned, serv.checkpoint.LastPrunedHeight)
+}
+
+type mockRetainer struct {
+	from uint64
+}
+
+func (mr *mockRetainer) RetainFrom(context.Context) (uint64, error) {
+	return mr.from, nil
+}
@@ -11,6 +11,11 @@ type Params struct {
 	// pruneCycle is the frequency at which the pruning Service
 	// runs the ticker. If set to 0, the Service will not run.
 	pruneCycle time.Duration
+	// headerRetention is the amount of the most recent headers kept in the header store.
+	// If set to 0, the Service doesn't prune headers.
+	headerRetention uint64
+	// retainers keep the headers they still need in the header store.
+	retainers []HeaderRetainer
 }
 
 func (p *Params) Validate() error {
@@ -34,6 +39,22 @@ func WithPruneCycle(cycle time.Duration) Option {
 	}
 }
 
+// WithHeaderRetention configures the pruning Service to also prune the headers
+// below the given amount of the most recent ones.
+func WithHeaderRetention(headers uint64) Option {
+	return func(p *Params) {
+		p.headerRetention = headers
+	}
+}
+
+// WithHeaderRetainers configures the pruning Service to keep the headers
+// the given retainers still need.
+func WithHeaderRetainers(retainers ...HeaderRetainer) Option {
+	return func(p *Params) {
+		p.retainers = append(p.retainers, retainers...)
+	}
+}
+
 // WithPrunerMetrics is a utility function to turn on pruner metrics and that is
 // expected to be "invoked" by the fx lifecycle.
 func WithPrunerMetrics(s *Service) error {
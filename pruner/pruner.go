@@ -11,3 +11,9 @@ import (
 type Pruner interface {
 	Prune(context.Context, *header.ExtendedHeader) error
 }
+
+// Sizer is optionally implemented by the Pruners able to report the amount of bytes
+// pruning a block frees.
+type Sizer interface {
+	Size(context.Context, *header.ExtendedHeader) (uint64, error)
+}
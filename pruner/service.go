@@ -32,6 +32,9 @@ type Service struct {
 	checkpointMu sync.Mutex
 	checkpoint   *checkpoint
 
+	// headersMu serializes pruning of the headers
+	headersMu sync.Mutex
+
 	metrics *metrics
 
 	ctx    context.Context
@@ -136,6 +139,7 @@ func (s *Service) run() {
 
 	for {
 		s.prune(s.ctx)
+		s.pruneHeaders(s.ctx)
 		// pruning may take a while beyond ticker's time
 		// and this ensures we don't do idle spins right after the pruning
 		// and ensures there is always pruneCycle period between each run
@@ -0,0 +1,157 @@
+package pruner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+// Status describes the state of the pruning Service.
+type Status struct {
+	// LastPrunedHeight is the height of the last block pruned.
+	LastPrunedHeight uint64 `json:"last_pruned_height"`
+	// FailedHeights are the heights of the blocks failed to be pruned,
+	// which are retried on every pruning round.
+	FailedHeights []uint64 `json:"failed_heights"`
+}
+
+// PrunableBlock is a block the pruning Service would prune.
+type PrunableBlock struct {
+	Height uint64 `json:"height"`
+	// Bytes is the amount of bytes pruning the block frees.
+	// It is zero if the Pruner doesn't implement Sizer.
+	Bytes uint64 `json:"bytes"`
+}
+
+// maxDryRunBlocks is the maximum number of blocks listed by a dry run.
+var maxDryRunBlocks = 4096
+
+// DryRunResult lists the blocks the pruning Service would prune.
+type DryRunResult struct {
+	Blocks     []PrunableBlock `json:"blocks"`
+	TotalBytes uint64          `json:"total_bytes"`
+	// Truncated is set if the listing stopped at the maximum number of blocks
+	// before reaching the requested height.
+	Truncated bool `json:"truncated"`
+}
+
+// Status reports the state of the pruning Service.
+func (s *Service) Status(ctx context.Context) (Status, error) {
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+
+	if err := s.loadCheckpoint(ctx); err != nil {
+		return Status{}, err
+	}
+	return Status{
+		LastPrunedHeight: s.checkpoint.LastPrunedHeight,
+		FailedHeights:    slices.Sorted(maps.Keys(s.checkpoint.FailedHeaders)),
+	}, nil
+}
+
+// DryRun lists the blocks up to the given height the next pruning round would prune, without
+// pruning them. If untilHeight is 0, the blocks outside the availability window are listed.
+// At most maxDryRunBlocks blocks are listed, the result is marked as truncated otherwise.
+func (s *Service) DryRun(ctx context.Context, untilHeight uint64) (*DryRunResult, error) {
+	// the checkpoint is only read here, so it is copied to not block pruning rounds
+	// while sizing the blocks
+	failedHeights, lastPruned, err := s.dryRunCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if untilHeight == 0 {
+		untilHeight = s.hstore.Height()
+	}
+
+	result := &DryRunResult{Blocks: make([]PrunableBlock, 0)}
+	add := func(eh *header.ExtendedHeader) error {
+		block := PrunableBlock{Height: eh.Height()}
+		if sizer, ok := s.pruner.(Sizer); ok {
+			var err error
+			block.Bytes, err = sizer.Size(ctx, eh)
+			if err != nil {
+				return fmt.Errorf("sizing block %d: %w", eh.Height(), err)
+			}
+		}
+		result.Blocks = append(result.Blocks, block)
+		result.TotalBytes += block.Bytes
+		return nil
+	}
+
+	// failed heights are retried first
+	for _, height := range failedHeights {
+		if height > untilHeight {
+			break
+		}
+		eh, err := s.hstore.GetByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("getting failed header %d: %w", height, err)
+		}
+		if len(result.Blocks) == maxDryRunBlocks {
+			result.Truncated = true
+			return result, nil
+		}
+		if err := add(eh); err != nil {
+			return nil, err
+		}
+	}
+
+	for lastPruned.Height() < untilHeight {
+		headers, err := s.findPruneableHeaders(ctx, lastPruned)
+		if err != nil {
+			return nil, fmt.Errorf("finding pruneable headers: %w", err)
+		}
+		if len(headers) == 0 {
+			break
+		}
+
+		for _, eh := range headers {
+			if eh.Height() > untilHeight {
+				return result, nil
+			}
+			if len(result.Blocks) == maxDryRunBlocks {
+				result.Truncated = true
+				return result, nil
+			}
+			if err := add(eh); err != nil {
+				return nil, err
+			}
+		}
+		if len(headers) < maxHeadersPerLoop {
+			break
+		}
+		lastPruned = headers[len(headers)-1]
+	}
+	return result, nil
+}
+
+// dryRunCheckpoint returns the sorted failed heights and the last pruned header
+// of the current checkpoint.
+func (s *Service) dryRunCheckpoint(ctx context.Context) ([]uint64, *header.ExtendedHeader, error) {
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+
+	if err := s.loadCheckpoint(ctx); err != nil {
+		return nil, nil, err
+	}
+	lastPruned, err := s.lastPruned(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting last pruned header: %w", err)
+	}
+	return slices.Sorted(maps.Keys(s.checkpoint.FailedHeaders)), lastPruned, nil
+}
+
+// PruneNow runs a pruning round right away and returns once it is done.
+func (s *Service) PruneNow(ctx context.Context) error {
+	if s.ctx == nil || s.ctx.Err() != nil {
+		return errors.New("pruner service is not running")
+	}
+
+	s.prune(ctx)
+	s.pruneHeaders(ctx)
+	return nil
+}
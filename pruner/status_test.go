@@ -0,0 +1,104 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+)
+
+// TestService_DryRun checks that DryRun lists exactly the blocks
+// the following pruning round prunes, without pruning them.
+func TestService_DryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	blockTime := time.Millisecond
+
+	suite := headertest.NewTestSuite(t, headertest.WithValidators(1), headertest.WithBlockTime(blockTime))
+	store := headertest.NewCustomStore(t, suite, 100)
+
+	mp := &mockSizer{mockPruner: mockPruner{failHeight: map[uint64]int{4: 0}}}
+
+	serv, err := NewService(
+		mp,
+		time.Millisecond*20,
+		store,
+		sync.MutexWrap(datastore.NewMapDatastore()),
+		blockTime,
+	)
+	require.NoError(t, err)
+
+	err = serv.PruneNow(ctx)
+	require.Error(t, err)
+	serv.ctx = ctx
+
+	err = serv.loadCheckpoint(ctx)
+	require.NoError(t, err)
+
+	// ensures the blocks are prune-able
+	time.Sleep(time.Millisecond * 50)
+
+	// a pruning round to record the failed height
+	require.NoError(t, serv.PruneNow(ctx))
+	status, err := serv.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, status.FailedHeights)
+	require.Equal(t, serv.checkpoint.LastPrunedHeight, status.LastPrunedHeight)
+
+	// add more prune-able blocks
+	err = store.Append(ctx, suite.GenExtendedHeaders(50)...)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 50)
+
+	until := status.LastPrunedHeight + 10
+	result, err := serv.DryRun(ctx, until)
+	require.NoError(t, err)
+	require.Len(t, result.Blocks, 11)
+	require.EqualValues(t, 4, result.Blocks[0].Height)
+	require.Equal(t, until, result.Blocks[len(result.Blocks)-1].Height)
+	require.EqualValues(t, len(result.Blocks)*blockSize, result.TotalBytes)
+
+	result, err = serv.DryRun(ctx, 0)
+	require.NoError(t, err)
+	require.Greater(t, len(result.Blocks), 11)
+	require.False(t, result.Truncated)
+
+	// the listing is capped
+	maxDryRunBlocksOld := maxDryRunBlocks
+	maxDryRunBlocks = 5
+	truncated, err := serv.DryRun(ctx, 0)
+	maxDryRunBlocks = maxDryRunBlocksOld
+	require.NoError(t, err)
+	require.True(t, truncated.Truncated)
+	require.Equal(t, result.Blocks[:5], truncated.Blocks)
+
+	// nothing is pruned by the dry run
+	pruned := len(mp.deletedHeaderHashes)
+	status, err = serv.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, status.FailedHeights)
+
+	// more blocks may get out of the window by the time of the pruning round
+	require.NoError(t, serv.PruneNow(ctx))
+	require.GreaterOrEqual(t, len(mp.deletedHeaderHashes), pruned+len(result.Blocks)-1)
+	for i, block := range result.Blocks[1:] {
+		require.Equal(t, block.Height, mp.deletedHeaderHashes[pruned+i].height)
+	}
+}
+
+const blockSize = 1024
+
+type mockSizer struct {
+	mockPruner
+}
+
+func (ms *mockSizer) Size(context.Context, *header.ExtendedHeader) (uint64, error) {
+	return blockSize, nil
+}
@@ -9,6 +9,7 @@ import (
 	logging "github.com/ipfs/go-log/v2"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/availability"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
@@ -33,6 +34,7 @@ type ShareAvailability struct {
 
 	storageWindow time.Duration
 	archival      bool
+	pins          *store.Pins
 }
 
 // NewShareAvailability creates a new full ShareAvailability.
@@ -51,6 +53,7 @@ func NewShareAvailability(
 		getter:        getter,
 		storageWindow: availability.StorageWindow,
 		archival:      p.archival,
+		pins:          p.pins,
 	}
 }
 
@@ -114,6 +117,40 @@ func (fa *ShareAvailability) Prune(ctx context.Context, eh *header.ExtendedHeade
 		return fa.store.RemoveQ4(ctx, eh.Height(), eh.DAH.Hash())
 	}
 
+	if err := fa.extractPinned(ctx, eh); err != nil {
+		return err
+	}
+
 	log.Debugf("removing block %s at height %d", eh.DAH.String(), eh.Height())
 	return fa.store.RemoveODSQ4(ctx, eh.Height(), eh.DAH.Hash())
 }
+
+// extractPinned keeps the data of the pinned namespaces out of the block before it is removed.
+func (fa *ShareAvailability) extractPinned(ctx context.Context, eh *header.ExtendedHeader) error {
+	if fa.pins == nil || len(fa.pins.Pinned()) == 0 {
+		return nil
+	}
+
+	acc, err := fa.store.GetByHeight(ctx, eh.Height())
+	if errors.Is(err, store.ErrNotFound) {
+		// nothing to keep
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("full availability: getting block to extract pinned namespaces: %w", err)
+	}
+	defer utils.CloseAndLog(log, "pinned namespaces", acc)
+
+	if err := fa.pins.Extract(ctx, eh, acc); err != nil {
+		return fmt.Errorf("full availability: extracting pinned namespaces: %w", err)
+	}
+	return nil
+}
+
+// Size returns the amount of bytes pruning the block of the given header frees.
+func (fa *ShareAvailability) Size(ctx context.Context, eh *header.ExtendedHeader) (uint64, error) {
+	if fa.archival {
+		return uint64(fa.store.SizeQ4(ctx, eh.DAH.Hash())), nil
+	}
+	return uint64(fa.store.SizeODSQ4(ctx, eh.DAH.Hash())), nil
+}
@@ -6,9 +6,13 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/availability"
@@ -149,3 +153,30 @@ func TestSharesAvailable_OutsideSamplingWindow_Archival(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, has)
 }
+
+func TestPrune_ExtractsPinned(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ns := libshare.RandomBlobNamespace()
+	pins, err := store.NewPins(ctx, ds_sync.MutexWrap(datastore.NewMapDatastore()), []libshare.Namespace{ns})
+	require.NoError(t, err)
+
+	edsStore, err := store.NewStore(store.DefaultParameters(), t.TempDir())
+	require.NoError(t, err)
+	eds, roots := edstest.RandEDSWithNamespace(t, ns, 4, 8)
+	eh := headertest.RandExtendedHeaderWithRoot(t, roots)
+	require.NoError(t, edsStore.PutODSQ4(ctx, roots, eh.Height(), eds))
+
+	avail := NewShareAvailability(edsStore, nil, WithPins(pins))
+	require.NoError(t, avail.Prune(ctx, eh))
+
+	has, err := edsStore.HasByHeight(ctx, eh.Height())
+	require.NoError(t, err)
+	require.False(t, has)
+
+	nd, err := pins.Get(ctx, eh.Height(), ns)
+	require.NoError(t, err)
+	require.Equal(t, 4, nd.Length())
+	require.NoError(t, nd.Verify(roots, ns))
+}
@@ -1,7 +1,10 @@
 package full
 
+import "github.com/celestiaorg/celestia-node/store"
+
 type params struct {
 	archival bool
+	pins     *store.Pins
 }
 
 // Option is a function that configures light availability Parameters
@@ -23,3 +26,11 @@ func WithArchivalMode() Option {
 		p.archival = true
 	}
 }
+
+// WithPins is a functional option to keep the data of the pinned namespaces
+// out of the blocks before they are pruned.
+func WithPins(pins *store.Pins) Option {
+	return func(p *params) {
+		p.pins = pins
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/ipfs/go-datastore/namespace"
 	logging "github.com/ipfs/go-log/v2"
 
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
@@ -37,6 +39,12 @@ type ShareAvailability struct {
 	getter shwap.Getter
 	bs     blockstore.Blockstore
 	params Parameters
+	// namespaces are the namespaces of interest sampled along with the random samples
+	namespaces []libshare.Namespace
+	nsCache    *NamespaceCache
+	// pending are the headers the namespaces of interest failed to be fetched for
+	pendingLk sync.Mutex
+	pending   map[uint64]*header.ExtendedHeader
 
 	samplingWindow time.Duration
 
@@ -56,6 +64,7 @@ func NewShareAvailability(
 	opts ...Option,
 ) *ShareAvailability {
 	params := *DefaultParameters()
+	nsCache := NewNamespaceCache(ds)
 	ds = namespace.Wrap(ds, samplingResultsPrefix)
 	autoDS := autobatch.NewAutoBatching(ds, writeBatchSize)
 
@@ -67,6 +76,9 @@ func NewShareAvailability(
 		getter:         getter,
 		bs:             bs,
 		params:         params,
+		namespaces:     params.namespaces,
+		nsCache:        nsCache,
+		pending:        make(map[uint64]*header.ExtendedHeader),
 		samplingWindow: availability.SamplingWindow,
 		activeHeights:  utils.NewSessions(),
 		ds:             autoDS,
@@ -74,7 +86,11 @@ func NewShareAvailability(
 }
 
 // SharesAvailable randomly samples `params.SampleAmount` amount of Shares committed to the given
-// ExtendedHeader. This way SharesAvailable subjectively verifies that Shares are available.
+// ExtendedHeader, or as many as `params.Confidence` requires for the square size.
+// This way SharesAvailable subjectively verifies that Shares are available.
+// Once the samples are available, the data of the namespaces of interest is fetched and verified,
+// so it can be read from the NamespaceCache later on. Failing to fetch it doesn't fail the sampling,
+// and the fetch is retried along with the following heights.
 func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header.ExtendedHeader) error {
 	dah := header.DAH
 
@@ -107,22 +123,26 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 			return err
 		}
 		// No previous results; create new samples
-		samples = NewSamplingResult(len(dah.RowRoots), int(la.params.SampleAmount))
+		samples = NewSamplingResult(len(dah.RowRoots), la.params.sampleAmount(len(dah.RowRoots)))
 	} else {
 		err = json.Unmarshal(data, samples)
 		if err != nil {
 			return err
 		}
-		// Verify total samples count.
+		// The result may be stored with a different amount of samples before the parameters
+		// changed. The missing samples are added, while the extra ones are kept.
 		totalSamples := len(samples.Remaining) + len(samples.Available)
-		if (totalSamples != int(la.params.SampleAmount)) && (totalSamples != len(dah.RowRoots)*len(dah.RowRoots)) {
-			return fmt.Errorf("invalid sampling result:"+
-				" expected %d samples, got %d", la.params.SampleAmount, totalSamples)
+		if sampleAmount := la.params.sampleAmount(len(dah.RowRoots)); totalSamples < sampleAmount {
+			log.Debugw("extending sampling result",
+				"root", dah.String(), "samples", totalSamples, "expected", sampleAmount)
+			samples.extend(len(dah.RowRoots), sampleAmount)
 		}
 	}
+	samples.Confidence = confidenceOf(len(samples.Remaining)+len(samples.Available), len(dah.RowRoots))
 
 	if len(samples.Remaining) == 0 {
 		// All samples have been processed successfully
+		la.sampleNamespaces(ctx, header)
 		return nil
 	}
 
@@ -180,9 +200,26 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 		return share.ErrNotAvailable
 	}
 
+	la.sampleNamespaces(ctx, header)
 	return nil
 }
 
+// Confidence returns the confidence that the square of the given header is recoverable the
+// samples taken out of it achieve. For the squares not sampled yet, it is the confidence
+// the samples to take achieve.
+func (la *ShareAvailability) Confidence(header *header.ExtendedHeader) float64 {
+	la.dsLk.RLock()
+	data, err := la.ds.Get(context.Background(), datastoreKeyForRoot(header.DAH))
+	la.dsLk.RUnlock()
+	if err == nil {
+		samples := &SamplingResult{}
+		if err := json.Unmarshal(data, samples); err == nil && samples.Confidence > 0 {
+			return samples.Confidence
+		}
+	}
+	return la.params.confidence(len(header.DAH.RowRoots))
+}
+
 // Prune deletes samples and all sampling data corresponding to provided header from store.
 // The operation will remove all data that ShareAvailable might have created
 func (la *ShareAvailability) Prune(ctx context.Context, h *header.ExtendedHeader) error {
@@ -233,6 +270,11 @@ func (la *ShareAvailability) Prune(ctx context.Context, h *header.ExtendedHeader
 		}
 	}
 
+	la.removePending(h.Height())
+	if err := la.nsCache.remove(ctx, dah); err != nil {
+		return fmt.Errorf("delete namespace data: %w", err)
+	}
+
 	// delete the sampling result
 	la.dsLk.Lock()
 	err = la.ds.Delete(ctx, key)
@@ -23,6 +23,7 @@ import (
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/celestiaorg/celestia-app/v9/pkg/wrapper"
 	libshare "github.com/celestiaorg/go-square/v4/share"
 	"github.com/celestiaorg/nmt"
 	"github.com/celestiaorg/rsmt2d"
@@ -87,6 +88,180 @@ func TestSharesAvailableSuccess(t *testing.T) {
 
 	require.Empty(t, result.Remaining)
 	require.Len(t, result.Available, int(avail.params.SampleAmount))
+	require.Equal(t, confidenceOf(int(avail.params.SampleAmount), len(roots.RowRoots)), result.Confidence)
+	require.Equal(t, result.Confidence, avail.Confidence(eh))
+}
+
+func TestSharesAvailableNamespaces(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	ns := libshare.RandomBlobNamespace()
+	square, roots := edstest.RandEDSWithNamespace(t, ns, 8, 16)
+	eh := headertest.RandExtendedHeaderWithRoot(t, roots)
+	acc := &eds.Rsmt2D{ExtendedDataSquare: square}
+
+	getter := mock.NewMockGetter(gomock.NewController(t))
+	getter.EXPECT().
+		GetSamples(gomock.Any(), eh, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ *header.ExtendedHeader, indices []shwap.SampleCoords) ([]shwap.Sample, error) {
+				smpls := make([]shwap.Sample, len(indices))
+				for i, idx := range indices {
+					smpl, err := acc.Sample(ctx, idx)
+					if err != nil {
+						return nil, err
+					}
+					smpls[i] = smpl
+				}
+				return smpls, nil
+			}).
+		AnyTimes()
+	// the namespace data is fetched once and served from the cache afterwards
+	getter.EXPECT().
+		GetNamespaceData(gomock.Any(), eh, ns).
+		DoAndReturn(
+			func(ctx context.Context, _ *header.ExtendedHeader, ns libshare.Namespace) (shwap.NamespaceData, error) {
+				return eds.NamespaceData(ctx, acc, ns)
+			}).
+		Times(1)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	avail := NewShareAvailability(getter, ds, blockstore.NewBlockstore(ds), WithNamespaces(ns))
+	require.NoError(t, avail.SharesAvailable(ctx, eh))
+	require.NoError(t, avail.SharesAvailable(ctx, eh))
+	require.NoError(t, avail.Close(ctx))
+
+	cache := NewNamespaceCache(ds)
+	nd, err := cache.GetNamespaceData(ctx, eh, ns)
+	require.NoError(t, err)
+	require.Equal(t, 8, nd.Length())
+	require.NoError(t, nd.Verify(roots, ns))
+
+	// pruning removes the namespace data along with the samples
+	require.NoError(t, avail.Prune(ctx, eh))
+	_, err = cache.GetNamespaceData(ctx, eh, ns)
+	require.ErrorIs(t, err, shwap.ErrNotFound)
+}
+
+func TestSharesAvailableNamespacesRetried(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	ns := libshare.RandomBlobNamespace()
+	accessors := make(map[*header.ExtendedHeader]eds.Accessor)
+	headers := make([]*header.ExtendedHeader, 2)
+	for i := range headers {
+		square, roots := edstest.RandEDSWithNamespace(t, ns, 8, 16)
+		headers[i] = headertest.RandExtendedHeaderWithRoot(t, roots)
+		accessors[headers[i]] = &eds.Rsmt2D{ExtendedDataSquare: square}
+	}
+
+	var failed atomic.Bool
+	getter := mock.NewMockGetter(gomock.NewController(t))
+	getter.EXPECT().
+		GetSamples(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, h *header.ExtendedHeader, indices []shwap.SampleCoords) ([]shwap.Sample, error) {
+				smpls := make([]shwap.Sample, len(indices))
+				for i, idx := range indices {
+					smpl, err := accessors[h].Sample(ctx, idx)
+					if err != nil {
+						return nil, err
+					}
+					smpls[i] = smpl
+				}
+				return smpls, nil
+			}).
+		AnyTimes()
+	// the first fetch of the namespace data fails
+	getter.EXPECT().
+		GetNamespaceData(gomock.Any(), gomock.Any(), ns).
+		DoAndReturn(
+			func(ctx context.Context, h *header.ExtendedHeader, ns libshare.Namespace) (shwap.NamespaceData, error) {
+				if !failed.Swap(true) {
+					return nil, shwap.ErrNotFound
+				}
+				return eds.NamespaceData(ctx, accessors[h], ns)
+			}).
+		Times(3)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	avail := NewShareAvailability(getter, ds, blockstore.NewBlockstore(ds), WithNamespaces(ns))
+	cache := NewNamespaceCache(ds)
+
+	// the sampling succeeds regardless of the namespace data
+	require.NoError(t, avail.SharesAvailable(ctx, headers[0]))
+	_, err := cache.GetNamespaceData(ctx, headers[0], ns)
+	require.ErrorIs(t, err, shwap.ErrNotFound)
+
+	// the namespace data is retried along with the sampling of the following height
+	require.NoError(t, avail.SharesAvailable(ctx, headers[1]))
+	for _, h := range headers {
+		nd, err := cache.GetNamespaceData(ctx, h, ns)
+		require.NoError(t, err)
+		require.NoError(t, nd.Verify(h.DAH, ns))
+	}
+	require.Empty(t, avail.pending)
+}
+
+func TestSharesAvailableNamespacesSharedRows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	const odsSize = 8
+	shares, err := libshare.RandShares(odsSize * odsSize)
+	require.NoError(t, err)
+	square, err := rsmt2d.ComputeExtendedDataSquare(
+		libshare.ToBytes(shares),
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(odsSize),
+	)
+	require.NoError(t, err)
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	eh := headertest.RandExtendedHeaderWithRoot(t, roots)
+	acc := &eds.Rsmt2D{ExtendedDataSquare: square}
+
+	// both namespaces are in the first row
+	namespaces := []libshare.Namespace{shares[1].Namespace(), shares[odsSize-2].Namespace()}
+
+	getter := mock.NewMockGetter(gomock.NewController(t))
+	getter.EXPECT().
+		GetSamples(gomock.Any(), eh, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ *header.ExtendedHeader, indices []shwap.SampleCoords) ([]shwap.Sample, error) {
+				smpls := make([]shwap.Sample, len(indices))
+				for i, idx := range indices {
+					smpl, err := acc.Sample(ctx, idx)
+					if err != nil {
+						return nil, err
+					}
+					smpls[i] = smpl
+				}
+				return smpls, nil
+			}).
+		AnyTimes()
+	// the row containing both namespaces is fetched once
+	getter.EXPECT().
+		GetRow(gomock.Any(), eh, 0).
+		DoAndReturn(func(context.Context, *header.ExtendedHeader, int) (shwap.Row, error) {
+			return shwap.RowFromEDS(square, 0, shwap.Left)
+		}).
+		Times(1)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	avail := NewShareAvailability(getter, ds, blockstore.NewBlockstore(ds), WithNamespaces(namespaces...))
+	require.NoError(t, avail.SharesAvailable(ctx, eh))
+	require.NoError(t, avail.Close(ctx))
+
+	cache := NewNamespaceCache(ds)
+	for _, ns := range namespaces {
+		nd, err := cache.GetNamespaceData(ctx, eh, ns)
+		require.NoError(t, err)
+		require.Equal(t, 1, nd.Length())
+		require.NoError(t, nd.Verify(roots, ns))
+	}
 }
 
 // TestSharesAvailablePartialResponse verifies that when a getter returns a
@@ -184,6 +359,57 @@ func TestSharesAvailableSkipSampled(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSharesAvailableExtendsStoredResult(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDS(t, 16)
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	eh := headertest.RandExtendedHeaderWithRoot(t, roots)
+	acc := &eds.Rsmt2D{ExtendedDataSquare: square}
+
+	getter := mock.NewMockGetter(gomock.NewController(t))
+	getter.EXPECT().
+		GetSamples(gomock.Any(), eh, gomock.Any()).
+		DoAndReturn(
+			func(_ context.Context, _ *header.ExtendedHeader, indices []shwap.SampleCoords) ([]shwap.Sample, error) {
+				smpls := make([]shwap.Sample, len(indices))
+				for i, idx := range indices {
+					smpl, err := acc.Sample(ctx, idx)
+					if err != nil {
+						return nil, err
+					}
+					smpls[i] = smpl
+				}
+				return smpls, nil
+			}).
+		Times(1)
+
+	ds := datastore.NewMapDatastore()
+	avail := NewShareAvailability(getter, ds, nil)
+
+	// the result stored before the parameters changed requiring more samples
+	stored := &SamplingResult{Available: selectRandomSamples(len(roots.RowRoots), 4)}
+	data, err := json.Marshal(stored)
+	require.NoError(t, err)
+	require.NoError(t, avail.ds.Put(ctx, datastoreKeyForRoot(roots), data))
+
+	require.NoError(t, avail.SharesAvailable(ctx, eh))
+
+	data, err = avail.ds.Get(ctx, datastoreKeyForRoot(roots))
+	require.NoError(t, err)
+	result := &SamplingResult{}
+	require.NoError(t, json.Unmarshal(data, result))
+	require.Empty(t, result.Remaining)
+	require.Len(t, result.Available, int(DefaultSampleAmount))
+	require.Subset(t, result.Available, stored.Available)
+	// the confidence the samples achieve is kept
+	confidence := confidenceOf(int(DefaultSampleAmount), len(roots.RowRoots))
+	require.Equal(t, confidence, result.Confidence)
+	require.Equal(t, confidence, avail.Confidence(eh))
+}
+
 func TestSharesAvailableEmptyEDS(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,54 @@
+package light
+
+import "math"
+
+// The extended square of width 2k is unrecoverable only if at least (k+1)^2 of its shares are
+// withheld, that is a bit more than a quarter of the square. A light node is fooled by such a
+// square if all of its samples hit the available shares. Drawing s samples without replacement
+// out of n shares with w withheld, the probability of it is:
+//
+//	P(s) = ((n-w)/n) * ((n-w-1)/(n-1)) * ... * ((n-w-s+1)/(n-s+1))
+//
+// The confidence the samples give is 1 - P(s).
+
+// sampleAmountFor returns the amount of samples each of the given amount of independent light
+// nodes takes, so they collectively detect an unrecoverable square of the given width with the
+// given confidence. The light nodes are fooled collectively only if every one of them is, so each
+// tolerates being fooled with the probability of (1-confidence)^(1/lightNodes), and the more light
+// nodes sample the network, the fewer samples each takes. It is bounded by the amount of shares
+// in the square.
+func sampleAmountFor(confidence float64, lightNodes uint, squareWidth int) int {
+	n, w := squareShares(squareWidth)
+	maxFooled := math.Pow(1-confidence, 1/float64(max(lightNodes, 1)))
+
+	fooled := 1.0
+	for s := range n {
+		fooled *= float64(n-w-s) / float64(n-s)
+		if fooled <= maxFooled {
+			return s + 1
+		}
+	}
+	return n
+}
+
+// confidenceOf returns the confidence the given amount of samples give a light node that it isn't
+// fooled by an unrecoverable square of the given width.
+func confidenceOf(samples, squareWidth int) float64 {
+	n, w := squareShares(squareWidth)
+
+	fooled := 1.0
+	for s := range min(samples, n) {
+		fooled *= float64(n-w-s) / float64(n-s)
+		if fooled <= 0 {
+			return 1
+		}
+	}
+	return 1 - fooled
+}
+
+// squareShares returns the amount of shares in the extended square of the given width and the
+// minimum amount of them to be withheld for the square to be unrecoverable.
+func squareShares(squareWidth int) (n, w int) {
+	k := squareWidth / 2
+	return squareWidth * squareWidth, (k + 1) * (k + 1)
+}
@@ -0,0 +1,64 @@
+package light
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleAmountFor(t *testing.T) {
+	tests := []struct {
+		confidence float64
+		lightNodes uint
+		width      int
+	}{
+		{confidence: 0.99, lightNodes: 1, width: 4},
+		{confidence: 0.999999, lightNodes: 1, width: 128},
+		{confidence: 0.999999, lightNodes: 1000, width: 128},
+		{confidence: 0.999999, lightNodes: 1, width: 512},
+	}
+
+	for _, tt := range tests {
+		amount := sampleAmountFor(tt.confidence, tt.lightNodes, tt.width)
+		assert.LessOrEqual(t, amount, tt.width*tt.width)
+		// the amount is the minimal one reaching the confidence
+		maxFooled := math.Pow(1-tt.confidence, 1/float64(tt.lightNodes))
+		assert.LessOrEqual(t, 1-confidenceOf(amount, tt.width), maxFooled)
+		assert.Greater(t, 1-confidenceOf(amount-1, tt.width), maxFooled)
+	}
+
+	// more light nodes collectively reach the confidence with fewer samples each
+	assert.Less(t, sampleAmountFor(0.999999, 1000, 128), sampleAmountFor(0.999999, 1, 128))
+	// sampling more than the available part of the square can't be fooled
+	assert.Equal(t, 1.0, confidenceOf(16, 4))
+}
+
+func TestParametersSampleAmount(t *testing.T) {
+	params := DefaultParameters()
+	assert.Equal(t, int(DefaultSampleAmount), params.sampleAmount(128))
+	assert.Equal(t, 4, params.sampleAmount(2))
+
+	WithConfidence(0.999999, 1)(params)
+	assert.NoError(t, params.Validate())
+	assert.Equal(t, sampleAmountFor(0.999999, 1, 128), params.sampleAmount(128))
+	assert.Greater(t, params.sampleAmount(128), int(DefaultSampleAmount))
+	// the achieved confidence is at least the target one
+	assert.GreaterOrEqual(t, params.confidence(128), 0.999999)
+	assert.Equal(t, confidenceOf(params.sampleAmount(128), 128), params.confidence(128))
+
+	// the SampleAmount stays the floor when many light nodes are assumed
+	WithConfidence(0.999999, maxLightNodes)(params)
+	assert.NoError(t, params.Validate())
+	assert.Less(t, sampleAmountFor(0.999999, maxLightNodes, 128), int(DefaultSampleAmount))
+	assert.Equal(t, int(DefaultSampleAmount), params.sampleAmount(128))
+
+	WithConfidence(0.999999, maxLightNodes+1)(params)
+	assert.Error(t, params.Validate())
+
+	WithConfidence(0, 10)(params)
+	assert.Error(t, params.Validate())
+
+	WithConfidence(1, 1)(params)
+	assert.Error(t, params.Validate())
+}
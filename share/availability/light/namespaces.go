@@ -0,0 +1,304 @@
+package light
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	"golang.org/x/sync/errgroup"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+var namespaceDataPrefix = datastore.NewKey("namespace_data")
+
+const (
+	// maxPendingNamespaces bounds the amount of headers the namespaces of interest are retried for.
+	maxPendingNamespaces = 256
+	// namespacesRetries is the amount of pending headers retried along with a sampled height.
+	namespacesRetries = 4
+)
+
+var _ shwap.Getter = (*NamespaceCache)(nil)
+
+// NamespaceCache keeps the namespace data of the namespaces of interest fetched and verified by
+// ShareAvailability along with the samples. It serves the data as shwap.Getter, so the namespace
+// data isn't downloaded twice. It supports GetNamespaceData only.
+// The data is removed along with the sampling results once the block is pruned.
+type NamespaceCache struct {
+	ds datastore.Datastore
+}
+
+// NewNamespaceCache creates a new NamespaceCache over the given datastore.
+func NewNamespaceCache(ds datastore.Datastore) *NamespaceCache {
+	return &NamespaceCache{ds: namespace.Wrap(ds, namespaceDataPrefix)}
+}
+
+func (c *NamespaceCache) GetNamespaceData(
+	ctx context.Context,
+	h *header.ExtendedHeader,
+	ns libshare.Namespace,
+) (shwap.NamespaceData, error) {
+	data, err := c.ds.Get(ctx, namespaceDataKey(h.DAH, ns))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, shwap.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nd := shwap.NamespaceData{}
+	if _, err := nd.ReadFrom(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("unmarshaling namespace data: %w", err)
+	}
+	return nd, nil
+}
+
+func (c *NamespaceCache) GetSamples(
+	context.Context,
+	*header.ExtendedHeader,
+	[]shwap.SampleCoords,
+) ([]shwap.Sample, error) {
+	return nil, shwap.ErrOperationNotSupported
+}
+
+func (c *NamespaceCache) GetEDS(context.Context, *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
+	return nil, shwap.ErrOperationNotSupported
+}
+
+func (c *NamespaceCache) GetRow(context.Context, *header.ExtendedHeader, int) (shwap.Row, error) {
+	return shwap.Row{}, shwap.ErrOperationNotSupported
+}
+
+func (c *NamespaceCache) GetRangeNamespaceData(
+	context.Context,
+	*header.ExtendedHeader,
+	int, int,
+) (shwap.RangeNamespaceData, error) {
+	return shwap.RangeNamespaceData{}, shwap.ErrOperationNotSupported
+}
+
+func (c *NamespaceCache) has(ctx context.Context, root *share.AxisRoots, ns libshare.Namespace) (bool, error) {
+	return c.ds.Has(ctx, namespaceDataKey(root, ns))
+}
+
+func (c *NamespaceCache) put(
+	ctx context.Context,
+	root *share.AxisRoots,
+	ns libshare.Namespace,
+	nd shwap.NamespaceData,
+) error {
+	buf := &bytes.Buffer{}
+	if _, err := nd.WriteTo(buf); err != nil {
+		return fmt.Errorf("marshaling namespace data: %w", err)
+	}
+	return c.ds.Put(ctx, namespaceDataKey(root, ns), buf.Bytes())
+}
+
+// remove removes the namespace data of all the namespaces kept for the given root.
+func (c *NamespaceCache) remove(ctx context.Context, root *share.AxisRoots) error {
+	results, err := c.ds.Query(ctx, query.Query{
+		Prefix:   datastoreKeyForRoot(root).String(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := c.ds.Delete(ctx, datastore.NewKey(result.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func namespaceDataKey(root *share.AxisRoots, ns libshare.Namespace) datastore.Key {
+	return datastoreKeyForRoot(root).ChildString(hex.EncodeToString(ns.Bytes()))
+}
+
+// sampleNamespaces fetches and verifies the namespace data of the namespaces of interest not kept
+// by the cache yet. A failure doesn't fail the sampling, as the namespace data is not needed to
+// tell the square is available. Instead, the header is kept pending and the fetch is retried
+// along with the sampling of the following heights. Until then, the data is served from the
+// network.
+func (la *ShareAvailability) sampleNamespaces(ctx context.Context, header *header.ExtendedHeader) {
+	if len(la.namespaces) == 0 {
+		return
+	}
+
+	if err := la.fetchNamespaces(ctx, header); err != nil {
+		log.Warnw("fetching namespaces of interest, retrying later", "height", header.Height(), "err", err)
+		la.addPending(header)
+	}
+	la.retryPending(ctx, header.Height())
+}
+
+// fetchNamespaces fetches, verifies and caches the data of the namespaces of interest not kept by
+// the cache yet.
+func (la *ShareAvailability) fetchNamespaces(ctx context.Context, header *header.ExtendedHeader) error {
+	missing := make([]libshare.Namespace, 0, len(la.namespaces))
+	for _, ns := range la.namespaces {
+		has, err := la.nsCache.has(ctx, header.DAH, ns)
+		if err != nil {
+			return fmt.Errorf("checking namespace data cache: %w", err)
+		}
+		if !has {
+			missing = append(missing, ns)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	nds, err := la.namespacesData(ctx, header, missing)
+	if err != nil {
+		return err
+	}
+	for i, ns := range missing {
+		if err := nds[i].Verify(header.DAH, ns); err != nil {
+			return fmt.Errorf("verifying namespace %s data: %w", ns.String(), err)
+		}
+		if err := la.nsCache.put(ctx, header.DAH, ns, nds[i]); err != nil {
+			return fmt.Errorf("caching namespace %s data: %w", ns.String(), err)
+		}
+	}
+	return nil
+}
+
+// namespacesData gets the namespace data of each of the given namespaces. The rows containing
+// the namespaces are fetched only once, regardless of the amount of namespaces they contain.
+func (la *ShareAvailability) namespacesData(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	namespaces []libshare.Namespace,
+) ([]shwap.NamespaceData, error) {
+	if len(namespaces) == 1 {
+		nd, err := la.getter.GetNamespaceData(ctx, header, namespaces[0])
+		if err != nil {
+			return nil, fmt.Errorf("getting namespace %s data: %w", namespaces[0].String(), err)
+		}
+		return []shwap.NamespaceData{nd}, nil
+	}
+
+	namespaceRows := make([][]int, len(namespaces))
+	rowIdxs := make([]int, 0)
+	for i, ns := range namespaces {
+		rows, err := share.RowsWithNamespace(header.DAH, ns)
+		if err != nil {
+			return nil, err
+		}
+		namespaceRows[i] = rows
+		rowIdxs = append(rowIdxs, rows...)
+	}
+	slices.Sort(rowIdxs)
+	rowIdxs = slices.Compact(rowIdxs)
+
+	var (
+		rows   = make(map[int][]libshare.Share, len(rowIdxs))
+		rowsLk sync.Mutex
+	)
+	errGroup, rowsCtx := errgroup.WithContext(ctx)
+	for _, idx := range rowIdxs {
+		errGroup.Go(func() error {
+			row, err := la.getter.GetRow(rowsCtx, header, idx)
+			if err != nil {
+				return fmt.Errorf("getting row %d: %w", idx, err)
+			}
+			shares, err := row.Shares()
+			if err != nil {
+				return fmt.Errorf("getting shares of row %d: %w", idx, err)
+			}
+
+			rowsLk.Lock()
+			rows[idx] = shares
+			rowsLk.Unlock()
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	nds := make([]shwap.NamespaceData, len(namespaces))
+	for i, ns := range namespaces {
+		nds[i] = make(shwap.NamespaceData, len(namespaceRows[i]))
+		for j, idx := range namespaceRows[i] {
+			rnd, err := shwap.RowNamespaceDataFromShares(rows[idx], ns, idx)
+			if err != nil {
+				return nil, fmt.Errorf("extracting namespace %s data from row %d: %w", ns.String(), idx, err)
+			}
+			nds[i][j] = rnd
+		}
+	}
+	return nds, nil
+}
+
+// addPending keeps the header to retry fetching its namespaces of interest later. The lowest
+// height is dropped once maxPendingNamespaces headers are kept.
+func (la *ShareAvailability) addPending(header *header.ExtendedHeader) {
+	la.pendingLk.Lock()
+	defer la.pendingLk.Unlock()
+	if len(la.pending) >= maxPendingNamespaces {
+		lowest := uint64(math.MaxUint64)
+		for height := range la.pending {
+			lowest = min(lowest, height)
+		}
+		delete(la.pending, lowest)
+	}
+	la.pending[header.Height()] = header
+}
+
+// retryPending retries fetching the namespaces of interest of up to namespacesRetries pending
+// headers other than the one of the given height. The headers outside the sampling window are
+// dropped.
+func (la *ShareAvailability) retryPending(ctx context.Context, height uint64) {
+	la.pendingLk.Lock()
+	retries := make([]*header.ExtendedHeader, 0, namespacesRetries)
+	for h, eh := range la.pending {
+		if len(retries) == namespacesRetries {
+			break
+		}
+		if h == height {
+			continue
+		}
+		delete(la.pending, h)
+		retries = append(retries, eh)
+	}
+	la.pendingLk.Unlock()
+
+	for _, eh := range retries {
+		if !availability.IsWithinWindow(eh.Time(), la.samplingWindow) {
+			continue
+		}
+		if err := la.fetchNamespaces(ctx, eh); err != nil {
+			log.Debugw("retrying namespaces of interest", "height", eh.Height(), "err", err)
+			la.addPending(eh)
+		}
+	}
+}
+
+// removePending stops retrying fetching the namespaces of interest of the given height.
+func (la *ShareAvailability) removePending(height uint64) {
+	la.pendingLk.Lock()
+	delete(la.pending, height)
+	la.pendingLk.Unlock()
+}
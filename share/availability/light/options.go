@@ -1,7 +1,11 @@
 package light
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
 )
 
 // DefaultSampleAmount specifies the minimum required amount of samples a light node must perform
@@ -10,10 +14,32 @@ var (
 	DefaultSampleAmount uint = 16
 )
 
+// maxLightNodes is the maximum amount of light nodes the Confidence may be targeted for.
+// Assuming more light nodes lowers the samples taken by each of them, while the assumption
+// can't be verified, so it is capped.
+const maxLightNodes = 1000
+
 // Parameters is the set of Parameters that must be configured for the light
 // availability implementation
 type Parameters struct {
 	SampleAmount uint // The minimum required amount of samples to perform
+	// Confidence is the target confidence that a sampled square is recoverable, e.g. 0.999999.
+	// If set, the amount of samples is derived out of the square size, but never goes below
+	// SampleAmount. A higher confidence costs more samples, so more bandwidth, per block.
+	Confidence float64 `toml:",omitempty"`
+	// LightNodes is the amount of independent light nodes assumed to sample the network. The
+	// Confidence is targeted for the light nodes to collectively detect an unrecoverable square,
+	// so the more light nodes are assumed, the fewer samples each of them takes. That saves
+	// bandwidth, but weakens the guarantee of a single node if fewer nodes actually sample,
+	// which is why SampleAmount stays the floor. It requires Confidence and is capped at 1000.
+	LightNodes uint `toml:",omitempty"`
+	// Namespaces are the hex-encoded namespaces of interest. Along with the random samples,
+	// the rows containing the namespaces are fetched and verified, and the namespace data is
+	// kept until the block is pruned.
+	Namespaces []string `toml:",omitempty"`
+
+	// namespaces are the decoded namespaces of interest set by WithNamespaces
+	namespaces []libshare.Namespace
 }
 
 // Option is a function that configures light availability Parameters
@@ -38,9 +64,52 @@ func (p *Parameters) Validate() error {
 		)
 	}
 
+	if p.Confidence < 0 || p.Confidence >= 1 {
+		return fmt.Errorf(
+			"light availability: invalid option: value %s was %f, where it should be within [0, 1)",
+			"Confidence",
+			p.Confidence,
+		)
+	}
+
+	if p.LightNodes > 0 && p.Confidence == 0 {
+		return errors.New("light availability: invalid option: LightNodes is set without Confidence")
+	}
+	if p.LightNodes > maxLightNodes {
+		return fmt.Errorf(
+			"light availability: invalid option: value %s was %d, where it should be <= %d",
+			"LightNodes",
+			p.LightNodes,
+			maxLightNodes,
+		)
+	}
+
+	if _, err := p.DecodeNamespaces(); err != nil {
+		return fmt.Errorf("light availability: invalid option: Namespaces: %w", err)
+	}
 	return nil
 }
 
+// DecodeNamespaces decodes the Namespaces.
+func (p *Parameters) DecodeNamespaces() ([]libshare.Namespace, error) {
+	namespaces := make([]libshare.Namespace, 0, len(p.Namespaces))
+	for _, nsString := range p.Namespaces {
+		nsBytes, err := hex.DecodeString(nsString)
+		if err != nil {
+			return nil, fmt.Errorf("decoding namespace %s: %w", nsString, err)
+		}
+		ns, err := libshare.NewNamespaceFromBytes(nsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("decoding namespace %s: %w", nsString, err)
+		}
+		if err := ns.ValidateForData(); err != nil {
+			return nil, fmt.Errorf("namespace %s: %w", nsString, err)
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces, nil
+}
+
 // WithSampleAmount is a functional option that the Availability interface
 // implementers use to set the SampleAmount configuration param
 func WithSampleAmount(sampleAmount uint) Option {
@@ -48,3 +117,36 @@ func WithSampleAmount(sampleAmount uint) Option {
 		p.SampleAmount = sampleAmount
 	}
 }
+
+// WithConfidence is a functional option that the Availability interface
+// implementers use to set the Confidence and LightNodes configuration params
+func WithConfidence(confidence float64, lightNodes uint) Option {
+	return func(p *Parameters) {
+		p.Confidence = confidence
+		p.LightNodes = lightNodes
+	}
+}
+
+// sampleAmount returns the amount of samples to take out of the extended square of the given width.
+// The amount derived out of the Confidence is floored by the SampleAmount.
+func (p *Parameters) sampleAmount(squareWidth int) int {
+	amount := int(p.SampleAmount)
+	if p.Confidence > 0 {
+		amount = max(amount, sampleAmountFor(p.Confidence, p.LightNodes, squareWidth))
+	}
+	return min(amount, squareWidth*squareWidth)
+}
+
+// confidence returns the confidence a single light node achieves with the samples taken out of the
+// extended square of the given width.
+func (p *Parameters) confidence(squareWidth int) float64 {
+	return confidenceOf(p.sampleAmount(squareWidth), squareWidth)
+}
+
+// WithNamespaces is a functional option that the Availability interface
+// implementers use to set the namespaces of interest, e.g. the ones decoded out of the Namespaces
+func WithNamespaces(namespaces ...libshare.Namespace) Option {
+	return func(p *Parameters) {
+		p.namespaces = append(p.namespaces, namespaces...)
+	}
+}
@@ -13,6 +13,9 @@ import (
 type SamplingResult struct {
 	Available []shwap.SampleCoords `json:"available"`
 	Remaining []shwap.SampleCoords `json:"remaining"`
+	// Confidence is the confidence that the square is recoverable the samples achieve.
+	// It is omitted by the results stored before it was introduced.
+	Confidence float64 `json:"confidence,omitempty"`
 }
 
 // NewSamplingResult creates a new SamplingResult with randomly selected samples.
@@ -28,6 +31,30 @@ func NewSamplingResult(squareSize, sampleCount int) *SamplingResult {
 	}
 }
 
+// extend adds randomly selected samples not taken yet to the remaining ones, so the result holds
+// the given amount of samples.
+func (sr *SamplingResult) extend(squareSize, sampleCount int) {
+	sampleCount = min(sampleCount, squareSize*squareSize)
+	taken := make(map[shwap.SampleCoords]struct{}, sampleCount)
+	for _, s := range sr.Available {
+		taken[s] = struct{}{}
+	}
+	for _, s := range sr.Remaining {
+		taken[s] = struct{}{}
+	}
+	for len(taken) < sampleCount {
+		s := shwap.SampleCoords{
+			Row: randInt(squareSize),
+			Col: randInt(squareSize),
+		}
+		if _, ok := taken[s]; ok {
+			continue
+		}
+		taken[s] = struct{}{}
+		sr.Remaining = append(sr.Remaining, s)
+	}
+}
+
 // selectRandomSamples randomly picks unique coordinates from a square of given size.
 func selectRandomSamples(squareSize, sampleCount int) []shwap.SampleCoords {
 	total := squareSize * squareSize
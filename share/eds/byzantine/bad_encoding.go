@@ -0,0 +1,218 @@
+package byzantine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v9/pkg/wrapper"
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/fraud"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+const (
+	version = "v0.1"
+
+	// BadEncoding is the ProofType of the Bad Encoding Fraud Proof.
+	BadEncoding fraud.ProofType = "badencoding" + version
+)
+
+var (
+	errIncorrectIndex   = errors.New("fraud: incorrect index")
+	errIncorrectAmount  = errors.New("fraud: incorrect amount of shares")
+	errNotEnoughShares  = errors.New("fraud: not enough shares to reconstruct the axis")
+	errInvalidProof     = errors.New("fraud: invalid proof: recomputed Merkle root matches the DAH's axis root")
+	errInvalidShareType = errors.New("fraud: invalid share proof")
+)
+
+var _ fraud.Proof = (*BadEncodingProof)(nil)
+
+// BadEncodingProof is a fraud proof that is created when a row or column of the extended data
+// square was erasure coded incorrectly by the block producer.
+type BadEncodingProof struct {
+	headerHash  []byte
+	BlockHeight uint64
+	// ShareWithProofs contains all shares from row or col.
+	// Shares that did not pass verification in rsmt2d will be nil.
+	// For non-nil shares MerkleProofs are computed.
+	Shares []*ShareWithProof
+	// Index represents the row/col index where ErrByzantineRow/ErrByzantineColl occurred.
+	Index uint32
+	// Axis represents the axis that verification failed on.
+	Axis rsmt2d.Axis
+}
+
+// CreateBadEncodingProof creates a new Bad Encoding Fraud Proof that should be propagated through
+// network. The fraud proof will contain shares that did not pass verification and their relevant
+// Merkle proofs.
+func CreateBadEncodingProof(
+	hash []byte,
+	height uint64,
+	errByzantine *ErrByzantine,
+) *BadEncodingProof {
+	return &BadEncodingProof{
+		headerHash:  hash,
+		BlockHeight: height,
+		Shares:      errByzantine.Shares,
+		Index:       errByzantine.Index,
+		Axis:        errByzantine.Axis,
+	}
+}
+
+// Type returns type of fraud proof.
+func (p *BadEncodingProof) Type() fraud.ProofType {
+	return BadEncoding
+}
+
+// HeaderHash returns block hash.
+func (p *BadEncodingProof) HeaderHash() []byte {
+	return p.headerHash
+}
+
+// Height returns block height.
+func (p *BadEncodingProof) Height() uint64 {
+	return p.BlockHeight
+}
+
+// MarshalBinary converts BadEncodingProof to binary.
+func (p *BadEncodingProof) MarshalBinary() ([]byte, error) {
+	return json.Marshal(&jsonBadEncodingProof{
+		HeaderHash:  p.headerHash,
+		BlockHeight: p.BlockHeight,
+		Shares:      p.Shares,
+		Index:       p.Index,
+		Axis:        p.Axis,
+	})
+}
+
+// UnmarshalBinary converts binary to BadEncodingProof.
+func (p *BadEncodingProof) UnmarshalBinary(data []byte) error {
+	var befp jsonBadEncodingProof
+	if err := json.Unmarshal(data, &befp); err != nil {
+		return err
+	}
+
+	*p = BadEncodingProof{
+		headerHash:  befp.HeaderHash,
+		BlockHeight: befp.BlockHeight,
+		Shares:      befp.Shares,
+		Index:       befp.Index,
+		Axis:        befp.Axis,
+	}
+	return nil
+}
+
+// UnmarshalBadEncodingProof decodes the binary representation of a BadEncodingProof.
+func UnmarshalBadEncodingProof(data []byte) (fraud.Proof, error) {
+	befp := &BadEncodingProof{}
+	if err := befp.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return befp, nil
+}
+
+// Validate ensures that fraud proof is correct.
+// Validate checks that provided Merkle Proofs correspond to the shares,
+// rebuilds bad row or col from received shares, computes Merkle Root
+// and compares it with block's Merkle Root.
+func (p *BadEncodingProof) Validate(hdr *header.ExtendedHeader) error {
+	if hdr.Height() != p.BlockHeight {
+		return fmt.Errorf("fraud: incorrect block height: expected %d, got %d", hdr.Height(), p.BlockHeight)
+	}
+	if !bytes.Equal(hdr.Hash(), p.headerHash) {
+		return fmt.Errorf("fraud: incorrect hash: expected %X, got %X", hdr.Hash(), p.headerHash)
+	}
+
+	roots := &share.AxisRoots{
+		RowRoots:    hdr.DAH.RowRoots,
+		ColumnRoots: hdr.DAH.ColumnRoots,
+	}
+	width := len(roots.RowRoots)
+	if int(p.Index) >= width {
+		return fmt.Errorf("%w: index %d, square width %d", errIncorrectIndex, p.Index, width)
+	}
+	if len(p.Shares) != width {
+		return fmt.Errorf("%w: expected %d, got %d", errIncorrectAmount, width, len(p.Shares))
+	}
+
+	var root []byte
+	switch p.Axis {
+	case rsmt2d.Row:
+		root = roots.RowRoots[p.Index]
+	case rsmt2d.Col:
+		root = roots.ColumnRoots[p.Index]
+	default:
+		return fmt.Errorf("fraud: invalid axis type: %d", p.Axis)
+	}
+
+	// verify that Merkle proofs correspond to particular shares.
+	shares := make([][]byte, width)
+	var numShares int
+	for index, shr := range p.Shares {
+		if shr == nil {
+			continue
+		}
+		if shr.Proof == nil {
+			return fmt.Errorf("%w: share at index %d has no proof", errInvalidShareType, index)
+		}
+		// validate inclusion of the share into one of the DAHeader roots
+		if ok := shr.Validate(roots, p.Axis, int(p.Index), index); !ok {
+			return fmt.Errorf("%w: index %d", errInvalidShareType, index)
+		}
+		shares[index] = shr.ToBytes()
+		numShares++
+	}
+
+	odsWidth := width / 2
+	// check if we have enough shares to reconstruct the data based on rsmt2d
+	if numShares < odsWidth {
+		return fmt.Errorf("%w: got %d, need %d", errNotEnoughShares, numShares, odsWidth)
+	}
+
+	codec := share.DefaultRSMT2DCodec()
+	rebuiltShares, err := codec.Decode(shares)
+	if err != nil {
+		return fmt.Errorf("fraud: decoding shares: %w", err)
+	}
+
+	rebuiltExtendedShares, err := codec.Encode(rebuiltShares[:odsWidth])
+	if err != nil {
+		return fmt.Errorf("fraud: encoding shares: %w", err)
+	}
+	copy(rebuiltShares[odsWidth:], rebuiltExtendedShares)
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), uint(p.Index))
+	for _, shr := range rebuiltShares {
+		if len(shr) != libshare.ShareSize {
+			return fmt.Errorf("fraud: rebuilt share of invalid size: %d", len(shr))
+		}
+		if err = tree.Push(shr); err != nil {
+			return fmt.Errorf("fraud: building tree: %w", err)
+		}
+	}
+
+	expectedRoot, err := tree.Root()
+	if err != nil {
+		return fmt.Errorf("fraud: computing root: %w", err)
+	}
+
+	// root is a merkle root of the row/col where ErrByzantine occurred
+	// expectedRoot is a merkle root of the rebuilt row/col
+	if bytes.Equal(expectedRoot, root) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+type jsonBadEncodingProof struct {
+	HeaderHash  []byte            `json:"header_hash"`
+	BlockHeight uint64            `json:"height"`
+	Shares      []*ShareWithProof `json:"shares"`
+	Index       uint32            `json:"index"`
+	Axis        rsmt2d.Axis       `json:"axis"`
+}
@@ -0,0 +1,146 @@
+package byzantine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v9/pkg/wrapper"
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+func TestBEFP_Validate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	bServ := ipld.NewMemBlockservice()
+
+	const odsSize = 16
+	square := edstest.RandByzantineEDS(t, odsSize)
+	err := ipld.ImportEDS(ctx, square, bServ)
+	require.NoError(t, err)
+	eh := headertest.ExtendedHeaderFromEDS(t, 1, square)
+
+	errByz := repairByzantine(t, square)
+	err = NewErrByzantine(ctx, bServ.Blockstore(), eh.DAH, errByz)
+	var byzantine *ErrByzantine
+	require.ErrorAs(t, err, &byzantine)
+
+	proof := CreateBadEncodingProof(eh.Hash(), eh.Height(), byzantine)
+
+	t.Run("valid BEFP", func(t *testing.T) {
+		require.NoError(t, proof.Validate(eh))
+	})
+
+	t.Run("serde", func(t *testing.T) {
+		bin, err := proof.MarshalBinary()
+		require.NoError(t, err)
+
+		decoded, err := UnmarshalBadEncodingProof(bin)
+		require.NoError(t, err)
+		require.Equal(t, BadEncoding, decoded.Type())
+		require.Equal(t, proof.Height(), decoded.Height())
+		require.Equal(t, proof.HeaderHash(), decoded.HeaderHash())
+		require.NoError(t, decoded.Validate(eh))
+	})
+
+	t.Run("mismatched header", func(t *testing.T) {
+		other := headertest.ExtendedHeaderFromEDS(t, 1, square)
+		require.Error(t, proof.Validate(other))
+	})
+
+	t.Run("incorrect index", func(t *testing.T) {
+		befp := *proof
+		befp.Index = uint32(len(eh.DAH.RowRoots))
+		require.ErrorIs(t, befp.Validate(eh), errIncorrectIndex)
+	})
+
+	t.Run("not enough shares", func(t *testing.T) {
+		befp := *proof
+		befp.Shares = make([]*ShareWithProof, len(proof.Shares))
+		copy(befp.Shares, proof.Shares[:odsSize/2])
+		require.ErrorIs(t, befp.Validate(eh), errNotEnoughShares)
+	})
+
+	t.Run("tampered share", func(t *testing.T) {
+		befp := *proof
+		befp.Shares = make([]*ShareWithProof, len(proof.Shares))
+		copy(befp.Shares, proof.Shares)
+		for i, shr := range befp.Shares {
+			if shr == nil {
+				continue
+			}
+			rnd, err := libshare.RandShares(1)
+			require.NoError(t, err)
+			befp.Shares[i] = &ShareWithProof{Share: rnd[0], Proof: shr.Proof, Axis: shr.Axis}
+			break
+		}
+		require.ErrorIs(t, befp.Validate(eh), errInvalidShareType)
+	})
+}
+
+func TestBEFP_ValidateHonestSquare(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	bServ := ipld.NewMemBlockservice()
+
+	const odsSize = 8
+	square := edstest.RandEDS(t, odsSize)
+	err := ipld.ImportEDS(ctx, square, bServ)
+	require.NoError(t, err)
+	eh := headertest.ExtendedHeaderFromEDS(t, 1, square)
+
+	row := square.Row(0)
+	shares := make([]*ShareWithProof, len(row))
+	for i, raw := range row {
+		shr, err := libshare.NewShare(raw)
+		require.NoError(t, err)
+		shares[i], err = GetShareWithProof(ctx, bServ, eh.DAH, shr, rsmt2d.Row, 0, i)
+		require.NoError(t, err)
+	}
+
+	proof := &BadEncodingProof{
+		headerHash:  eh.Hash(),
+		BlockHeight: eh.Height(),
+		Shares:      shares,
+		Index:       0,
+		Axis:        rsmt2d.Row,
+	}
+	require.ErrorIs(t, proof.Validate(eh), errInvalidProof)
+}
+
+// repairByzantine drops a single share from the given byzantine square and attempts to repair it,
+// returning the rsmt2d error that is produced for the corrupted axis.
+func repairByzantine(t *testing.T, square *rsmt2d.ExtendedDataSquare) *rsmt2d.ErrByzantineData {
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+
+	width := square.Width()
+	partial, err := rsmt2d.NewExtendedDataSquare(
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(uint64(width/2)),
+		width,
+		libshare.ShareSize,
+	)
+	require.NoError(t, err)
+	for row := range width {
+		for col := range width {
+			if row == width-1 && col == width-1 {
+				continue
+			}
+			require.NoError(t, partial.SetCell(row, col, square.GetCell(row, col)))
+		}
+	}
+
+	err = partial.Repair(roots.RowRoots, roots.ColumnRoots)
+	var errByz *rsmt2d.ErrByzantineData
+	require.ErrorAs(t, err, &errByz)
+	return errByz
+}
@@ -2,6 +2,7 @@ package byzantine
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/ipfs/boxo/blockservice"
@@ -27,6 +28,37 @@ type ShareWithProof struct {
 	Axis rsmt2d.Axis
 }
 
+// MarshalJSON encodes ShareWithProof to the json encoded bytes.
+func (s *ShareWithProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&jsonShareWithProof{
+		Share: s.Share,
+		Proof: s.Proof,
+		Axis:  s.Axis,
+	})
+}
+
+// UnmarshalJSON decodes bytes to the ShareWithProof.
+func (s *ShareWithProof) UnmarshalJSON(data []byte) error {
+	var jsonShare jsonShareWithProof
+	if err := json.Unmarshal(data, &jsonShare); err != nil {
+		return err
+	}
+	if jsonShare.Proof == nil {
+		return errors.New("share without proof")
+	}
+
+	s.Share = jsonShare.Share
+	s.Proof = jsonShare.Proof
+	s.Axis = jsonShare.Axis
+	return nil
+}
+
+type jsonShareWithProof struct {
+	Share libshare.Share `json:"share"`
+	Proof *nmt.Proof     `json:"proof"`
+	Axis  rsmt2d.Axis    `json:"axis"`
+}
+
 // Validate validates inclusion of the share under the given root CID.
 func (s *ShareWithProof) Validate(roots *share.AxisRoots, axisType rsmt2d.Axis, axisIdx, shrIdx int) bool {
 	var rootHash []byte
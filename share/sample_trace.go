@@ -0,0 +1,37 @@
+package share
+
+import (
+	"context"
+	"time"
+)
+
+// SampleTrace describes the outcome of a single sample taken while sampling a block.
+type SampleTrace struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+	// Peer is the peer that served the sample. It is empty if the sample was served locally,
+	// or the peer is unknown.
+	Peer string `json:"peer,omitempty"`
+	// Latency is the time passed from requesting the sample until it was received.
+	Latency time.Duration `json:"latency"`
+	// Available tells whether the sample was received and verified.
+	Available bool `json:"available"`
+}
+
+// SampleTracer is notified about every sample taken within the context it is attached to.
+// It may be called concurrently.
+type SampleTracer func(SampleTrace)
+
+type sampleTracerKey struct{}
+
+// WithSampleTracer attaches the SampleTracer to the context.
+func WithSampleTracer(ctx context.Context, tracer SampleTracer) context.Context {
+	return context.WithValue(ctx, sampleTracerKey{}, tracer)
+}
+
+// TraceSample notifies the SampleTracer attached to the context, if any.
+func TraceSample(ctx context.Context, trace SampleTrace) {
+	if tracer, ok := ctx.Value(sampleTracerKey{}).(SampleTracer); ok && tracer != nil {
+		tracer(trace)
+	}
+}
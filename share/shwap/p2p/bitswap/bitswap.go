@@ -128,6 +128,8 @@ func NewClient(
 		// Prevents Has calls to Blockstore for metric that counts duplicates
 		// Unnecessary for our use case, so we can save some disk lookups.
 		client.WithoutDuplicatedBlockStats(),
+		// Records the peers serving the Blocks, so they can be reported. See [WithSources].
+		client.WithBlockReceivedNotifier(sourceNotifier{}),
 
 		// These two options have mixed up named. One should be another and vice versa.
 		client.ProviderSearchDelay(broadcastDelay),
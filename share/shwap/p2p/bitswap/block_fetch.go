@@ -104,6 +104,7 @@ func fetch(
 		if err := exchg.NotifyNewBlocks(ctx, bitswapBlk); err != nil {
 			log.Error("failed to notify the new Bitswap block: %s", err)
 		}
+		options.collectSource(bitswapBlk.Cid())
 
 		blk, ok := duplicates[bitswapBlk.Cid()]
 		if ok {
@@ -172,6 +173,9 @@ var unmarshalFns sync.Map
 type unmarshalEntry struct {
 	sync.Mutex
 	UnmarshalFn
+
+	// source is set once the Block is received. See [WithSources].
+	source *BlockSource
 }
 
 // hasher implements hash.Hash to be registered as custom multihash
@@ -252,6 +256,7 @@ type FetchOption func(*fetchOptions)
 type fetchOptions struct {
 	Session exchange.Fetcher
 	Store   blockstore.Blockstore
+	Sources map[cid.Cid]BlockSource
 }
 
 func (options *fetchOptions) getFetcher(exhng exchange.Interface) exchange.Fetcher {
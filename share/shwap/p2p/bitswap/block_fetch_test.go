@@ -69,6 +69,27 @@ func TestFetch_Options(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, len(blks), fetcher.Fetched)
 	})
+
+	t.Run("WithSources", func(t *testing.T) {
+		exchange := newExchange(ctx, t, bstore)
+
+		blks := make([]Block, 0, cids.Len())
+		_ = cids.ForEach(func(c cid.Cid) error {
+			blk, err := newEmptyTestBlock(c)
+			require.NoError(t, err)
+			blks = append(blks, blk)
+			return nil
+		})
+
+		sources := make(map[cid.Cid]BlockSource)
+		err := Fetch(ctx, exchange, nil, blks, WithSources(sources))
+		require.NoError(t, err)
+		require.Len(t, sources, len(blks))
+		for _, src := range sources {
+			require.NotEmpty(t, src.Peer)
+			require.False(t, src.ReceivedAt.IsZero())
+		}
+	})
 }
 
 func TestFetch_Duplicates(t *testing.T) {
@@ -0,0 +1,63 @@
+package bitswap
+
+import (
+	"time"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// BlockSource describes the peer a Block was received from and when.
+type BlockSource struct {
+	Peer       peer.ID
+	ReceivedAt time.Time
+}
+
+// WithSources instructs [Fetch] to collect the sources of the fetched Blocks into the given map.
+// Blocks served locally have no source.
+func WithSources(sources map[cid.Cid]BlockSource) FetchOption {
+	return func(options *fetchOptions) {
+		options.Sources = sources
+	}
+}
+
+// sourceNotifier records the sources of the Blocks being fetched for [Fetch] to collect.
+// It implements Bitswap client's BlockReceivedNotifier.
+type sourceNotifier struct{}
+
+func (sourceNotifier) ReceivedBlocks(from peer.ID, blks []blocks.Block) {
+	now := time.Now()
+	for _, blk := range blks {
+		val, ok := unmarshalFns.Load(blk.Cid())
+		if !ok {
+			continue
+		}
+		entry := val.(*unmarshalEntry)
+
+		entry.Lock()
+		if entry.source == nil {
+			entry.source = &BlockSource{Peer: from, ReceivedAt: now}
+		}
+		entry.Unlock()
+	}
+}
+
+// collectSource records the source of the Block with the given CID, if requested and known.
+func (options *fetchOptions) collectSource(cid cid.Cid) {
+	if options.Sources == nil {
+		return
+	}
+
+	val, ok := unmarshalFns.Load(cid)
+	if !ok {
+		return
+	}
+	entry := val.(*unmarshalEntry)
+
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.source != nil {
+		options.Sources[cid] = *entry.source
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ipfs/boxo/blockstore"
 	"github.com/ipfs/boxo/exchange"
+	"github.com/ipfs/go-cid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -94,6 +95,7 @@ func (g *Getter) GetSamples(
 	}
 
 	blks := make([]Block, len(indices))
+	cids := make([]cid.Cid, len(indices))
 	for i, idx := range indices {
 		sid, err := NewEmptySampleBlock(hdr.Height(), idx, len(hdr.DAH.RowRoots))
 		if err != nil {
@@ -101,6 +103,7 @@ func (g *Getter) GetSamples(
 		}
 
 		blks[i] = sid
+		cids[i] = sid.CID()
 	}
 
 	isArchival := g.isArchival(hdr)
@@ -109,7 +112,10 @@ func (g *Getter) GetSamples(
 	ses, release := g.getSession(isArchival)
 	defer release()
 
-	err = Fetch(ctx, g.exchange, hdr.DAH, blks, WithStore(g.bstore), WithFetcher(ses))
+	start := time.Now()
+	sources := make(map[cid.Cid]BlockSource, len(blks))
+	err = Fetch(ctx, g.exchange, hdr.DAH, blks, WithStore(g.bstore), WithFetcher(ses), WithSources(sources))
+	fetchTime := time.Since(start)
 
 	var fetched int
 	smpls := make([]shwap.Sample, len(blks))
@@ -119,6 +125,18 @@ func (g *Getter) GetSamples(
 			fetched++
 			smpls[i] = c
 		}
+
+		trace := share.SampleTrace{
+			Row:       indices[i].Row,
+			Col:       indices[i].Col,
+			Latency:   fetchTime,
+			Available: !c.IsEmpty(),
+		}
+		if src, ok := sources[cids[i]]; ok {
+			trace.Peer = src.Peer.String()
+			trace.Latency = src.ReceivedAt.Sub(start)
+		}
+		share.TraceSample(ctx, trace)
 	}
 
 	if err != nil {
@@ -48,9 +48,13 @@ var (
 
 type TxConfig = txclient.TxConfig
 
+// PendingSubmission is a PayForBlob submission that is not confirmed yet.
+type PendingSubmission = txclient.PendingSubmission
+
 type TxClient interface {
 	SubmitMessage(context.Context, sdktypes.Msg, *txclient.TxConfig) (*user.TxResponse, error)
 	SubmitPayForBlob(context.Context, []*libshare.Blob, sdktypes.AccAddress, *txclient.TxConfig) (*user.TxResponse, error)
+	PendingSubmissions(context.Context) ([]*txclient.PendingSubmission, error)
 }
 
 // CoreAccessor implements service over a gRPC connection
@@ -545,6 +549,11 @@ func (ca *CoreAccessor) RevokeGrantFee(
 	return convertToSdkTxResponse(response), nil
 }
 
+// PendingSubmissions returns all the PayForBlob submissions that are not confirmed yet.
+func (ca *CoreAccessor) PendingSubmissions(ctx context.Context) ([]*PendingSubmission, error) {
+	return ca.txClient.PendingSubmissions(ctx)
+}
+
 func (ca *CoreAccessor) LastPayForBlob() int64 {
 	ca.lock.Lock()
 	defer ca.lock.Unlock()
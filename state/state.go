@@ -82,6 +82,7 @@ var (
 	TxPriorityHigh        = txclient.TxPriorityHigh
 
 	ErrGasPriceExceedsLimit = txclient.ErrGasPriceExceedsLimit
+	ErrTxEvicted            = txclient.ErrTxEvicted
 
 	WithEstimatorService        = txclient.WithEstimatorService
 	WithEstimatorServiceTLS     = txclient.WithEstimatorServiceTLS
@@ -1,6 +1,7 @@
 package txclient
 
 import (
+	"github.com/ipfs/go-datastore"
 	"google.golang.org/grpc"
 )
 
@@ -42,3 +43,12 @@ func WithTxWorkerAccounts(workerAccounts int) Option {
 		c.txWorkerAccounts = workerAccounts
 	}
 }
+
+// WithSubmissionQueue enables persistence of pending PayForBlob submissions in the given
+// datastore. Submissions that were not confirmed before the node stopped are retried
+// with a bumped gas price on the next start.
+func WithSubmissionQueue(ds datastore.Datastore) Option {
+	return func(c *TxClient) {
+		c.queue = newSubmissionQueue(ds)
+	}
+}
@@ -0,0 +1,263 @@
+package txclient
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+)
+
+var queuePrefix = datastore.NewKey("pfb_queue")
+
+const (
+	// gasPriceBumpFactor is the multiplier applied to the last used gas price
+	// when a pending submission is retried after a restart.
+	gasPriceBumpFactor = 1.2
+	// maxSubmissionAttempts is the maximum number of times a pending submission is
+	// retried after a restart before it is dropped from the queue.
+	maxSubmissionAttempts = 5
+)
+
+// PendingSubmission is a PayForBlob submission that was accepted by the node,
+// but has not been confirmed yet.
+type PendingSubmission struct {
+	ID     uint64           `json:"id"`
+	Blobs  []*libshare.Blob `json:"blobs"`
+	Author string           `json:"author"`
+	Config *TxConfig        `json:"config"`
+	// GasPrice is the gas price used for the last broadcast of the submission.
+	GasPrice float64 `json:"gas_price,omitempty"`
+	// TxHash is the hash of the last broadcasted transaction of the submission.
+	// It is empty when the transaction was not broadcasted yet, or was submitted through
+	// the parallel submission queue, which reports the hash only along with the result.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Attempts is the number of times the submission was retried after a restart.
+	Attempts  uint64    `json:"attempts"`
+	LastError string    `json:"last_error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// author returns the parsed address of the submission's author.
+func (p *PendingSubmission) author() (types.AccAddress, error) {
+	return types.AccAddressFromBech32(p.Author)
+}
+
+// bumpedConfig returns a copy of the submission's TxConfig with the gas price increased
+// by gasPriceBumpFactor and capped by the max gas price. If the submission was never
+// broadcasted, the original config is returned.
+func (p *PendingSubmission) bumpedConfig() *TxConfig {
+	cfg := *p.Config
+	if p.GasPrice <= 0 {
+		return &cfg
+	}
+
+	cfg.gasPrice = math.Min(p.GasPrice*gasPriceBumpFactor, cfg.MaxGasPrice())
+	cfg.isGasPriceSet = true
+	return &cfg
+}
+
+// submissionQueue persists pending PayForBlob submissions in the datastore so they
+// can be retried in case the node restarts before they are confirmed.
+type submissionQueue struct {
+	ds datastore.Datastore
+
+	lk     sync.Mutex
+	lastID uint64
+}
+
+func newSubmissionQueue(ds datastore.Datastore) *submissionQueue {
+	return &submissionQueue{ds: namespace.Wrap(ds, queuePrefix)}
+}
+
+// start loads the last used submission ID from the datastore.
+func (q *submissionQueue) start(ctx context.Context) error {
+	pending, err := q.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	q.lk.Lock()
+	defer q.lk.Unlock()
+	for _, p := range pending {
+		q.lastID = max(q.lastID, p.ID)
+	}
+	return nil
+}
+
+// add persists a new pending submission.
+func (q *submissionQueue) add(
+	ctx context.Context,
+	blobs []*libshare.Blob,
+	author types.AccAddress,
+	cfg *TxConfig,
+) (*PendingSubmission, error) {
+	q.lk.Lock()
+	q.lastID++
+	id := q.lastID
+	q.lk.Unlock()
+
+	pending := &PendingSubmission{
+		ID:        id,
+		Blobs:     blobs,
+		Author:    author.String(),
+		Config:    cfg,
+		CreatedAt: time.Now().UTC(),
+	}
+	return pending, q.update(ctx, pending)
+}
+
+// update persists the current state of the pending submission.
+func (q *submissionQueue) update(ctx context.Context, pending *PendingSubmission) error {
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return fmt.Errorf("marshaling pending submission %d: %w", pending.ID, err)
+	}
+	return q.ds.Put(ctx, submissionKey(pending.ID), data)
+}
+
+// remove deletes the pending submission from the queue.
+func (q *submissionQueue) remove(ctx context.Context, id uint64) error {
+	return q.ds.Delete(ctx, submissionKey(id))
+}
+
+// list returns all the pending submissions ordered by their IDs.
+func (q *submissionQueue) list(ctx context.Context) ([]*PendingSubmission, error) {
+	results, err := q.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	pending := make([]*PendingSubmission, 0)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		p := &PendingSubmission{}
+		if err := json.Unmarshal(result.Value, p); err != nil {
+			return nil, fmt.Errorf("unmarshaling pending submission %s: %w", result.Key, err)
+		}
+		pending = append(pending, p)
+	}
+
+	slices.SortFunc(pending, func(a, b *PendingSubmission) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return pending, nil
+}
+
+func submissionKey(id uint64) datastore.Key {
+	return datastore.NewKey(strconv.FormatUint(id, 10))
+}
+
+// PendingSubmissions returns all the PayForBlob submissions that are not confirmed yet.
+func (c *TxClient) PendingSubmissions(ctx context.Context) ([]*PendingSubmission, error) {
+	if c.queue == nil {
+		return nil, errors.New("state: submission queue is not enabled")
+	}
+	return c.queue.list(ctx)
+}
+
+// recoverSubmissions retries all the submissions left in the queue from the previous run
+// of the node, bumping their gas price on each attempt.
+func (c *TxClient) recoverSubmissions(ctx context.Context) {
+	pending, err := c.queue.list(ctx)
+	if err != nil {
+		log.Errorw("listing pending submissions", "err", err)
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	// the client may not be set up yet if the core endpoint was unavailable during start
+	for c.setupClient() != nil {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second * 5):
+		}
+	}
+
+	log.Infow("recovering pending submissions", "amount", len(pending))
+	for _, p := range pending {
+		c.recoverSubmission(ctx, p)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (c *TxClient) recoverSubmission(ctx context.Context, pending *PendingSubmission) {
+	if pending.TxHash != "" {
+		// the transaction could have been committed while the node was offline
+		resp, err := c.client.ConfirmTx(ctx, pending.TxHash)
+		if err == nil && resp.Code == 0 {
+			log.Infow("pending submission was confirmed",
+				"id", pending.ID, "hash", pending.TxHash, "height", resp.Height)
+			c.removeSubmission(ctx, pending)
+			return
+		}
+	}
+
+	author, err := pending.author()
+	if err != nil {
+		log.Errorw("dropping pending submission with invalid author", "id", pending.ID, "err", err)
+		c.removeSubmission(ctx, pending)
+		return
+	}
+
+	for pending.Attempts < maxSubmissionAttempts {
+		pending.Attempts++
+		resp, err := c.submitPayForBlob(ctx, pending.Blobs, author, pending.bumpedConfig(), pending)
+		if err == nil {
+			log.Infow("pending submission was resubmitted",
+				"id", pending.ID, "hash", resp.TxHash, "height", resp.Height, "attempt", pending.Attempts)
+			c.removeSubmission(ctx, pending)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		pending.LastError = err.Error()
+		if err := c.queue.update(ctx, pending); err != nil {
+			log.Errorw("updating pending submission", "id", pending.ID, "err", err)
+		}
+		log.Warnw("resubmitting pending submission", "id", pending.ID, "attempt", pending.Attempts, "err", err)
+	}
+
+	log.Errorw("dropping pending submission after max attempts",
+		"id", pending.ID, "attempts", pending.Attempts, "last_error", pending.LastError)
+	c.removeSubmission(ctx, pending)
+}
+
+// completeSubmission removes the pending submission from the queue once its result is
+// known to the caller. Submissions interrupted by the node shutdown are kept, so they can be
+// retried after restart.
+func (c *TxClient) completeSubmission(pending *PendingSubmission) {
+	if c.ctx != nil && c.ctx.Err() != nil {
+		return
+	}
+	c.removeSubmission(context.Background(), pending)
+}
+
+func (c *TxClient) removeSubmission(ctx context.Context, pending *PendingSubmission) {
+	if err := c.queue.remove(ctx, pending.ID); err != nil {
+		log.Errorw("removing pending submission", "id", pending.ID, "err", err)
+	}
+}
@@ -0,0 +1,79 @@
+package txclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+)
+
+func TestSubmissionQueue(t *testing.T) {
+	ctx := context.Background()
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+	author := types.AccAddress("author_address_0000")
+
+	blobs, err := libshare.GenerateV0Blobs([]int{1, 2}, true)
+	require.NoError(t, err)
+
+	queue := newSubmissionQueue(ds)
+	require.NoError(t, queue.start(ctx))
+
+	first, err := queue.add(ctx, blobs, author, NewTxConfig(WithGas(100)))
+	require.NoError(t, err)
+	second, err := queue.add(ctx, blobs[:1], author, NewTxConfig())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), first.ID)
+	assert.Equal(t, uint64(2), second.ID)
+
+	first.TxHash = "hash"
+	first.GasPrice = 0.002
+	require.NoError(t, queue.update(ctx, first))
+
+	pending, err := queue.list(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, first.ID, pending[0].ID)
+	assert.Equal(t, "hash", pending[0].TxHash)
+	assert.Equal(t, 0.002, pending[0].GasPrice)
+	assert.Equal(t, first.Config, pending[0].Config)
+	assert.Equal(t, blobs, pending[0].Blobs)
+	parsedAuthor, err := pending[0].author()
+	require.NoError(t, err)
+	assert.Equal(t, author, parsedAuthor)
+
+	// ensure IDs are not reused after restart
+	require.NoError(t, queue.remove(ctx, second.ID))
+	queue = newSubmissionQueue(ds)
+	require.NoError(t, queue.start(ctx))
+	third, err := queue.add(ctx, blobs, author, NewTxConfig())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), third.ID)
+
+	pending, err = queue.list(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, []uint64{first.ID, third.ID}, []uint64{pending[0].ID, pending[1].ID})
+}
+
+func TestPendingSubmission_BumpedConfig(t *testing.T) {
+	pending := &PendingSubmission{Config: NewTxConfig(WithGas(100), WithMaxGasPrice(0.01))}
+	// never broadcasted submissions keep the original config
+	assert.Equal(t, pending.Config, pending.bumpedConfig())
+
+	pending.GasPrice = 0.002
+	cfg := pending.bumpedConfig()
+	assert.InDelta(t, 0.002*gasPriceBumpFactor, cfg.GasPrice(), 1e-9)
+	assert.True(t, cfg.IsGasPriceSet())
+	assert.Equal(t, uint64(100), cfg.GasLimit())
+	// the original config is left untouched
+	assert.False(t, pending.Config.IsGasPriceSet())
+
+	pending.GasPrice = 0.009
+	assert.Equal(t, 0.01, pending.bumpedConfig().GasPrice())
+}
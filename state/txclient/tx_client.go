@@ -3,10 +3,12 @@ package txclient
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
@@ -19,6 +21,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v9/app"
 	"github.com/celestiaorg/celestia-app/v9/app/encoding"
 	apperrors "github.com/celestiaorg/celestia-app/v9/app/errors"
+	"github.com/celestiaorg/celestia-app/v9/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v9/pkg/user"
 	apptypes "github.com/celestiaorg/celestia-app/v9/x/blob/types"
 	libshare "github.com/celestiaorg/go-square/v4/share"
@@ -39,6 +42,11 @@ type TxClient struct {
 	estimatorServiceTLS  bool
 	estimatorConn        *grpc.ClientConn
 	txWorkerAccounts     int
+	// queue persists pending PFB submissions. Nil if persistence is disabled.
+	queue *submissionQueue
+	// recovered is closed once the submissions left from the previous run are recovered.
+	// Nil if persistence is disabled.
+	recovered chan struct{}
 
 	metrics *metrics
 
@@ -73,12 +81,23 @@ func NewTxClient(
 	return client, nil
 }
 
-func (c *TxClient) Start(context.Context) error {
+func (c *TxClient) Start(ctx context.Context) error {
 	c.ctx, c.cancel = context.WithCancel(context.Background())
 	err := c.setupClient()
 	if err != nil {
 		log.Warnw("failed to setup tx client", "err", err)
 	}
+
+	if c.queue != nil {
+		if err := c.queue.start(ctx); err != nil {
+			return fmt.Errorf("starting submission queue: %w", err)
+		}
+		c.recovered = make(chan struct{})
+		go func() {
+			defer close(c.recovered)
+			c.recoverSubmissions(c.ctx)
+		}()
+	}
 	return nil
 }
 
@@ -136,6 +155,9 @@ func (c *TxClient) SubmitMessage(
 	if err != nil {
 		return nil, err
 	}
+	if err := c.waitRecovery(ctx); err != nil {
+		return nil, err
+	}
 
 	txConfig := make([]user.TxOption, 0)
 	if cfg.FeeGranterAddress() != "" {
@@ -159,11 +181,44 @@ func (c *TxClient) SubmitMessage(
 	return c.client.SubmitTx(ctx, []types.Msg{msg}, txConfig...)
 }
 
+// SubmitPayForBlob submits the blobs and blocks until the transaction is committed.
+// If the submission queue is enabled, the submission is persisted until its result is known,
+// so it can be retried if the node restarts in the meantime. New submissions wait until
+// the submissions left from the previous run are recovered.
 func (c *TxClient) SubmitPayForBlob(
 	ctx context.Context,
 	libBlobs []*libshare.Blob,
 	author types.AccAddress,
 	cfg *TxConfig,
+) (*user.TxResponse, error) {
+	if c.queue == nil {
+		return c.submitPayForBlob(ctx, libBlobs, author, cfg, nil)
+	}
+	// fail fast instead of waiting for the recovery if core is unavailable
+	if err := c.setupClient(); err != nil {
+		return nil, err
+	}
+	if err := c.waitRecovery(ctx); err != nil {
+		return nil, err
+	}
+
+	pending, err := c.queue.add(ctx, libBlobs, author, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("persisting pending submission: %w", err)
+	}
+	defer c.completeSubmission(pending)
+	return c.submitPayForBlob(ctx, libBlobs, author, cfg, pending)
+}
+
+// submitPayForBlob submits the blobs and blocks until the transaction is committed.
+// The given pending submission, if any, is updated with the gas price and hash of the
+// broadcasted transaction.
+func (c *TxClient) submitPayForBlob(
+	ctx context.Context,
+	libBlobs []*libshare.Blob,
+	author types.AccAddress,
+	cfg *TxConfig,
+	pending *PendingSubmission,
 ) (_ *user.TxResponse, err error) {
 	if err = c.setupClient(); err != nil {
 		return nil, err
@@ -259,11 +314,18 @@ func (c *TxClient) SubmitPayForBlob(
 		}
 	}()
 
+	if pending != nil {
+		pending.GasPrice = gasPrice
+		if err := c.queue.update(ctx, pending); err != nil {
+			log.Warnw("updating pending submission", "id", pending.ID, "err", err)
+		}
+	}
+
 	var response *user.TxResponse
 	if c.txWorkerAccounts > 0 && author.Equals(c.defaultSignerAddress) {
-		response, err = c.client.SubmitPayForBlobToQueue(ctx, libBlobs, opts...)
+		response, err = c.submitToQueue(ctx, libBlobs, pending, opts...)
 	} else {
-		response, err = c.client.SubmitPayForBlobWithAccount(ctx, account.Name(), libBlobs, opts...)
+		response, err = c.broadcastAndConfirm(ctx, account.Name(), libBlobs, pending, opts...)
 	}
 
 	if apperrors.IsInsufficientFee(err) {
@@ -276,6 +338,107 @@ func (c *TxClient) SubmitPayForBlob(
 	return response, err
 }
 
+// waitRecovery blocks until the submissions left from the previous run are recovered, so the
+// new submissions don't race with them on the account sequence.
+func (c *TxClient) waitRecovery(ctx context.Context) error {
+	if c.recovered == nil {
+		return nil
+	}
+	select {
+	case <-c.recovered:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for pending submissions to be recovered: %w", ctx.Err())
+	}
+}
+
+// broadcastAndConfirm broadcasts the PFB transaction and waits for it to be committed.
+// The hash of the broadcasted transaction is persisted in the pending submission, if any,
+// so that the submission can be confirmed after restart instead of being resubmitted.
+func (c *TxClient) broadcastAndConfirm(
+	ctx context.Context,
+	accountName string,
+	libBlobs []*libshare.Blob,
+	pending *PendingSubmission,
+	opts ...user.TxOption,
+) (*user.TxResponse, error) {
+	resp, err := c.client.BroadcastPayForBlobWithAccount(ctx, accountName, libBlobs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending != nil {
+		pending.TxHash = resp.TxHash
+		if err := c.queue.update(ctx, pending); err != nil {
+			log.Warnw("updating pending submission", "id", pending.ID, "err", err)
+		}
+	}
+	confirmed, err := c.client.ConfirmTx(ctx, resp.TxHash)
+	if err != nil && c.isEvicted(ctx, resp.TxHash) {
+		return nil, fmt.Errorf("%w: %w", ErrTxEvicted, err)
+	}
+	return confirmed, err
+}
+
+// submitToQueue submits the PFB transaction through the parallel submission queue of the app's
+// client and waits for it to be committed. The queue only reports the hash of the transaction
+// along with its result, so it is persisted in the pending submission, if any, once the result
+// is known: a submission failing to be confirmed is then confirmed after restart instead of being
+// resubmitted, while one interrupted by a restart before its result is known is resubmitted.
+func (c *TxClient) submitToQueue(
+	ctx context.Context,
+	libBlobs []*libshare.Blob,
+	pending *PendingSubmission,
+	opts ...user.TxOption,
+) (*user.TxResponse, error) {
+	resp, err := c.client.SubmitPayForBlobToQueue(ctx, libBlobs, opts...)
+	txHash := submittedTxHash(resp, err)
+	if txHash == "" {
+		return resp, err
+	}
+
+	if pending != nil && err != nil {
+		pending.TxHash = txHash
+		if err := c.queue.update(ctx, pending); err != nil {
+			log.Warnw("updating pending submission", "id", pending.ID, "err", err)
+		}
+	}
+	if err != nil && c.isEvicted(ctx, txHash) {
+		return nil, fmt.Errorf("%w: %w", ErrTxEvicted, err)
+	}
+	return resp, err
+}
+
+// submittedTxHash returns the hash of the transaction the submission resulted in, if any.
+func submittedTxHash(resp *user.TxResponse, err error) string {
+	if resp != nil && resp.TxHash != "" {
+		return resp.TxHash
+	}
+	var broadcastErr *user.BroadcastTxError
+	if errors.As(err, &broadcastErr) {
+		return broadcastErr.TxHash
+	}
+	var execErr *user.ExecutionError
+	if errors.As(err, &execErr) {
+		return execErr.TxHash
+	}
+	return ""
+}
+
+// isEvicted reports whether core reports the transaction of the given hash as evicted
+// from the mempool.
+func (c *TxClient) isEvicted(ctx context.Context, txHash string) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	resp, err := tx.NewTxClient(c.coreConns[0]).TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+	if err != nil {
+		log.Debugw("querying tx status", "hash", txHash, "err", err)
+		return false
+	}
+	return resp.Status == core.TxStatusEvicted
+}
+
 func ParseAccAddressFromString(addrStr string) (types.AccAddress, error) {
 	return types.AccAddressFromBech32(addrStr)
 }
@@ -2,11 +2,14 @@ package txclient
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/celestiaorg/celestia-app/v9/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v9/pkg/user"
 )
 
 // TestSetupEstimatorConnection verifies the connection is created lazily and is
@@ -28,3 +31,25 @@ func TestSetupEstimatorConnection(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, mes.gasPriceToReturn, resp.EstimatedGasPrice)
 }
+
+func TestTxClient_WaitRecovery(t *testing.T) {
+	c := &TxClient{}
+	// persistence is disabled, so there is nothing to wait for
+	require.NoError(t, c.waitRecovery(context.Background()))
+
+	c.recovered = make(chan struct{})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	require.ErrorIs(t, c.waitRecovery(ctx), context.DeadlineExceeded)
+
+	close(c.recovered)
+	require.NoError(t, c.waitRecovery(context.Background()))
+}
+
+func TestSubmittedTxHash(t *testing.T) {
+	require.Equal(t, "committed", submittedTxHash(&user.TxResponse{TxHash: "committed"}, nil))
+	rejected := fmt.Errorf("broadcast: %w", &user.BroadcastTxError{TxHash: "rejected"})
+	require.Equal(t, "rejected", submittedTxHash(nil, rejected))
+	require.Equal(t, "failed", submittedTxHash(nil, &user.ExecutionError{TxHash: "failed"}))
+	require.Empty(t, submittedTxHash(nil, context.DeadlineExceeded))
+}
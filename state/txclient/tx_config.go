@@ -22,6 +22,10 @@ const (
 
 var ErrGasPriceExceedsLimit = errors.New("state: estimated gas price exceeds max gas price in tx config")
 
+// ErrTxEvicted is returned when the transaction was evicted from the mempool and never got
+// committed.
+var ErrTxEvicted = errors.New("state: transaction was evicted from the mempool")
+
 // NewTxConfig constructs a new TxConfig with the provided options.
 // It starts with a DefaultGasPrice and then applies any additional
 // options provided through the variadic parameter.
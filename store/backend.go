@@ -0,0 +1,102 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/celestiaorg/celestia-node/store/file"
+)
+
+// Backend is a storage the Store keeps its files in. Files are addressed by paths built by the
+// Store, which are rooted at the basepath of the Store.
+type Backend interface {
+	file.FS
+	// Mkdir ensures the directory under the given path exists.
+	Mkdir(path string) error
+	// Link makes the file under the link path refer to the file under the target path.
+	// It fails with os.ErrExist if the link already exists.
+	Link(target, link string) error
+	// Stat returns the size of the file under the given path.
+	// It fails with os.ErrNotExist if the file does not exist.
+	Stat(path string) (int64, error)
+	// Rename moves the file from the old path to the new one.
+	Rename(oldpath, newpath string) error
+	// Remove removes the file under the given path. Removing a non-existing file is not an error.
+	Remove(path string) error
+}
+
+// symlinker is implemented by the Backends supporting symbolic links. Symbolic links are used for
+// the files shared by many heights, as the amount of hardlinks to a single file is limited.
+type symlinker interface {
+	// Symlink creates the link referring to the target by its path relative to the link.
+	Symlink(target, link string) error
+}
+
+// LocalBackend is a Backend of the local filesystem.
+type LocalBackend struct{}
+
+var (
+	_ Backend   = LocalBackend{}
+	_ symlinker = LocalBackend{}
+)
+
+func (LocalBackend) Create(path string) (io.WriteCloser, error) {
+	return file.LocalFS.Create(path)
+}
+
+func (LocalBackend) Open(path string) (file.File, error) {
+	return file.LocalFS.Open(path)
+}
+
+func (LocalBackend) Mkdir(path string) error {
+	return mkdir(path)
+}
+
+func (LocalBackend) Link(target, link string) error {
+	return hardLink(target, link)
+}
+
+func (LocalBackend) Symlink(target, link string) error {
+	return symlink(target, link)
+}
+
+func (LocalBackend) Stat(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func (LocalBackend) Rename(oldpath, newpath string) error {
+	return os.Rename(oldpath, newpath)
+}
+
+func (LocalBackend) Remove(path string) error {
+	return remove(path)
+}
+
+// exists reports whether the file under the given path exists in the Backend.
+func exists(backend Backend, path string) (bool, error) {
+	_, err := backend.Stat(path)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, os.ErrNotExist):
+		return false, nil
+	default:
+		return false, fmt.Errorf("checking file existence '%s': %w", path, err)
+	}
+}
+
+// fileSize returns the size of a file, or 0 if it's missing/unstatable.
+// Used purely for the bytes-reclaimed metric — best-effort, not load-bearing.
+func fileSize(backend Backend, path string) int64 {
+	size, err := backend.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return size
+}
@@ -0,0 +1,312 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/celestiaorg/celestia-node/store/file"
+)
+
+// linkTargetKey is the metadata key of the link objects holding the key of the linked object.
+const linkTargetKey = "link-target"
+
+// S3Parameters configures the Backend keeping the files in an S3-compatible object storage.
+type S3Parameters struct {
+	// Endpoint is the URL of the object storage, e.g. https://s3.us-east-1.amazonaws.com.
+	Endpoint string
+	// Region is the region of the bucket.
+	Region string
+	// Bucket is the name of the bucket to keep the files in.
+	Bucket string
+	// Prefix is prepended to the keys of all the objects of the store.
+	Prefix string
+	// AccessKeyID and SecretAccessKey are the credentials of the object storage. If not set,
+	// they are read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle puts the bucket name into the path of the requests instead of the host name.
+	// It is required by most self-hosted S3-compatible storages.
+	UsePathStyle bool
+	// RequestTimeout limits the duration of a single request to the object storage.
+	RequestTimeout time.Duration
+}
+
+// DefaultS3Parameters returns the default configuration values for the S3 backend.
+func DefaultS3Parameters() *S3Parameters {
+	return &S3Parameters{
+		Region:         "us-east-1",
+		RequestTimeout: time.Minute,
+	}
+}
+
+func (p *S3Parameters) Validate() error {
+	if p.Endpoint == "" {
+		return errors.New("s3 endpoint is not set")
+	}
+	if p.Bucket == "" {
+		return errors.New("s3 bucket is not set")
+	}
+	if p.RequestTimeout <= 0 {
+		return errors.New("s3 request timeout must be positive")
+	}
+	return nil
+}
+
+// S3Backend is a Backend keeping the files as objects of an S3-compatible object storage.
+// Files are read with ranged requests, so serving a sample or an axis half does not require
+// downloading the whole file. Objects don't support links, so links are stored as empty objects
+// holding the key of the linked object in their metadata.
+type S3Backend struct {
+	client  *s3.Client
+	bucket  string
+	prefix  string
+	timeout time.Duration
+}
+
+var _ Backend = (*S3Backend)(nil)
+
+// NewS3Backend creates a new S3Backend with the given parameters.
+func NewS3Backend(params *S3Parameters) (*S3Backend, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	accessKey, secretKey := params.AccessKeyID, params.SecretAccessKey
+	if accessKey == "" {
+		accessKey, secretKey = os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	creds := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: accessKey, SecretAccessKey: secretKey}, nil
+	})
+
+	client := s3.New(s3.Options{
+		BaseEndpoint: aws.String(params.Endpoint),
+		Region:       params.Region,
+		Credentials:  creds,
+		UsePathStyle: params.UsePathStyle,
+		// checksums are only sent when required, as not all the S3-compatible storages support them
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	})
+	return &S3Backend{
+		client:  client,
+		bucket:  params.Bucket,
+		prefix:  params.Prefix,
+		timeout: params.RequestTimeout,
+	}, nil
+}
+
+// Create returns the writer uploading the file once closed. The file is kept in memory until then.
+func (b *S3Backend) Create(path string) (io.WriteCloser, error) {
+	key := b.key(path)
+	_, _, err := b.head(key)
+	if err == nil {
+		return nil, &os.PathError{Op: "create", Path: key, Err: os.ErrExist}
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return &s3Writer{backend: b, key: key}, nil
+}
+
+func (b *S3Backend) Open(path string) (file.File, error) {
+	key, size, err := b.resolve(b.key(path))
+	if err != nil {
+		return nil, err
+	}
+	return &s3File{backend: b, key: key, size: size}, nil
+}
+
+// Mkdir does nothing, as there are no directories in object storages.
+func (b *S3Backend) Mkdir(string) error {
+	return nil
+}
+
+func (b *S3Backend) Link(target, link string) error {
+	targetKey, _, err := b.resolve(b.key(target))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	_, err = b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.bucket),
+		Key:           aws.String(b.key(link)),
+		Body:          bytes.NewReader(nil),
+		ContentLength: aws.Int64(0),
+		Metadata:      map[string]string{linkTargetKey: targetKey},
+		IfNoneMatch:   aws.String("*"),
+	})
+	return s3Error("link", b.key(link), err)
+}
+
+func (b *S3Backend) Stat(path string) (int64, error) {
+	_, size, err := b.resolve(b.key(path))
+	return size, err
+}
+
+// Rename copies the object under the new key and removes the old one, as objects can't be moved.
+func (b *S3Backend) Rename(oldpath, newpath string) error {
+	oldKey, newKey := b.key(oldpath), b.key(newpath)
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	_, err := b.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(b.bucket),
+		Key:        aws.String(newKey),
+		CopySource: aws.String(b.bucket + "/" + oldKey),
+	})
+	if err != nil {
+		return s3Error("rename", oldKey, err)
+	}
+	return b.Remove(oldpath)
+}
+
+func (b *S3Backend) Remove(path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(path)),
+	})
+	err = s3Error("remove", b.key(path), err)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// key converts the path of the file built by the Store to the key of the object.
+func (b *S3Backend) key(p string) string {
+	return strings.TrimPrefix(path.Join(b.prefix, filepath.ToSlash(p)), "/")
+}
+
+// resolve follows the link object if the key belongs to one and returns the key and the size of
+// the object holding the data.
+func (b *S3Backend) resolve(key string) (string, int64, error) {
+	size, target, err := b.head(key)
+	if err != nil || target == "" {
+		return key, size, err
+	}
+	size, _, err = b.head(target)
+	return target, size, err
+}
+
+// head returns the size of the object and the target key if the object is a link.
+func (b *S3Backend) head(key string) (int64, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	out, err := b.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, "", s3Error("stat", key, err)
+	}
+	return aws.ToInt64(out.ContentLength), out.Metadata[linkTargetKey], nil
+}
+
+func (b *S3Backend) put(key string, data []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		// ensure we fail if already exist
+		IfNoneMatch: aws.String("*"),
+	})
+	return s3Error("create", key, err)
+}
+
+// s3Error converts the errors of missing and already existing objects into their fs counterparts.
+func s3Error(op, key string, err error) error {
+	var respErr *awshttp.ResponseError
+	if !errors.As(err, &respErr) {
+		return err
+	}
+	switch respErr.HTTPStatusCode() {
+	case http.StatusNotFound:
+		return &os.PathError{Op: op, Path: key, Err: os.ErrNotExist}
+	case http.StatusPreconditionFailed:
+		return &os.PathError{Op: op, Path: key, Err: os.ErrExist}
+	default:
+		return fmt.Errorf("%s %s: %w", op, key, err)
+	}
+}
+
+// s3Writer buffers the written file and uploads it on Close.
+type s3Writer struct {
+	backend *S3Backend
+	key     string
+	buf     bytes.Buffer
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *s3Writer) Close() error {
+	return w.backend.put(w.key, w.buf.Bytes())
+}
+
+// s3File reads the object with ranged requests.
+type s3File struct {
+	backend *S3Backend
+	key     string
+	size    int64
+}
+
+var _ file.RemoteFile = (*s3File)(nil)
+
+func (f *s3File) ReadAt(p []byte, off int64) (int, error) {
+	if off >= f.size {
+		return 0, io.EOF
+	}
+	end := min(off+int64(len(p)), f.size)
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.backend.timeout)
+	defer cancel()
+	out, err := f.backend.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(f.backend.bucket),
+		Key:    aws.String(f.key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, end-1)),
+	})
+	if err != nil {
+		return 0, s3Error("read", f.key, err)
+	}
+	defer out.Body.Close()
+
+	n, err := io.ReadFull(out.Body, p[:end-off])
+	if err != nil {
+		return n, fmt.Errorf("reading %s: %w", f.key, err)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *s3File) Size() (int64, error) {
+	return f.size, nil
+}
+
+func (f *s3File) Close() error {
+	return nil
+}
+
+func (f *s3File) Remote() {}
@@ -0,0 +1,296 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+func TestStoreS3Backend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	objects := newS3StandIn(t)
+	edsStore, err := NewStore(s3Params(objects), t.TempDir())
+	require.NoError(t, err)
+
+	// empty EDS is kept in the bucket as well
+	require.True(t, objects.has("store/blocks/"+share.EmptyEDSDataHash().String()+odsFileExt))
+
+	t.Run("put and get", func(t *testing.T) {
+		square, roots := randomEDS(t)
+		height := uint64(1)
+		err := edsStore.PutODSQ4(ctx, roots, height, square)
+		require.NoError(t, err)
+
+		// put of existing file is not an error
+		err = edsStore.PutODSQ4(ctx, roots, height, square)
+		require.NoError(t, err)
+
+		require.True(t, objects.has(blockKey(roots, odsFileExt)))
+		require.True(t, objects.has(blockKey(roots, q4FileExt)))
+		require.True(t, objects.has("store/blocks/heights/1"+odsFileExt))
+
+		has, err := edsStore.HasByHeight(ctx, height)
+		require.NoError(t, err)
+		require.True(t, has)
+		has, err = edsStore.HasByHash(ctx, roots.Hash())
+		require.NoError(t, err)
+		require.True(t, has)
+
+		acc, err := edsStore.GetByHash(ctx, roots.Hash())
+		require.NoError(t, err)
+		ensureAccessorEqualsEDS(ctx, t, acc, square)
+		require.NoError(t, acc.Close())
+
+		// clear the cache to read through the link
+		require.NoError(t, edsStore.cache.Remove(height))
+		acc, err = edsStore.GetByHeight(ctx, height)
+		require.NoError(t, err)
+		ensureAccessorEqualsEDS(ctx, t, acc, square)
+		require.NoError(t, acc.Close())
+	})
+
+	t.Run("ranged reads", func(t *testing.T) {
+		square := edstest.RandEDS(t, 16)
+		roots, err := share.NewAxisRoots(square)
+		require.NoError(t, err)
+		height := uint64(2)
+		err = edsStore.PutODSQ4(ctx, roots, height, square)
+		require.NoError(t, err)
+
+		acc, err := edsStore.GetByHash(ctx, roots.Hash())
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, acc.Close())
+		})
+
+		// a sample of the first quadrant takes a single ranged read of the row
+		objects.gets.Store(0)
+		smpl, err := acc.Sample(ctx, shwap.SampleCoords{Row: 1, Col: 2})
+		require.NoError(t, err)
+		require.NoError(t, smpl.Verify(roots, 1, 2))
+		require.EqualValues(t, 1, objects.gets.Load())
+		require.Less(t, objects.lastRead.Load(), int64(16*libshare.ShareSize+1))
+
+		// a column of the fourth quadrant takes a single ranged read of the Q4
+		objects.gets.Store(0)
+		half, err := acc.AxisHalf(ctx, rsmt2d.Col, 20)
+		require.NoError(t, err)
+		shares, err := half.Extended()
+		require.NoError(t, err)
+		require.Equal(t, square.Col(20), libshare.ToBytes(shares))
+		require.EqualValues(t, 1, objects.gets.Load())
+	})
+
+	t.Run("empty EDS", func(t *testing.T) {
+		height := uint64(3)
+		err := edsStore.PutODSQ4(ctx, share.EmptyEDSRoots(), height, share.EmptyEDS())
+		require.NoError(t, err)
+
+		acc, err := edsStore.GetByHeight(ctx, height)
+		require.NoError(t, err)
+		hash, err := acc.DataHash(ctx)
+		require.NoError(t, err)
+		require.True(t, hash.IsEmptyEDS())
+		require.NoError(t, acc.Close())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		square, roots := randomEDS(t)
+		height := uint64(4)
+		err := edsStore.PutODSQ4(ctx, roots, height, square)
+		require.NoError(t, err)
+
+		err = edsStore.RemoveODSQ4(ctx, height, roots.Hash())
+		require.NoError(t, err)
+		require.False(t, objects.has(blockKey(roots, odsFileExt)))
+		require.False(t, objects.has(blockKey(roots, q4FileExt)))
+		require.False(t, objects.has("store/blocks/heights/4"+odsFileExt))
+
+		_, err = edsStore.GetByHeight(ctx, height)
+		require.ErrorIs(t, err, ErrNotFound)
+		has, err := edsStore.HasByHash(ctx, roots.Hash())
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("quarantine", func(t *testing.T) {
+		square, roots := randomEDS(t)
+		height := uint64(5)
+		err := edsStore.PutODS(ctx, roots, height, square)
+		require.NoError(t, err)
+
+		err = edsStore.quarantine(height, roots.Hash())
+		require.NoError(t, err)
+		require.False(t, objects.has(blockKey(roots, odsFileExt)))
+		quarantined := "store/" + quarantinePath + "/" + share.DataHash(roots.Hash()).String() + ".5" + odsFileExt
+		require.True(t, objects.has(quarantined))
+	})
+}
+
+func ensureAccessorEqualsEDS(
+	ctx context.Context,
+	t *testing.T,
+	acc eds.Accessor,
+	square *rsmt2d.ExtendedDataSquare,
+) {
+	shares, err := acc.Shares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, square.FlattenedODS(), libshare.ToBytes(shares))
+}
+
+// blockKey returns the key of the object keeping the file of the given square.
+func blockKey(roots *share.AxisRoots, ext string) string {
+	return "store/" + blocksPath + "/" + share.DataHash(roots.Hash()).String() + ext
+}
+
+func s3Params(objects *s3StandIn) *Parameters {
+	params := paramsNoCache()
+	params.RecentBlocksCacheSize = 1
+	params.S3 = DefaultS3Parameters()
+	params.S3.Endpoint = objects.srv.URL
+	params.S3.Bucket = "bucket"
+	params.S3.Prefix = "store"
+	params.S3.AccessKeyID = "access"
+	params.S3.SecretAccessKey = "secret"
+	params.S3.UsePathStyle = true
+	return params
+}
+
+type s3Object struct {
+	data     []byte
+	metadata http.Header
+}
+
+// s3StandIn is an in-memory stand-in of S3-compatible object storage, supporting the subset of
+// the API used by the S3Backend. It serves a single bucket with path style addressing.
+type s3StandIn struct {
+	srv *httptest.Server
+
+	lk      sync.Mutex
+	objects map[string]s3Object
+
+	gets     atomic.Int64
+	lastRead atomic.Int64
+}
+
+func newS3StandIn(t *testing.T) *s3StandIn {
+	s := &s3StandIn{objects: make(map[string]s3Object)}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *s3StandIn) has(key string) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	_, ok := s.objects[key]
+	return ok
+}
+
+func (s *s3StandIn) serve(w http.ResponseWriter, r *http.Request) {
+	// path style: /bucket/key
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(parts) != 2 {
+		http.Error(w, "invalid path", http.StatusBadRequest)
+		return
+	}
+	key := parts[1]
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	obj, ok := s.objects[key]
+
+	switch r.Method {
+	case http.MethodPut:
+		if src := r.Header.Get("X-Amz-Copy-Source"); src != "" {
+			srcParts := strings.SplitN(strings.TrimPrefix(src, "/"), "/", 2)
+			srcObj, ok := s.objects[srcParts[1]]
+			if !ok {
+				writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+				return
+			}
+			s.objects[key] = srcObj
+			_, _ = fmt.Fprint(w, `<CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`)
+			return
+		}
+		if ok && r.Header.Get("If-None-Match") == "*" {
+			writeS3Error(w, http.StatusPreconditionFailed, "PreconditionFailed")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		metadata := make(http.Header)
+		for k, v := range r.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+				metadata[k] = v
+			}
+		}
+		s.objects[key] = s3Object{data: data, metadata: metadata}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead:
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range obj.metadata {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+	case http.MethodGet:
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		s.gets.Add(1)
+		data := obj.data
+		status := http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			var from, to int
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &from, &to); err != nil || to >= len(data) {
+				writeS3Error(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+				return
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, to, len(data)))
+			data = data[from : to+1]
+			status = http.StatusPartialContent
+		}
+		s.lastRead.Store(int64(len(data)))
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
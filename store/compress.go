@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/store/file"
+)
+
+const tmpFileExt = ".tmp"
+
+// CompressFiles converts all the uncompressed ODS files of the store under the given basepath
+// into the compressed format and returns the amount of converted files.
+// It must only be run while the store is not in use. The conversion can be safely interrupted
+// and resumed, as uncompressed files are replaced atomically.
+func CompressFiles(ctx context.Context, basepath string) (int, error) {
+	s := &Store{basepath: basepath}
+
+	entries, err := os.ReadDir(filepath.Join(basepath, blocksPath))
+	if err != nil {
+		return 0, fmt.Errorf("reading blocks directory: %w", err)
+	}
+
+	var converted int
+	emptyFile := share.EmptyEDSDataHash().String() + odsFileExt
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return converted, ctx.Err()
+		}
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, odsFileExt) || name == emptyFile {
+			continue
+		}
+
+		ok, err := compressFile(filepath.Join(basepath, blocksPath, name))
+		if err != nil {
+			return converted, err
+		}
+		if ok {
+			converted++
+			if converted%1000 == 0 {
+				log.Infow("compressing ODS files", "converted", converted)
+			}
+		}
+	}
+
+	if err := s.relinkHeights(ctx); err != nil {
+		return converted, fmt.Errorf("relinking heights: %w", err)
+	}
+	return converted, nil
+}
+
+// compressFile replaces the ODS file under the given path with its compressed version.
+// It reports false if the file is already compressed.
+func compressFile(path string) (bool, error) {
+	ods, err := file.OpenODS(path)
+	if err != nil {
+		return false, fmt.Errorf("opening ODS file %s: %w", path, err)
+	}
+	compressed := ods.Compressed()
+	utils.CloseAndLog(log, "ods", ods)
+	if compressed {
+		return false, nil
+	}
+
+	// cleanup leftovers of the interrupted conversion
+	tmpPath := path + tmpFileExt
+	if err := remove(tmpPath); err != nil {
+		return false, err
+	}
+	if err := file.CompressODS(path, tmpPath); err != nil {
+		return false, errors.Join(
+			fmt.Errorf("compressing ODS file %s: %w", path, err),
+			remove(tmpPath),
+		)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return false, fmt.Errorf("replacing ODS file %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// relinkHeights points the height hardlinks to the current ODS files. Replaced ODS files get
+// new inodes, while the hardlinks keep referencing the old ones.
+func (s *Store) relinkHeights(ctx context.Context) error {
+	entries, err := os.ReadDir(filepath.Join(s.basepath, heightsPath))
+	if err != nil {
+		return fmt.Errorf("reading heights directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		name := entry.Name()
+		// empty EDS is symlinked and is never converted
+		if entry.Type()&os.ModeSymlink != 0 || !strings.HasSuffix(name, odsFileExt) {
+			continue
+		}
+
+		if err := s.relinkHeight(ctx, filepath.Join(s.basepath, heightsPath, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) relinkHeight(ctx context.Context, pathLink string) error {
+	ods, err := file.OpenODS(pathLink)
+	if err != nil {
+		return fmt.Errorf("opening ODS file %s: %w", pathLink, err)
+	}
+	datahash, err := ods.DataHash(ctx)
+	utils.CloseAndLog(log, "ods", ods)
+	if err != nil {
+		return fmt.Errorf("reading datahash: %w", err)
+	}
+
+	pathODS := s.hashToPath(datahash, odsFileExt)
+	linkInfo, err := os.Stat(pathLink)
+	if err != nil {
+		return err
+	}
+	odsInfo, err := os.Stat(pathODS)
+	if err != nil {
+		return err
+	}
+	if os.SameFile(linkInfo, odsInfo) {
+		return nil
+	}
+
+	// replace the link atomically
+	tmpLink := pathLink + tmpFileExt
+	if err := remove(tmpLink); err != nil {
+		return err
+	}
+	if err := hardLink(pathODS, tmpLink); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpLink, pathLink); err != nil {
+		return fmt.Errorf("replacing hardlink %s: %w", pathLink, err)
+	}
+	return nil
+}
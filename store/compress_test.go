@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/store/file"
+)
+
+func TestCompressFiles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	dir := t.TempDir()
+	edsStore, err := NewStore(paramsNoCache(), dir)
+	require.NoError(t, err)
+
+	const heights = 5
+	roots := make([]*share.AxisRoots, heights)
+	for i := range heights {
+		eds, rts := randomEDS(t)
+		roots[i] = rts
+		height := uint64(i + 1)
+		if i%2 == 0 {
+			err = edsStore.PutODSQ4(ctx, rts, height, eds)
+		} else {
+			err = edsStore.PutODS(ctx, rts, height, eds)
+		}
+		require.NoError(t, err)
+	}
+	// empty EDS is only linked and must be skipped
+	err = edsStore.PutODS(ctx, share.EmptyEDSRoots(), heights+1, share.EmptyEDS())
+	require.NoError(t, err)
+
+	expected := make([][]libshare.Share, heights)
+	for i := range heights {
+		acc, err := edsStore.GetByHeight(ctx, uint64(i+1))
+		require.NoError(t, err)
+		expected[i], err = acc.Shares(ctx)
+		require.NoError(t, err)
+		require.NoError(t, acc.Close())
+	}
+	require.NoError(t, edsStore.Stop(ctx))
+
+	converted, err := CompressFiles(ctx, dir)
+	require.NoError(t, err)
+	require.Equal(t, heights, converted)
+
+	edsStore, err = NewStore(paramsNoCache(), dir)
+	require.NoError(t, err)
+	for i := range heights {
+		height := uint64(i + 1)
+		pathLink := edsStore.heightToPath(height, odsFileExt)
+		pathODS := edsStore.hashToPath(roots[i].Hash(), odsFileExt)
+		linkInfo, err := os.Stat(pathLink)
+		require.NoError(t, err)
+		odsInfo, err := os.Stat(pathODS)
+		require.NoError(t, err)
+		require.True(t, os.SameFile(linkInfo, odsInfo))
+
+		ods, err := file.OpenODS(pathLink)
+		require.NoError(t, err)
+		require.True(t, ods.Compressed())
+		require.NoError(t, ods.Close())
+
+		acc, err := edsStore.GetByHeight(ctx, height)
+		require.NoError(t, err)
+		shares, err := acc.Shares(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], shares)
+		require.NoError(t, acc.Close())
+	}
+
+	acc, err := edsStore.GetByHeight(ctx, heights+1)
+	require.NoError(t, err)
+	hash, err := acc.DataHash(ctx)
+	require.NoError(t, err)
+	require.True(t, hash.IsEmptyEDS())
+	require.NoError(t, acc.Close())
+	require.NoError(t, edsStore.Stop(ctx))
+
+	// already compressed files are skipped
+	converted, err = CompressFiles(ctx, dir)
+	require.NoError(t, err)
+	require.Zero(t, converted)
+}
+
+func TestStoreCompressFiles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	params := paramsNoCache()
+	params.CompressFiles = true
+	edsStore, err := NewStore(params, t.TempDir())
+	require.NoError(t, err)
+
+	eds, roots := randomEDS(t)
+	err = edsStore.PutODSQ4(ctx, roots, 1, eds)
+	require.NoError(t, err)
+
+	ods, err := file.OpenODS(edsStore.heightToPath(1, odsFileExt))
+	require.NoError(t, err)
+	require.True(t, ods.Compressed())
+	require.NoError(t, ods.Close())
+
+	// putting the same square again validates the compressed file
+	err = edsStore.PutODSQ4(ctx, roots, 1, eds)
+	require.NoError(t, err)
+	acc, err := edsStore.GetByHeight(ctx, 1)
+	require.NoError(t, err)
+	shares, err := acc.Shares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eds.FlattenedODS(), libshare.ToBytes(shares))
+	require.NoError(t, acc.Close())
+}
@@ -12,3 +12,26 @@ const (
 	writeBufferSize = 64 << 10
 	filePermissions = 0o600
 )
+
+// Option configures the creation and the opening of the files.
+type Option func(*options)
+
+type options struct {
+	fs       FS
+	compress bool
+}
+
+func newOptions(opts ...Option) *options {
+	params := &options{fs: LocalFS}
+	for _, opt := range opts {
+		opt(params)
+	}
+	return params
+}
+
+// WithFS sets the FS the files are written to and read from. LocalFS is used by default.
+func WithFS(fs FS) Option {
+	return func(p *options) {
+		p.fs = fs
+	}
+}
@@ -0,0 +1,82 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// FS is a storage the files are written to and read from.
+type FS interface {
+	// Create creates a new file under the given path for writing.
+	// It fails with os.ErrExist if the file already exists.
+	Create(path string) (io.WriteCloser, error)
+	// Open opens an existing file under the given path for random access reads.
+	// It fails with os.ErrNotExist if the file does not exist.
+	Open(path string) (File, error)
+}
+
+// File is a file opened for random access reads.
+type File interface {
+	io.ReaderAt
+	io.Closer
+	// Size returns the size of the file in bytes.
+	Size() (int64, error)
+}
+
+// RemoteFile is implemented by the Files stored remotely, where every read is a separate request.
+// Parts of such files that would otherwise be read by many small reads, like columns, are read
+// with a single ranged read instead.
+type RemoteFile interface {
+	File
+	// Remote marks the File as remote.
+	Remote()
+}
+
+// LocalFS is the FS of the local filesystem.
+var LocalFS FS = localFS{}
+
+type localFS struct{}
+
+func (localFS) Create(path string) (io.WriteCloser, error) {
+	mod := os.O_RDWR | os.O_CREATE | os.O_EXCL // ensure we fail if already exist
+	return os.OpenFile(path, mod, filePermissions)
+}
+
+func (localFS) Open(path string) (File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return localFile{f}, nil
+}
+
+type localFile struct {
+	*os.File
+}
+
+func (f localFile) Size() (int64, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func isRemote(r io.ReaderAt) bool {
+	_, ok := r.(RemoteFile)
+	return ok
+}
+
+// readRange reads the given range of the file in a single read. Shorter ranges are returned if
+// the file ends before the end of the range.
+func readRange(r io.ReaderAt, offset, length int) (*bytes.Reader, error) {
+	data := make([]byte, length)
+	n, err := r.ReadAt(data, int64(offset))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading range: %w", err)
+	}
+	return bytes.NewReader(data[:n]), nil
+}
@@ -0,0 +1,102 @@
+package file
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+)
+
+func TestRemoteODSFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	ODSSize := 16
+	eds.TestSuiteAccessor(ctx, t, func(t testing.TB, square *rsmt2d.ExtendedDataSquare) eds.Accessor {
+		return createRemoteODSQ4File(t, square, &remoteFS{})
+	}, ODSSize)
+	eds.TestStreamer(ctx, t, func(t testing.TB, square *rsmt2d.ExtendedDataSquare) eds.AccessorStreamer {
+		return createRemoteODSQ4File(t, square, &remoteFS{})
+	}, ODSSize)
+}
+
+func TestRemoteODSFileReads(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDS(t, 16)
+	fs := &remoteFS{}
+	f := createRemoteODSQ4File(t, square, fs)
+	// opening reads the header
+	require.EqualValues(t, 1, fs.reads.Load())
+
+	for _, idx := range []int{0, 16} {
+		fs.reads.Store(0)
+		half, err := f.AxisHalf(ctx, rsmt2d.Col, idx)
+		require.NoError(t, err)
+		shares, err := half.Extended()
+		require.NoError(t, err)
+		require.Equal(t, square.Col(uint(idx)), libshare.ToBytes(shares))
+		// the column is read out of the whole quadrant at once
+		require.EqualValues(t, 1, fs.reads.Load())
+	}
+
+	disableCache := createRemoteODSQ4File(t, square, fs)
+	disableCache.ods.disableCache = true
+	fs.reads.Store(0)
+	shares, err := disableCache.Shares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, square.FlattenedODS(), libshare.ToBytes(shares))
+	require.EqualValues(t, 1, fs.reads.Load())
+}
+
+func createRemoteODSQ4File(t testing.TB, square *rsmt2d.ExtendedDataSquare, fs *remoteFS) *ODSQ4 {
+	dir := t.TempDir()
+	pathODS, pathQ4 := dir+"/ods", dir+"/q4"
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	require.NoError(t, CreateODSQ4(pathODS, pathQ4, roots, square, WithFS(fs)))
+
+	ods, err := OpenODS(pathODS, WithFS(fs))
+	require.NoError(t, err)
+	return ODSWithQ4(ods, pathQ4)
+}
+
+// remoteFS is the local FS, which marks the files as remote and counts the reads.
+type remoteFS struct {
+	reads atomic.Int64
+}
+
+func (fs *remoteFS) Create(path string) (io.WriteCloser, error) {
+	return LocalFS.Create(path)
+}
+
+func (fs *remoteFS) Open(path string) (File, error) {
+	f, err := LocalFS.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteFile{File: f, reads: &fs.reads}, nil
+}
+
+type remoteFile struct {
+	File
+	reads *atomic.Int64
+}
+
+func (f *remoteFile) ReadAt(p []byte, off int64) (int, error) {
+	f.reads.Add(1)
+	return f.File.ReadAt(p, off)
+}
+
+func (f *remoteFile) Remote() {}
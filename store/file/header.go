@@ -30,6 +30,9 @@ type fileVersion uint8
 
 const (
 	fileV0 fileVersion = iota + 1
+	// fileV1 stores the ODS split into square tiles of up to 8x8 shares, laid out in row-major
+	// order and compressed independently from each other, preceded by the table of their offsets.
+	fileV1
 )
 
 func readHeader(r io.Reader) (*headerV0, error) {
@@ -0,0 +1,190 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrNoNamespaceIndex is returned when the namespace index is requested,
+// but it was not written for the file.
+var ErrNoNamespaceIndex = errors.New("namespace index is not available")
+
+type namespaceIndexVersion uint8
+
+const namespaceIndexV0 namespaceIndexVersion = iota + 1
+
+// namespaceIndexEntrySize is the size of a single namespace index entry in bytes:
+// namespace followed by the start and the end share indexes.
+const namespaceIndexEntrySize = libshare.NamespaceSize + 4 + 4
+
+// namespaceRange is the range of shares in the ODS occupied by a single namespace.
+// Shares are addressed by their row-major index in the ODS.
+type namespaceRange struct {
+	namespace libshare.Namespace
+	// from is the index of the first share of the namespace.
+	from int
+	// to is the index following the last share of the namespace.
+	to int
+}
+
+// namespaceIndex is the sidecar index of the ODS file that maps namespaces to the ranges of
+// shares they occupy. Shares in the ODS are ordered by namespace, so every namespace occupies
+// a single contiguous range. It allows reading the shares of a namespace without going through
+// the row roots and whole rows of the square.
+type namespaceIndex struct {
+	ranges []namespaceRange
+}
+
+// CreateNamespaceIndex creates a new namespace index file under the given FS path out of the ODS
+// of the given EDS.
+// It may leave partially written file if any of the writes fail.
+func CreateNamespaceIndex(path string, eds *rsmt2d.ExtendedDataSquare, opts ...Option) error {
+	idx, err := newNamespaceIndex(eds)
+	if err != nil {
+		return fmt.Errorf("building namespace index: %w", err)
+	}
+
+	f, err := newOptions(opts...).fs.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating namespace index file: %w", err)
+	}
+
+	buf := bufio.NewWriter(f)
+	_, err = idx.WriteTo(buf)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if errClose := f.Close(); errClose != nil {
+		err = errors.Join(err, fmt.Errorf("closing created namespace index file: %w", errClose))
+	}
+	return err
+}
+
+// openNamespaceIndex reads the namespace index file under the given FS path.
+func openNamespaceIndex(fs FS, path string) (*namespaceIndex, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	size, err := f.Size()
+	if err != nil {
+		return nil, fmt.Errorf("getting namespace index size: %w", err)
+	}
+	// the index is small, so it is read at once
+	r, err := readRange(f, 0, int(size))
+	if err != nil {
+		return nil, err
+	}
+	idx := &namespaceIndex{}
+	if _, err := idx.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("reading namespace index: %w", err)
+	}
+	return idx, nil
+}
+
+// newNamespaceIndex builds the namespace index out of the ODS of the given EDS.
+// Tail padding shares are not stored in the ODS file and thus are not indexed.
+func newNamespaceIndex(eds *rsmt2d.ExtendedDataSquare) (*namespaceIndex, error) {
+	odsWidth := int(eds.Width() / 2)
+	idx := &namespaceIndex{}
+	for i := range odsWidth * odsWidth {
+		rawShr := eds.GetCell(uint(i/odsWidth), uint(i%odsWidth))
+		ns, err := libshare.NewNamespaceFromBytes(rawShr[:libshare.NamespaceSize])
+		if err != nil {
+			return nil, fmt.Errorf("creating namespace at %d: %w", i, err)
+		}
+		if ns.Equals(libshare.TailPaddingNamespace) {
+			break
+		}
+
+		last := len(idx.ranges) - 1
+		if last >= 0 && idx.ranges[last].namespace.Equals(ns) {
+			idx.ranges[last].to = i + 1
+			continue
+		}
+		if last >= 0 && ns.IsLessThan(idx.ranges[last].namespace) {
+			return nil, fmt.Errorf("shares are not ordered by namespace at %d", i)
+		}
+		idx.ranges = append(idx.ranges, namespaceRange{namespace: ns, from: i, to: i + 1})
+	}
+	return idx, nil
+}
+
+// lookup returns the range of shares occupied by the given namespace.
+func (idx *namespaceIndex) lookup(namespace libshare.Namespace) (namespaceRange, bool) {
+	i := sort.Search(len(idx.ranges), func(i int) bool {
+		return !idx.ranges[i].namespace.IsLessThan(namespace)
+	})
+	if i < len(idx.ranges) && idx.ranges[i].namespace.Equals(namespace) {
+		return idx.ranges[i], true
+	}
+	return namespaceRange{}, false
+}
+
+// lookupShare returns the range of shares that contains the share with the given index.
+func (idx *namespaceIndex) lookupShare(shrIdx int) (namespaceRange, bool) {
+	i := sort.Search(len(idx.ranges), func(i int) bool {
+		return idx.ranges[i].to > shrIdx
+	})
+	if i < len(idx.ranges) && idx.ranges[i].from <= shrIdx {
+		return idx.ranges[i], true
+	}
+	return namespaceRange{}, false
+}
+
+// WriteTo writes the namespace index to the writer.
+func (idx *namespaceIndex) WriteTo(w io.Writer) (int64, error) {
+	buf := make([]byte, 1+4, 1+4+len(idx.ranges)*namespaceIndexEntrySize)
+	buf[0] = byte(namespaceIndexV0)
+	binary.LittleEndian.PutUint32(buf[1:], uint32(len(idx.ranges)))
+	for _, rng := range idx.ranges {
+		buf = append(buf, rng.namespace.Bytes()...)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(rng.from))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(rng.to))
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom reads the namespace index from the reader.
+func (idx *namespaceIndex) ReadFrom(r io.Reader) (int64, error) {
+	hdr := make([]byte, 1+4)
+	n, err := io.ReadFull(r, hdr)
+	if err != nil {
+		return int64(n), err
+	}
+	if version := namespaceIndexVersion(hdr[0]); version != namespaceIndexV0 {
+		return int64(n), fmt.Errorf("unsupported namespace index version: %d", version)
+	}
+
+	amount := int(binary.LittleEndian.Uint32(hdr[1:]))
+	entries := make([]byte, amount*namespaceIndexEntrySize)
+	m, err := io.ReadFull(r, entries)
+	if err != nil {
+		return int64(n + m), err
+	}
+
+	idx.ranges = make([]namespaceRange, amount)
+	for i := range idx.ranges {
+		entry := entries[i*namespaceIndexEntrySize : (i+1)*namespaceIndexEntrySize]
+		ns, err := libshare.NewNamespaceFromBytes(entry[:libshare.NamespaceSize])
+		if err != nil {
+			return int64(n + m), fmt.Errorf("reading namespace of entry %d: %w", i, err)
+		}
+		idx.ranges[i] = namespaceRange{
+			namespace: ns,
+			from:      int(binary.LittleEndian.Uint32(entry[libshare.NamespaceSize:])),
+			to:        int(binary.LittleEndian.Uint32(entry[libshare.NamespaceSize+4:])),
+		}
+	}
+	return int64(n + m), nil
+}
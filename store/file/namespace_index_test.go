@@ -0,0 +1,156 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+func TestNamespaceIndex(t *testing.T) {
+	ns := libshare.RandomNamespace()
+	square, _ := edstest.RandEDSWithNamespace(t, ns, 12, 8)
+
+	idx, err := newNamespaceIndex(square)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	_, err = idx.WriteTo(buf)
+	require.NoError(t, err)
+	readIdx := &namespaceIndex{}
+	_, err = readIdx.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Equal(t, idx, readIdx)
+
+	rng, ok := idx.lookup(ns)
+	require.True(t, ok)
+	require.Equal(t, 12, rng.to-rng.from)
+
+	shares := square.FlattenedODS()
+	for i := rng.from; i < rng.to; i++ {
+		require.Equal(t, ns.Bytes(), shares[i][:libshare.NamespaceSize])
+	}
+
+	shrRng, ok := idx.lookupShare(rng.from + 5)
+	require.True(t, ok)
+	require.Equal(t, rng, shrRng)
+
+	_, ok = idx.lookup(libshare.RandomNamespace())
+	require.False(t, ok)
+}
+
+func TestNamespaceIndex_TailPadding(t *testing.T) {
+	square := edstest.RandEDSWithTailPadding(t, 8, 11)
+
+	idx, err := newNamespaceIndex(square)
+	require.NoError(t, err)
+	require.Equal(t, 8*8-11, idx.ranges[len(idx.ranges)-1].to)
+
+	_, ok := idx.lookup(libshare.TailPaddingNamespace)
+	require.False(t, ok)
+	_, ok = idx.lookupShare(8*8 - 11)
+	require.False(t, ok)
+}
+
+func TestODSNamespaceShares(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	ns := libshare.RandomNamespace()
+	square, roots := edstest.RandEDSWithNamespace(t, ns, 20, 8)
+
+	f := createODSFile(t, square)
+	_, _, err := f.NamespaceShares(ctx, ns)
+	require.ErrorIs(t, err, ErrNoNamespaceIndex)
+
+	f = createODSFileWithIndex(t, square)
+	from, shares, err := f.NamespaceShares(ctx, ns)
+	require.NoError(t, err)
+	require.Len(t, shares, 20)
+	require.Equal(t, square.FlattenedODS()[from:from+20], libshare.ToBytes(shares))
+
+	// shares read from the cached square must be the same
+	_, err = f.readODS()
+	require.NoError(t, err)
+	cachedFrom, cachedShares, err := f.NamespaceShares(ctx, ns)
+	require.NoError(t, err)
+	require.Equal(t, from, cachedFrom)
+	require.Equal(t, shares, cachedShares)
+
+	// namespace outside the row range is rejected without reading the row
+	odsWidth := len(roots.RowRoots) / 2
+	lastRow := (from + 19) / odsWidth
+	if lastRow+1 < odsWidth {
+		_, err = f.RowNamespaceData(ctx, ns, lastRow+1)
+		require.ErrorIs(t, err, shwap.ErrNamespaceOutsideRange)
+	}
+
+	from, shares, err = f.NamespaceShares(ctx, libshare.RandomNamespace())
+	require.NoError(t, err)
+	require.Zero(t, from)
+	require.Empty(t, shares)
+}
+
+func TestODSFileWithNamespaceIndex(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	ODSSize := 16
+	eds.TestSuiteAccessor(ctx, t, func(t testing.TB, square *rsmt2d.ExtendedDataSquare) eds.Accessor {
+		return createODSFileWithIndex(t, square)
+	}, ODSSize)
+}
+
+func createODSFileWithIndex(t testing.TB, square *rsmt2d.ExtendedDataSquare) *ODS {
+	path := t.TempDir() + "/ods"
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	require.NoError(t, CreateODS(path, roots, square))
+	require.NoError(t, CreateNamespaceIndex(path+".nsi", square))
+
+	ods, err := OpenODS(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ods.Close()
+	})
+	return ods.UseNamespaceIndex(path + ".nsi")
+}
+
+func TestODSFile_NamespaceIndexWrittenLater(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDS(t, 8)
+	path := t.TempDir() + "/ods"
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	require.NoError(t, CreateODS(path, roots, square))
+
+	ods, err := OpenODS(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ods.Close()
+	})
+	ods.UseNamespaceIndex(path + ".nsi")
+
+	ns, err := libshare.NewNamespaceFromBytes(square.GetCell(0, 0)[:libshare.NamespaceSize])
+	require.NoError(t, err)
+	_, _, err = ods.NamespaceShares(ctx, ns)
+	require.ErrorIs(t, err, ErrNoNamespaceIndex)
+
+	// the index written after the failed read is picked up
+	require.NoError(t, CreateNamespaceIndex(path+".nsi", square))
+	_, shares, err := ods.NamespaceShares(ctx, ns)
+	require.NoError(t, err)
+	require.NotEmpty(t, shares)
+}
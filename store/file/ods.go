@@ -24,7 +24,9 @@ var _ eds.AccessorStreamer = (*ODS)(nil)
 // and it's metadata in file's header.
 type ODS struct {
 	hdr *headerV0
-	fl  *os.File
+	fl  File
+	// fs is the FS the file and its sidecar files are read from
+	fs FS
 
 	lock sync.RWMutex
 	// ods stores an in-memory cache of the original data square to enhance read performance. This
@@ -39,6 +41,12 @@ type ODS struct {
 	// Used for testing and benchmarking purposes, this flag allows for the evaluation of the
 	// performance.
 	disableCache bool
+
+	// pathIndex is the path to the sidecar namespace index of the file. The index is optional
+	// and is loaded lazily on the first namespace read it is available for.
+	pathIndex string
+	indexMu   sync.Mutex
+	index     *namespaceIndex
 }
 
 // CreateODS creates a new file under given FS path and
@@ -48,9 +56,10 @@ func CreateODS(
 	path string,
 	roots *share.AxisRoots,
 	eds *rsmt2d.ExtendedDataSquare,
+	opts ...Option,
 ) error {
-	mod := os.O_RDWR | os.O_CREATE | os.O_EXCL // ensure we fail if already exist
-	f, err := os.OpenFile(path, mod, filePermissions)
+	params := newOptions(opts...)
+	f, err := params.fs.Create(path)
 	if err != nil {
 		return fmt.Errorf("creating ODS file: %w", err)
 	}
@@ -62,6 +71,9 @@ func CreateODS(
 		squareSize:  uint16(eds.Width()),
 		datahash:    roots.Hash(),
 	}
+	if params.compress {
+		hdr.fileVersion = fileV1
+	}
 
 	err = writeODSFile(f, roots, eds, hdr)
 	if errClose := f.Close(); errClose != nil {
@@ -72,7 +84,7 @@ func CreateODS(
 }
 
 // writeQ4File full ODS content into OS File.
-func writeODSFile(f *os.File, axisRoots *share.AxisRoots, eds *rsmt2d.ExtendedDataSquare, hdr *headerV0) error {
+func writeODSFile(f io.Writer, axisRoots *share.AxisRoots, eds *rsmt2d.ExtendedDataSquare, hdr *headerV0) error {
 	// buffering gives us ~4x speed up
 	buf := bufio.NewWriterSize(f, writeBufferSize)
 
@@ -84,7 +96,18 @@ func writeODSFile(f *os.File, axisRoots *share.AxisRoots, eds *rsmt2d.ExtendedDa
 		return fmt.Errorf("writing axis roots: %w", err)
 	}
 
-	if err := writeODS(buf, eds); err != nil {
+	var err error
+	switch hdr.fileVersion {
+	case fileV1:
+		var tiles [][]byte
+		tiles, err = compressTiles(int(eds.Width()/2), hdr.ShareSize(), edsRowFn(eds))
+		if err == nil {
+			err = writeCompressedODS(buf, tiles)
+		}
+	default:
+		err = writeODS(buf, eds)
+	}
+	if err != nil {
 		return fmt.Errorf("writing ODS: %w", err)
 	}
 
@@ -137,25 +160,37 @@ func writeAxisRoots(w io.Writer, roots *share.AxisRoots) error {
 }
 
 // ValidateODSSize checks if the file under given FS path has the expected size.
-func ValidateODSSize(path string, eds *rsmt2d.ExtendedDataSquare) error {
-	ods, err := OpenODS(path)
+func ValidateODSSize(path string, eds *rsmt2d.ExtendedDataSquare, opts ...Option) error {
+	ods, err := OpenODS(path, opts...)
 	if err != nil {
 		return fmt.Errorf("opening file: %w", err)
 	}
 
-	shares, err := filledSharesAmount(eds)
-	if err != nil {
-		return fmt.Errorf("calculating shares amount: %w", err)
+	defer ods.Close()
+
+	var expectedSize int
+	if ods.Compressed() {
+		// compression is deterministic, so the size of the compressed square is known beforehand
+		compressed, err := compressedODSSize(eds)
+		if err != nil {
+			return fmt.Errorf("calculating compressed size: %w", err)
+		}
+		expectedSize = ods.hdr.OffsetWithRoots() + compressed
+	} else {
+		shares, err := filledSharesAmount(eds)
+		if err != nil {
+			return fmt.Errorf("calculating shares amount: %w", err)
+		}
+		shareSize := len(eds.GetCell(0, 0))
+		expectedSize = ods.hdr.OffsetWithRoots() + shares*shareSize
 	}
-	shareSize := len(eds.GetCell(0, 0))
-	expectedSize := ods.hdr.OffsetWithRoots() + shares*shareSize
 
-	info, err := ods.fl.Stat()
+	size, err := ods.fl.Size()
 	if err != nil {
-		return fmt.Errorf("getting file info: %w", err)
+		return fmt.Errorf("getting file size: %w", err)
 	}
-	if info.Size() != int64(expectedSize) {
-		return fmt.Errorf("file size mismatch: expected %d, got %d", expectedSize, info.Size())
+	if size != int64(expectedSize) {
+		return fmt.Errorf("file size mismatch: expected %d, got %d", expectedSize, size)
 	}
 	return nil
 }
@@ -165,23 +200,43 @@ func ValidateODSSize(path string, eds *rsmt2d.ExtendedDataSquare) error {
 // of the File is read lazily.
 // If file is empty, the ErrEmptyFile is returned.
 // File must be closed after usage.
-func OpenODS(path string) (*ODS, error) {
-	f, err := os.Open(path)
+func OpenODS(path string, opts ...Option) (*ODS, error) {
+	params := newOptions(opts...)
+	f, err := params.fs.Open(path)
 	if err != nil {
 		return nil, err
 	}
 
-	h, err := readHeader(f)
+	// read the whole header at once, as reads of remote files are expensive
+	r, err := readRange(f, 0, headerVOSize+1)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	h, err := readHeader(r)
 	if err != nil {
+		_ = f.Close()
 		return nil, err
 	}
+	if h.fileVersion != fileV0 && h.fileVersion != fileV1 {
+		_ = f.Close()
+		return nil, fmt.Errorf("unsupported file version: %d", h.fileVersion)
+	}
 
 	return &ODS{
 		hdr: h,
 		fl:  f,
+		fs:  params.fs,
 	}, nil
 }
 
+// UseNamespaceIndex sets the path to the sidecar namespace index of the file.
+// The index is opened lazily and reads fall back to scanning rows if it does not exist.
+func (o *ODS) UseNamespaceIndex(pathIndex string) *ODS {
+	o.pathIndex = pathIndex
+	return o
+}
+
 // Size returns EDS size stored in file's header.
 func (o *ODS) Size(context.Context) (int, error) {
 	return o.size(), nil
@@ -285,11 +340,22 @@ func (o *ODS) AxisHalf(_ context.Context, axisType rsmt2d.Axis, axisIdx int) (sh
 }
 
 // RowNamespaceData returns data for the given namespace and row index.
+// The namespace index, once available, only saves reading the rows that don't contain the
+// namespace. The rows that do are still read whole: the namespace proof is built out of every
+// share of the row, as the file keeps no inner nodes of the row's tree to prove with otherwise.
 func (o *ODS) RowNamespaceData(
 	ctx context.Context,
 	namespace libshare.Namespace,
 	rowIdx int,
 ) (shwap.RowNamespaceData, error) {
+	outside, err := o.isOutsideRow(namespace, rowIdx)
+	if err != nil {
+		return shwap.RowNamespaceData{}, err
+	}
+	if outside {
+		return shwap.RowNamespaceData{}, shwap.ErrNamespaceOutsideRange
+	}
+
 	shares, err := o.axis(ctx, rsmt2d.Row, rowIdx)
 	if err != nil {
 		return shwap.RowNamespaceData{}, err
@@ -316,6 +382,15 @@ func (o *ODS) Reader() (io.Reader, error) {
 		return ods.reader()
 	}
 
+	// compressed files can't be streamed as is, while streaming remote ones would take many reads
+	if o.Compressed() || isRemote(o.fl) {
+		ods, err := o.readODS()
+		if err != nil {
+			return nil, err
+		}
+		return ods.reader()
+	}
+
 	offset := o.hdr.OffsetWithRoots()
 	total := int64(o.hdr.shareSize) * int64(o.size()*o.size()/4)
 	reader := io.NewSectionReader(o.fl, int64(offset), total)
@@ -341,6 +416,14 @@ func (o *ODS) RangeNamespaceData(
 		return shwap.RangeNamespaceData{}, err
 	}
 
+	if idx := o.tryLoadIndex(); idx != nil {
+		// fail fast without reading the shares if the range spans multiple namespaces
+		rng, ok := idx.lookupShare(from)
+		if !ok || to > rng.to {
+			return shwap.RangeNamespaceData{}, fmt.Errorf("range [%d, %d) spans multiple namespaces", from, to)
+		}
+	}
+
 	shares := make([][]libshare.Share, toCoords.Row-fromCoords.Row+1)
 	for row, idx := fromCoords.Row, 0; row <= toCoords.Row; row++ {
 		half, err := o.readAxisHalf(rsmt2d.Row, row)
@@ -358,6 +441,108 @@ func (o *ODS) RangeNamespaceData(
 	return shwap.RangeNamespaceDataFromShares(shares, fromCoords, toCoords)
 }
 
+// NamespaceShares returns the shares of the given namespace along with the index of the first
+// share in the ODS. The shares are read in a single IO operation using the namespace index.
+// No shares are returned if the namespace is not present in the square.
+// ErrNoNamespaceIndex is returned if the namespace index was not written for the file.
+func (o *ODS) NamespaceShares(
+	_ context.Context,
+	namespace libshare.Namespace,
+) (int, []libshare.Share, error) {
+	idx := o.tryLoadIndex()
+	if idx == nil {
+		return 0, nil, ErrNoNamespaceIndex
+	}
+	rng, ok := idx.lookup(namespace)
+	if !ok {
+		return 0, nil, nil
+	}
+
+	o.lock.RLock()
+	ods := o.ods
+	o.lock.RUnlock()
+	if ods != nil || o.Compressed() {
+		// read only the rows the namespace spans
+		odsWidth := o.size() / 2
+		shares := make([]libshare.Share, 0, rng.to-rng.from)
+		for row := rng.from / odsWidth; row <= (rng.to-1)/odsWidth; row++ {
+			half, err := o.readAxisHalf(rsmt2d.Row, row)
+			if err != nil {
+				return 0, nil, err
+			}
+			from := max(rng.from-row*odsWidth, 0)
+			to := min(rng.to-row*odsWidth, odsWidth)
+			shares = append(shares, half.Shares[from:to]...)
+		}
+		return rng.from, shares, nil
+	}
+
+	shareSize := o.hdr.ShareSize()
+	data := make([]byte, (rng.to-rng.from)*shareSize)
+	offset := o.hdr.OffsetWithRoots() + rng.from*shareSize
+	if _, err := o.fl.ReadAt(data, int64(offset)); err != nil {
+		return 0, nil, fmt.Errorf("reading namespace shares: %w", err)
+	}
+
+	shares := make([]libshare.Share, rng.to-rng.from)
+	for i := range shares {
+		sh, err := libshare.NewShare(data[i*shareSize : (i+1)*shareSize])
+		if err != nil {
+			return 0, nil, err
+		}
+		shares[i] = sh
+	}
+	return rng.from, shares, nil
+}
+
+// isOutsideRow reports whether the namespace is outside the namespace range of the given row.
+// It is only able to answer using the namespace index and a single row root read, so false is
+// returned if the index is not available or the namespace is present in the row.
+func (o *ODS) isOutsideRow(namespace libshare.Namespace, rowIdx int) (bool, error) {
+	idx := o.tryLoadIndex()
+	if idx == nil || rowIdx >= o.size()/2 {
+		return false, nil
+	}
+
+	odsWidth := o.size() / 2
+	if rng, ok := idx.lookup(namespace); ok && rng.from < (rowIdx+1)*odsWidth && rng.to > rowIdx*odsWidth {
+		return false, nil
+	}
+
+	root := make([]byte, share.AxisRootSize)
+	offset := o.hdr.Size() + rowIdx*share.AxisRootSize
+	if _, err := o.fl.ReadAt(root, int64(offset)); err != nil {
+		return false, fmt.Errorf("reading row root: %w", err)
+	}
+	return share.IsOutsideRange(namespace, root, root)
+}
+
+// tryLoadIndex opens the namespace index once it is available. It returns nil if the index
+// is not available. Failed attempts are not cached, so the index is picked up by the next read
+// once it is written.
+func (o *ODS) tryLoadIndex() *namespaceIndex {
+	if o.pathIndex == "" {
+		return nil
+	}
+
+	o.indexMu.Lock()
+	defer o.indexMu.Unlock()
+	if o.index != nil {
+		return o.index
+	}
+
+	idx, err := openNamespaceIndex(o.fs, o.pathIndex)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		log.Errorf("opening namespace index %s: %s", o.pathIndex, err)
+		return nil
+	}
+	o.index = idx
+	return idx
+}
+
 func (o *ODS) axis(ctx context.Context, axisType rsmt2d.Axis, axisIdx int) ([]libshare.Share, error) {
 	half, err := o.AxisHalf(ctx, axisType, axisIdx)
 	if err != nil {
@@ -380,6 +565,10 @@ func (o *ODS) readAxisHalf(axisType rsmt2d.Axis, axisIdx int) (shwap.AxisHalf, e
 		return o.ods.axisHalf(axisType, axisIdx)
 	}
 
+	if o.Compressed() {
+		return o.readCompressedAxisHalf(axisType, axisIdx)
+	}
+
 	axisHalf, err := readAxisHalf(o.fl, axisType, axisIdx, o.hdr, o.hdr.OffsetWithRoots())
 	if err != nil {
 		return shwap.AxisHalf{}, fmt.Errorf("reading axis half: %w", err)
@@ -391,6 +580,30 @@ func (o *ODS) readAxisHalf(axisType rsmt2d.Axis, axisIdx int) (shwap.AxisHalf, e
 	}, nil
 }
 
+// readCompressedAxisHalf reads the axis half from the compressed file. Only the tiles of
+// the axis are decompressed.
+func (o *ODS) readCompressedAxisHalf(axisType rsmt2d.Axis, axisIdx int) (shwap.AxisHalf, error) {
+	var (
+		shares []libshare.Share
+		err    error
+	)
+	switch axisType {
+	case rsmt2d.Col:
+		shares, err = readCompressedColHalf(o.fl, axisIdx, o.hdr)
+	case rsmt2d.Row:
+		shares, err = readCompressedRowHalf(o.fl, axisIdx, o.hdr)
+	default:
+		return shwap.AxisHalf{}, fmt.Errorf("unknown axis")
+	}
+	if err != nil {
+		return shwap.AxisHalf{}, fmt.Errorf("reading compressed axis half: %w", err)
+	}
+	return shwap.AxisHalf{
+		Shares:   shares,
+		IsParity: false,
+	}, nil
+}
+
 func (o *ODS) readODS() (square, error) {
 	if !o.disableCache {
 		o.lock.RLock()
@@ -405,12 +618,26 @@ func (o *ODS) readODS() (square, error) {
 		defer o.lock.Unlock()
 	}
 
-	offset := o.hdr.OffsetWithRoots()
-	shareSize := o.hdr.ShareSize()
-	odsBytes := o.hdr.SquareSize() / 2
-	odsSizeInBytes := shareSize * odsBytes * odsBytes
-	reader := io.NewSectionReader(o.fl, int64(offset), int64(odsSizeInBytes))
-	ods, err := readSquare(reader, shareSize, o.size())
+	var (
+		ods square
+		err error
+	)
+	if o.Compressed() {
+		ods, err = readCompressedSquare(o.fl, o.hdr)
+	} else {
+		offset := o.hdr.OffsetWithRoots()
+		shareSize := o.hdr.ShareSize()
+		odsBytes := o.hdr.SquareSize() / 2
+		odsSizeInBytes := shareSize * odsBytes * odsBytes
+		var reader io.Reader = io.NewSectionReader(o.fl, int64(offset), int64(odsSizeInBytes))
+		if isRemote(o.fl) {
+			reader, err = readRange(o.fl, offset, odsSizeInBytes)
+			if err != nil {
+				return nil, fmt.Errorf("reading ODS: %w", err)
+			}
+		}
+		ods, err = readSquare(reader, shareSize, o.size())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("reading ODS: %w", err)
 	}
@@ -426,6 +653,15 @@ func readAxisHalf(r io.ReaderAt, axisTp rsmt2d.Axis, axisIdx int, hdr *headerV0,
 	case rsmt2d.Row:
 		return readRowHalf(r, axisIdx, hdr, offset)
 	case rsmt2d.Col:
+		if isRemote(r) {
+			// read the whole quadrant at once instead of reading the column share by share
+			odsLn := hdr.SquareSize() / 2
+			quadrant, err := readRange(r, offset, odsLn*odsLn*hdr.ShareSize())
+			if err != nil {
+				return nil, err
+			}
+			return readColHalf(quadrant, axisIdx, hdr, 0)
+		}
 		return readColHalf(r, axisIdx, hdr, offset)
 	default:
 		return nil, fmt.Errorf("unknown axis")
@@ -0,0 +1,353 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+const (
+	// compressedTileWidth is the width of the square tiles of shares the compressed ODS is split
+	// into. Tiles are compressed independently, so both rows and columns are read by decompressing
+	// a single strip of tiles instead of the whole ODS.
+	compressedTileWidth = 8
+	// tileOffsetSize is the size of a single entry in the table of compressed tile offsets.
+	tileOffsetSize = 8
+)
+
+var (
+	// zstd encoder and decoder are safe for concurrent use with EncodeAll and DecodeAll
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func init() {
+	var err error
+	zstdEncoder, err = zstd.NewWriter(nil)
+	if err != nil {
+		panic(fmt.Sprintf("creating zstd encoder: %s", err))
+	}
+	zstdDecoder, err = zstd.NewReader(nil)
+	if err != nil {
+		panic(fmt.Sprintf("creating zstd decoder: %s", err))
+	}
+}
+
+// WithCompression makes the ODS file to be written in the compressed format.
+// The compressed ODS is split into square tiles of shares compressed independently with zstd,
+// which keeps random access to both rows and columns, while padding shares take almost
+// no space on disk.
+func WithCompression() Option {
+	return func(p *options) {
+		p.compress = true
+	}
+}
+
+// CompressODS converts the ODS file under the src path into the compressed format and writes
+// it under the dst path. The src file is left untouched.
+func CompressODS(src, dst string) error {
+	ods, err := OpenODS(src)
+	if err != nil {
+		return fmt.Errorf("opening ODS file: %w", err)
+	}
+	defer ods.Close()
+	if ods.Compressed() {
+		return fmt.Errorf("ODS file %s is already compressed", src)
+	}
+
+	roots := make([]byte, ods.hdr.RootsSize())
+	if _, err := ods.fl.ReadAt(roots, int64(ods.hdr.Size())); err != nil {
+		return fmt.Errorf("reading axis roots: %w", err)
+	}
+
+	mod := os.O_RDWR | os.O_CREATE | os.O_EXCL // ensure we fail if already exist
+	f, err := os.OpenFile(dst, mod, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating compressed ODS file: %w", err)
+	}
+
+	hdr := *ods.hdr
+	hdr.fileVersion = fileV1
+	rowFn := func(rowIdx int) ([]byte, error) {
+		shares, err := readRowHalf(ods.fl, rowIdx, ods.hdr, ods.hdr.OffsetWithRoots())
+		if err != nil {
+			return nil, err
+		}
+		return sharesToBytes(shares, hdr.ShareSize()), nil
+	}
+
+	tiles, err := compressTiles(hdr.SquareSize()/2, hdr.ShareSize(), rowFn)
+	if err != nil {
+		return errors.Join(err, f.Close())
+	}
+
+	buf := bufio.NewWriterSize(f, writeBufferSize)
+	err = writeHeader(buf, &hdr)
+	if err == nil {
+		_, err = buf.Write(roots)
+	}
+	if err == nil {
+		err = writeCompressedODS(buf, tiles)
+	}
+	if err == nil {
+		err = buf.Flush()
+	}
+	if errClose := f.Close(); errClose != nil {
+		err = errors.Join(err, fmt.Errorf("closing compressed ODS file: %w", errClose))
+	}
+	return err
+}
+
+// Compressed reports whether the file is stored in the compressed format.
+func (o *ODS) Compressed() bool {
+	return o.hdr.fileVersion == fileV1
+}
+
+// tileWidth returns the width of the tiles the compressed ODS of the given width is split into.
+func tileWidth(odsWidth int) int {
+	return min(odsWidth, compressedTileWidth)
+}
+
+// compressTiles splits the ODS into tiles and compresses each of them. The shares of the tile
+// are laid out in row-major order and the tiles are returned in row-major order as well.
+func compressTiles(odsWidth, shareSize int, rowFn func(rowIdx int) ([]byte, error)) ([][]byte, error) {
+	width := tileWidth(odsWidth)
+	tilesPerAxis := odsWidth / width
+	tiles := make([][]byte, 0, tilesPerAxis*tilesPerAxis)
+	rows := make([][]byte, width)
+	raw := make([]byte, 0, width*width*shareSize)
+	for tileRow := range tilesPerAxis {
+		for i := range rows {
+			row, err := rowFn(tileRow*width + i)
+			if err != nil {
+				return nil, fmt.Errorf("reading row %d: %w", tileRow*width+i, err)
+			}
+			rows[i] = row
+		}
+		for tileCol := range tilesPerAxis {
+			raw = raw[:0]
+			for _, row := range rows {
+				raw = append(raw, row[tileCol*width*shareSize:(tileCol+1)*width*shareSize]...)
+			}
+			tiles = append(tiles, zstdEncoder.EncodeAll(raw, nil))
+		}
+	}
+	return tiles, nil
+}
+
+// writeCompressedODS writes the compressed tiles of the ODS preceded by the table of their
+// offsets, so each tile can be read without reading the rest of the file. The table has
+// an additional entry holding the total size of the compressed tiles.
+func writeCompressedODS(w io.Writer, tiles [][]byte) error {
+	offsets := make([]byte, (len(tiles)+1)*tileOffsetSize)
+	var offset uint64
+	for i, tile := range tiles {
+		binary.LittleEndian.PutUint64(offsets[i*tileOffsetSize:], offset)
+		offset += uint64(len(tile))
+	}
+	binary.LittleEndian.PutUint64(offsets[len(tiles)*tileOffsetSize:], offset)
+
+	if _, err := w.Write(offsets); err != nil {
+		return fmt.Errorf("writing tile offsets: %w", err)
+	}
+	for i, tile := range tiles {
+		if _, err := w.Write(tile); err != nil {
+			return fmt.Errorf("writing tile %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// edsRowFn returns function reading raw rows of the ODS out of the given EDS.
+func edsRowFn(eds *rsmt2d.ExtendedDataSquare) func(rowIdx int) ([]byte, error) {
+	odsWidth := int(eds.Width() / 2)
+	return func(rowIdx int) ([]byte, error) {
+		row := eds.Row(uint(rowIdx))[:odsWidth]
+		raw := make([]byte, 0, odsWidth*len(row[0]))
+		for _, shr := range row {
+			raw = append(raw, shr...)
+		}
+		return raw, nil
+	}
+}
+
+// compressedODSSize returns the size of the compressed ODS of the given EDS, excluding
+// the header and the axis roots.
+func compressedODSSize(eds *rsmt2d.ExtendedDataSquare) (int, error) {
+	odsWidth := int(eds.Width() / 2)
+	tiles, err := compressTiles(odsWidth, len(eds.GetCell(0, 0)), edsRowFn(eds))
+	if err != nil {
+		return 0, err
+	}
+	size := (len(tiles) + 1) * tileOffsetSize
+	for _, tile := range tiles {
+		size += len(tile)
+	}
+	return size, nil
+}
+
+// tilesPerAxis returns the amount of tiles in each row and column of tiles of the compressed ODS.
+func tilesPerAxis(hdr *headerV0) int {
+	odsWidth := hdr.SquareSize() / 2
+	return odsWidth / tileWidth(odsWidth)
+}
+
+// tilesOffset returns the offset of the compressed tiles in the file.
+func tilesOffset(hdr *headerV0) int {
+	tiles := tilesPerAxis(hdr)
+	return hdr.OffsetWithRoots() + (tiles*tiles+1)*tileOffsetSize
+}
+
+// readTileOffsets reads the given amount of consecutive entries of the table of tile offsets
+// starting from the given tile. The offsets are relative to the beginning of the compressed tiles.
+func readTileOffsets(r io.ReaderAt, hdr *headerV0, tileIdx, amount int) ([]int, error) {
+	buf := make([]byte, amount*tileOffsetSize)
+	tableOffset := hdr.OffsetWithRoots() + tileIdx*tileOffsetSize
+	if _, err := r.ReadAt(buf, int64(tableOffset)); err != nil {
+		return nil, fmt.Errorf("reading tile offsets: %w", err)
+	}
+
+	offsets := make([]int, amount)
+	for i := range offsets {
+		offsets[i] = int(binary.LittleEndian.Uint64(buf[i*tileOffsetSize:]))
+		if i > 0 && offsets[i] < offsets[i-1] {
+			return nil, fmt.Errorf("invalid offset of tile %d: %d < %d", tileIdx+i, offsets[i], offsets[i-1])
+		}
+	}
+	return offsets, nil
+}
+
+// readCompressed reads the compressed tiles in between the given offsets.
+func readCompressed(r io.ReaderAt, hdr *headerV0, start, end int) ([]byte, error) {
+	compressed := make([]byte, end-start)
+	if _, err := r.ReadAt(compressed, int64(tilesOffset(hdr)+start)); err != nil {
+		return nil, fmt.Errorf("reading compressed tiles: %w", err)
+	}
+	return compressed, nil
+}
+
+// decompressTile decompresses the tile into the shares laid out in row-major order.
+func decompressTile(compressed []byte, hdr *headerV0) ([]libshare.Share, error) {
+	width := tileWidth(hdr.SquareSize() / 2)
+	raw, err := zstdDecoder.DecodeAll(compressed, make([]byte, 0, width*width*hdr.ShareSize()))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing tile: %w", err)
+	}
+	if len(raw) != width*width*hdr.ShareSize() {
+		return nil, fmt.Errorf("decompressed tile has invalid size: %d", len(raw))
+	}
+
+	shares := make([]libshare.Share, width*width)
+	for i := range shares {
+		sh, err := libshare.NewShare(raw[i*hdr.ShareSize() : (i+1)*hdr.ShareSize()])
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = sh
+	}
+	return shares, nil
+}
+
+// readCompressedRowHalf reads the specific Row half from the compressed file. Only the tiles
+// of the row are read and decompressed.
+func readCompressedRowHalf(r io.ReaderAt, rowIdx int, hdr *headerV0) ([]libshare.Share, error) {
+	odsWidth := hdr.SquareSize() / 2
+	width, tiles := tileWidth(odsWidth), tilesPerAxis(hdr)
+	tileRow := rowIdx / width
+	offsets, err := readTileOffsets(r, hdr, tileRow*tiles, tiles+1)
+	if err != nil {
+		return nil, err
+	}
+	// the tiles of the same row are stored next to each other, so they are read at once
+	compressed, err := readCompressed(r, hdr, offsets[0], offsets[tiles])
+	if err != nil {
+		return nil, fmt.Errorf("reading row %d: %w", rowIdx, err)
+	}
+
+	shares := make([]libshare.Share, 0, odsWidth)
+	inTile := rowIdx % width
+	for tileCol := range tiles {
+		tile, err := decompressTile(compressed[offsets[tileCol]-offsets[0]:offsets[tileCol+1]-offsets[0]], hdr)
+		if err != nil {
+			return nil, fmt.Errorf("reading row %d: %w", rowIdx, err)
+		}
+		shares = append(shares, tile[inTile*width:(inTile+1)*width]...)
+	}
+	return shares, nil
+}
+
+// readCompressedColHalf reads the specific Col half from the compressed file. Only the tiles
+// of the column are read and decompressed.
+func readCompressedColHalf(r io.ReaderAt, colIdx int, hdr *headerV0) ([]libshare.Share, error) {
+	odsWidth := hdr.SquareSize() / 2
+	width, tiles := tileWidth(odsWidth), tilesPerAxis(hdr)
+	offsets, err := readTileOffsets(r, hdr, 0, tiles*tiles+1)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]libshare.Share, 0, odsWidth)
+	tileCol, inTile := colIdx/width, colIdx%width
+	for tileRow := range tiles {
+		tileIdx := tileRow*tiles + tileCol
+		compressed, err := readCompressed(r, hdr, offsets[tileIdx], offsets[tileIdx+1])
+		if err != nil {
+			return nil, fmt.Errorf("reading column %d: %w", colIdx, err)
+		}
+		tile, err := decompressTile(compressed, hdr)
+		if err != nil {
+			return nil, fmt.Errorf("reading column %d: %w", colIdx, err)
+		}
+		for i := range width {
+			shares = append(shares, tile[i*width+inTile])
+		}
+	}
+	return shares, nil
+}
+
+// readCompressedSquare reads and decompresses all tiles of the compressed file.
+func readCompressedSquare(r io.ReaderAt, hdr *headerV0) (square, error) {
+	odsWidth := hdr.SquareSize() / 2
+	width, tiles := tileWidth(odsWidth), tilesPerAxis(hdr)
+	offsets, err := readTileOffsets(r, hdr, 0, tiles*tiles+1)
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := readCompressed(r, hdr, 0, offsets[tiles*tiles])
+	if err != nil {
+		return nil, err
+	}
+
+	ods := make(square, odsWidth)
+	for i := range ods {
+		ods[i] = make([]libshare.Share, 0, odsWidth)
+	}
+	for tileIdx := range tiles * tiles {
+		tile, err := decompressTile(compressed[offsets[tileIdx]:offsets[tileIdx+1]], hdr)
+		if err != nil {
+			return nil, fmt.Errorf("reading tile %d: %w", tileIdx, err)
+		}
+		tileRow := tileIdx / tiles
+		for i := range width {
+			rowIdx := tileRow*width + i
+			ods[rowIdx] = append(ods[rowIdx], tile[i*width:(i+1)*width]...)
+		}
+	}
+	return ods, nil
+}
+
+func sharesToBytes(shares []libshare.Share, shareSize int) []byte {
+	raw := make([]byte, 0, len(shares)*shareSize)
+	for _, shr := range shares {
+		raw = append(raw, shr.ToBytes()...)
+	}
+	return raw
+}
@@ -0,0 +1,166 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+)
+
+func TestCompressedODSFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	ODSSize := 16
+	eds.TestSuiteAccessor(ctx, t, func(t testing.TB, square *rsmt2d.ExtendedDataSquare) eds.Accessor {
+		return createCompressedODSFile(t, square)
+	}, ODSSize)
+	eds.TestStreamer(ctx, t, func(t testing.TB, square *rsmt2d.ExtendedDataSquare) eds.AccessorStreamer {
+		return createCompressedODSFile(t, square)
+	}, ODSSize)
+}
+
+func TestCompressODS(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDSWithTailPadding(t, 16, 200)
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+
+	pathV0 := t.TempDir() + "/v0"
+	require.NoError(t, CreateODS(pathV0, roots, square))
+	pathV1 := t.TempDir() + "/v1"
+	require.NoError(t, CompressODS(pathV0, pathV1))
+	require.Error(t, CompressODS(pathV1, t.TempDir()+"/v2"))
+
+	f, err := OpenODS(pathV1)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = f.Close()
+	})
+	require.True(t, f.Compressed())
+	require.NoError(t, ValidateODSSize(pathV1, square))
+
+	readRoots, err := f.AxisRoots(ctx)
+	require.NoError(t, err)
+	require.True(t, roots.Equals(readRoots))
+
+	shares, err := f.Shares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, square.FlattenedODS(), libshare.ToBytes(shares))
+}
+
+func TestCompressedODSSize(t *testing.T) {
+	// mostly empty square
+	square := edstest.RandEDSWithTailPadding(t, 32, 32*32-10)
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+
+	path := t.TempDir() + "/ods"
+	require.NoError(t, CreateODS(path, roots, square, WithCompression()))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	rawSize := (headerVOSize + 1) + len(roots.RowRoots)*2*share.AxisRootSize + 10*libshare.ShareSize
+	// compressed rows of padding take a few bytes each
+	require.Less(t, info.Size(), int64(rawSize+32*64))
+}
+
+func TestCompressedODS_AxisReadsOwnTiles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDS(t, 32)
+	path := t.TempDir() + "/ods"
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	require.NoError(t, CreateODS(path, roots, square, WithCompression()))
+	require.NoError(t, ValidateODSSize(path, square))
+
+	f, err := OpenODS(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = f.Close()
+	})
+	f.disableCache = true
+
+	// corrupt the first tile, so any read decompressing it fails
+	offsets, err := readTileOffsets(f.fl, f.hdr, 0, 2)
+	require.NoError(t, err)
+	garbage := make([]byte, offsets[1]-offsets[0])
+	osFile, err := os.OpenFile(path, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = osFile.WriteAt(garbage, int64(tilesOffset(f.hdr)+offsets[0]))
+	require.NoError(t, err)
+	require.NoError(t, osFile.Close())
+
+	width := tileWidth(32)
+	for _, axisType := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		// axes crossing the corrupted tile fail
+		_, err = f.AxisHalf(ctx, axisType, width-1)
+		require.Error(t, err)
+
+		// while the others are read without decompressing it
+		half, err := f.AxisHalf(ctx, axisType, width)
+		require.NoError(t, err)
+		expected := square.Row(uint(width))[:32]
+		if axisType == rsmt2d.Col {
+			expected = square.Col(uint(width))[:32]
+		}
+		require.Equal(t, expected, libshare.ToBytes(half.Shares))
+	}
+	// the size of the file is validated against the compressed square it is expected to hold
+	require.Error(t, ValidateODSSize(path, edstest.RandEDSWithTailPadding(t, 32, 32*32-10)))
+}
+
+// BenchmarkCompressedODS_AxisHalf/Size:128/row         	     200	    866741 ns/op	 1064654 B/op	      36 allocs/op
+// BenchmarkCompressedODS_AxisHalf/Size:128/col         	     200	    928316 ns/op	 1085073 B/op	      51 allocs/op
+// BenchmarkCompressedODS_AxisHalf/Size:128/Square      	     200	  12567477 ns/op	17391376 B/op	     645 allocs/op
+func BenchmarkCompressedODS_AxisHalf(b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	b.Cleanup(cancel)
+
+	const size = 128
+	f := createCompressedODSFile(b, edstest.RandEDS(b, size))
+	f.disableCache = true
+
+	for _, axisType := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		b.Run(fmt.Sprintf("Size:%d/%s", size, axisType), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; b.Loop(); i++ {
+				_, err := f.AxisHalf(ctx, axisType, i%size)
+				require.NoError(b, err)
+			}
+		})
+	}
+	// reading the axis out of the whole square is what columns used to cost
+	b.Run(fmt.Sprintf("Size:%d/Square", size), func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, err := f.readODS()
+			require.NoError(b, err)
+		}
+	})
+}
+
+func createCompressedODSFile(t testing.TB, square *rsmt2d.ExtendedDataSquare) *ODS {
+	path := t.TempDir() + "/ods"
+	roots, err := share.NewAxisRoots(square)
+	require.NoError(t, err)
+	require.NoError(t, CreateODS(path, roots, square, WithCompression()))
+
+	ods, err := OpenODS(path)
+	require.NoError(t, err)
+	return ods
+}
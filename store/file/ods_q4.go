@@ -39,15 +39,17 @@ func CreateODSQ4(
 	pathODS, pathQ4 string,
 	roots *share.AxisRoots,
 	eds *rsmt2d.ExtendedDataSquare,
+	opts ...Option,
 ) error {
+	params := newOptions(opts...)
 	errCh := make(chan error)
 	go func() {
 		// doing this async shaves off ~27% of time for 128 ODS
 		// for bigger ODSes the discrepancy is even bigger
-		errCh <- createQ4(pathQ4, eds)
+		errCh <- createQ4(params.fs, pathQ4, eds)
 	}()
 
-	err := CreateODS(pathODS, roots, eds)
+	err := CreateODS(pathODS, roots, eds, opts...)
 	q4Err := <-errCh
 
 	if err != nil && q4Err != nil {
@@ -63,19 +65,20 @@ func CreateODSQ4(
 }
 
 // ValidateODSQ4Size checks the size of the ODS and Q4 files under the given FS paths.
-func ValidateODSQ4Size(pathODS, pathQ4 string, eds *rsmt2d.ExtendedDataSquare) error {
-	err := ValidateODSSize(pathODS, eds)
+func ValidateODSQ4Size(pathODS, pathQ4 string, eds *rsmt2d.ExtendedDataSquare, opts ...Option) error {
+	err := ValidateODSSize(pathODS, eds, opts...)
 	if err != nil {
 		return fmt.Errorf("validating ODS file size: %w", err)
 	}
-	err = validateQ4Size(pathQ4, eds)
+	err = validateQ4Size(newOptions(opts...).fs, pathQ4, eds)
 	if err != nil {
 		return fmt.Errorf("validating Q4 file size: %w", err)
 	}
 	return nil
 }
 
-// ODSWithQ4 returns ODSQ4 instance over ODS. It opens Q4 file lazily under the given path.
+// ODSWithQ4 returns ODSQ4 instance over ODS. It opens Q4 file lazily under the given path
+// from the same FS as the ODS.
 func ODSWithQ4(ods *ODS, pathQ4 string) *ODSQ4 {
 	return &ODSQ4{
 		ods:    ods,
@@ -95,7 +98,7 @@ func (odsq4 *ODSQ4) tryLoadQ4() *q4 {
 		return odsq4.q4
 	}
 
-	q4, err := openQ4(odsq4.pathQ4, odsq4.ods.hdr)
+	q4, err := openQ4(odsq4.ods.fs, odsq4.pathQ4, odsq4.ods.hdr)
 	// store q4 opened bool before updating atomic value to allow next read attempts to use it
 	odsq4.q4 = q4
 	// even if error occurred, store q4 opened bool to avoid trying to open it again
@@ -156,6 +159,14 @@ func (odsq4 *ODSQ4) RowNamespaceData(ctx context.Context,
 	namespace libshare.Namespace,
 	rowIdx int,
 ) (shwap.RowNamespaceData, error) {
+	outside, err := odsq4.ods.isOutsideRow(namespace, rowIdx)
+	if err != nil {
+		return shwap.RowNamespaceData{}, err
+	}
+	if outside {
+		return shwap.RowNamespaceData{}, shwap.ErrNamespaceOutsideRange
+	}
+
 	half, err := odsq4.AxisHalf(ctx, rsmt2d.Row, rowIdx)
 	if err != nil {
 		return shwap.RowNamespaceData{}, fmt.Errorf("reading axis: %w", err)
@@ -167,6 +178,14 @@ func (odsq4 *ODSQ4) RowNamespaceData(ctx context.Context,
 	return shwap.RowNamespaceDataFromShares(shares, namespace, rowIdx)
 }
 
+// NamespaceShares returns the shares of the given namespace using the namespace index of the ODS.
+func (odsq4 *ODSQ4) NamespaceShares(
+	ctx context.Context,
+	namespace libshare.Namespace,
+) (int, []libshare.Share, error) {
+	return odsq4.ods.NamespaceShares(ctx, namespace)
+}
+
 func (odsq4 *ODSQ4) Shares(ctx context.Context) ([]libshare.Share, error) {
 	return odsq4.ods.Shares(ctx)
 }
@@ -89,9 +89,33 @@ func TestValidateODSSize(t *testing.T) {
 		valid      bool
 	}{
 		{
-			name:       "valid",
-			createFile: CreateODS,
-			valid:      true,
+			name: "valid",
+			createFile: func(path string, roots *share.AxisRoots, eds *rsmt2d.ExtendedDataSquare) error {
+				return CreateODS(path, roots, eds)
+			},
+			valid: true,
+		},
+		{
+			name: "valid compressed",
+			createFile: func(path string, roots *share.AxisRoots, eds *rsmt2d.ExtendedDataSquare) error {
+				return CreateODS(path, roots, eds, WithCompression())
+			},
+			valid: true,
+		},
+		{
+			name: "shorter compressed",
+			createFile: func(path string, roots *share.AxisRoots, eds *rsmt2d.ExtendedDataSquare) error {
+				err := CreateODS(path, roots, eds, WithCompression())
+				if err != nil {
+					return err
+				}
+				info, err := os.Stat(path)
+				if err != nil {
+					return err
+				}
+				return os.Truncate(path, info.Size()-1)
+			},
+			valid: false,
 		},
 		{
 			name: "shorter",
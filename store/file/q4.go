@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/celestiaorg/rsmt2d"
 
@@ -15,18 +14,18 @@ import (
 // q4 stores the fourth quadrant of the square.
 type q4 struct {
 	hdr  *headerV0
-	file *os.File
+	file File
 }
 
 // createQ4 creates a new file under given FS path and
 // writes the Q4 into it out of given EDS.
 // It may leave partially written file if any of the writes fail.
 func createQ4(
+	fs FS,
 	path string,
 	eds *rsmt2d.ExtendedDataSquare,
 ) error {
-	mod := os.O_RDWR | os.O_CREATE | os.O_EXCL // ensure we fail if already exist
-	f, err := os.OpenFile(path, mod, filePermissions)
+	f, err := fs.Create(path)
 	if err != nil {
 		return fmt.Errorf("creating Q4 file: %w", err)
 	}
@@ -40,7 +39,7 @@ func createQ4(
 }
 
 // writeQ4File full Q4 content into OS File.
-func writeQ4File(f *os.File, eds *rsmt2d.ExtendedDataSquare) error {
+func writeQ4File(f io.Writer, eds *rsmt2d.ExtendedDataSquare) error {
 	// buffering gives us ~4x speed up
 	buf := bufio.NewWriterSize(f, writeBufferSize)
 
@@ -71,8 +70,8 @@ func writeQ4(w io.Writer, eds *rsmt2d.ExtendedDataSquare) error {
 	return nil
 }
 
-func validateQ4Size(path string, eds *rsmt2d.ExtendedDataSquare) error {
-	f, err := os.Open(path)
+func validateQ4Size(fs FS, path string, eds *rsmt2d.ExtendedDataSquare) error {
+	f, err := fs.Open(path)
 	if err != nil {
 		return fmt.Errorf("opening file: %w", err)
 	}
@@ -82,19 +81,19 @@ func validateQ4Size(path string, eds *rsmt2d.ExtendedDataSquare) error {
 	shareSize := len(eds.GetCell(0, 0))
 	expectedSize := shareSize * odsSize * odsSize
 
-	info, err := f.Stat()
+	size, err := f.Size()
 	if err != nil {
-		return fmt.Errorf("getting file info: %w", err)
+		return fmt.Errorf("getting file size: %w", err)
 	}
-	if info.Size() != int64(expectedSize) {
-		return fmt.Errorf("file size mismatch: expected %d, got %d", expectedSize, info.Size())
+	if size != int64(expectedSize) {
+		return fmt.Errorf("file size mismatch: expected %d, got %d", expectedSize, size)
 	}
 	return nil
 }
 
 // openQ4 opens an existing Q4 file under given FS path.
-func openQ4(path string, hdr *headerV0) (*q4, error) {
-	f, err := os.Open(path)
+func openQ4(fs FS, path string, hdr *headerV0) (*q4, error) {
+	f, err := fs.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -139,3 +139,21 @@ func (g *Getter) GetRangeNamespaceData(
 	}
 	return rngData, nil
 }
+
+// GetNamespaceShares returns the shares of the namespace along with the index of the first share
+// in the ODS. The shares are read using the namespace index of the stored file and come without
+// inclusion proofs, thus it is only suitable for reading the local data.
+func (g *Getter) GetNamespaceShares(
+	ctx context.Context,
+	h *header.ExtendedHeader,
+	ns libshare.Namespace,
+) (int, []libshare.Share, error) {
+	from, shares, err := g.store.NamespaceShares(ctx, h.Height(), ns)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return 0, nil, shwap.ErrNotFound
+		}
+		return 0, nil, fmt.Errorf("get namespace shares from store: %w", err)
+	}
+	return from, shares, nil
+}
@@ -193,3 +193,76 @@ func (m *metrics) close() error {
 	}
 	return m.unreg()
 }
+
+const corruptedKey = "corrupted"
+
+type scrubberMetrics struct {
+	scrubbed     metric.Int64Counter
+	recovered    metric.Int64Counter
+	lastScrubbed metric.Int64ObservableGauge
+	clientReg    metric.Registration
+}
+
+func (s *Scrubber) WithMetrics() error {
+	scrubbed, err := meter.Int64Counter("eds_store_scrubbed_total",
+		metric.WithDescription("number of stored files verified by the scrubber, labeled by `corrupted`"))
+	if err != nil {
+		return err
+	}
+
+	recovered, err := meter.Int64Counter("eds_store_scrubber_recovered_total",
+		metric.WithDescription("number of corrupted files re-fetched by the scrubber"))
+	if err != nil {
+		return err
+	}
+
+	lastScrubbed, err := meter.Int64ObservableGauge("eds_store_scrubber_last_scrubbed_height",
+		metric.WithDescription("last height verified by the scrubber in the current pass"))
+	if err != nil {
+		return err
+	}
+
+	callback := func(_ context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(lastScrubbed, int64(s.LastScrubbed()))
+		return nil
+	}
+	clientReg, err := meter.RegisterCallback(callback, lastScrubbed)
+	if err != nil {
+		return err
+	}
+
+	s.metrics = &scrubberMetrics{
+		scrubbed:     scrubbed,
+		recovered:    recovered,
+		lastScrubbed: lastScrubbed,
+		clientReg:    clientReg,
+	}
+	return nil
+}
+
+func (m *scrubberMetrics) observeScrub(ctx context.Context, corrupted bool) {
+	if m == nil {
+		return
+	}
+	ctx = utils.ResetContextOnError(ctx)
+
+	m.scrubbed.Add(ctx, 1, metric.WithAttributes(
+		attribute.Bool(corruptedKey, corrupted)))
+}
+
+func (m *scrubberMetrics) observeRecover(ctx context.Context, failed bool) {
+	if m == nil {
+		return
+	}
+	ctx = utils.ResetContextOnError(ctx)
+
+	m.recovered.Add(ctx, 1, metric.WithAttributes(
+		attribute.Bool(failedKey, failed)))
+}
+
+func (m *scrubberMetrics) close() error {
+	if m == nil {
+		return nil
+	}
+	return m.clientReg.Unregister()
+}
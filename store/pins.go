@@ -0,0 +1,259 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+var (
+	pinsPrefix     = datastore.NewKey("pins")
+	pinsListPrefix = datastore.NewKey("list")
+	pinsDataPrefix = datastore.NewKey("data")
+
+	// ErrPinnedInConfig is returned when unpinning a namespace pinned in the config.
+	ErrPinnedInConfig = errors.New("namespace is pinned in the config")
+)
+
+// Pins keeps the data of the pinned namespaces after the squares are removed from the Store.
+// Before a square is pruned, the shares of the pinned namespaces are extracted out of it along
+// with their inclusion proofs, so the namespace data stays verifiable.
+//
+// Namespaces are pinned either by the config, or at runtime, in which case the pin is persisted.
+// Pinning doesn't recover the data of the squares pruned before.
+type Pins struct {
+	ds datastore.Batching
+
+	lk sync.RWMutex
+	// configured are the namespaces pinned by the config, which can't be unpinned at runtime
+	configured map[string]struct{}
+	pinned     map[string]libshare.Namespace
+}
+
+// NewPins loads the runtime pins from the given datastore and pins the given namespaces on top.
+func NewPins(ctx context.Context, ds datastore.Batching, configured []libshare.Namespace) (*Pins, error) {
+	p := &Pins{
+		ds:         namespace.Wrap(ds, pinsPrefix),
+		configured: make(map[string]struct{}, len(configured)),
+		pinned:     make(map[string]libshare.Namespace),
+	}
+	for _, ns := range configured {
+		p.configured[pinKey(ns)] = struct{}{}
+		p.pinned[pinKey(ns)] = ns
+	}
+
+	results, err := p.ds.Query(ctx, query.Query{Prefix: pinsListPrefix.String(), KeysOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("loading pinned namespaces: %w", err)
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, fmt.Errorf("loading pinned namespaces: %w", result.Error)
+		}
+		key := datastore.RawKey(result.Key).BaseNamespace()
+		nsBytes, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("decoding pinned namespace %s: %w", key, err)
+		}
+		ns, err := libshare.NewNamespaceFromBytes(nsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("decoding pinned namespace %s: %w", key, err)
+		}
+		p.pinned[key] = ns
+	}
+	return p, nil
+}
+
+// Pin pins the namespace, so its data is kept after the squares are pruned.
+func (p *Pins) Pin(ctx context.Context, ns libshare.Namespace) error {
+	if err := ns.ValidateForData(); err != nil {
+		return err
+	}
+
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if _, ok := p.pinned[pinKey(ns)]; ok {
+		return nil
+	}
+	if err := p.ds.Put(ctx, pinsListPrefix.ChildString(pinKey(ns)), []byte{}); err != nil {
+		return fmt.Errorf("persisting pin: %w", err)
+	}
+	p.pinned[pinKey(ns)] = ns
+	return nil
+}
+
+// Unpin unpins the namespace and removes the data kept for it.
+// The namespaces pinned by the config can't be unpinned.
+func (p *Pins) Unpin(ctx context.Context, ns libshare.Namespace) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if _, ok := p.configured[pinKey(ns)]; ok {
+		return ErrPinnedInConfig
+	}
+	if _, ok := p.pinned[pinKey(ns)]; !ok {
+		return nil
+	}
+
+	batch, err := p.ds.Batch(ctx)
+	if err != nil {
+		return err
+	}
+	results, err := p.ds.Query(ctx, query.Query{
+		Prefix:   pinsDataPrefix.ChildString(pinKey(ns)).String(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := batch.Delete(ctx, datastore.NewKey(result.Key)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Delete(ctx, pinsListPrefix.ChildString(pinKey(ns))); err != nil {
+		return err
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("removing pin: %w", err)
+	}
+	delete(p.pinned, pinKey(ns))
+	return nil
+}
+
+// Pinned returns the pinned namespaces in ascending order.
+func (p *Pins) Pinned() []libshare.Namespace {
+	p.lk.RLock()
+	pinned := make([]libshare.Namespace, 0, len(p.pinned))
+	for _, ns := range p.pinned {
+		pinned = append(pinned, ns)
+	}
+	p.lk.RUnlock()
+
+	slices.SortFunc(pinned, func(a, b libshare.Namespace) int {
+		return a.Compare(b)
+	})
+	return pinned
+}
+
+// Extract keeps the data of the pinned namespaces out of the square of the given header.
+// It is meant to be called before the square is pruned.
+func (p *Pins) Extract(ctx context.Context, eh *header.ExtendedHeader, acc eds.Accessor) error {
+	pinned := p.Pinned()
+	if len(pinned) == 0 {
+		return nil
+	}
+
+	batch, err := p.ds.Batch(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ns := range pinned {
+		nd, err := eds.NamespaceData(ctx, acc, ns)
+		if err != nil {
+			return fmt.Errorf("extracting namespace %s: %w", ns.String(), err)
+		}
+		buf := &bytes.Buffer{}
+		if _, err := nd.WriteTo(buf); err != nil {
+			return fmt.Errorf("marshaling namespace %s: %w", ns.String(), err)
+		}
+		if err := batch.Put(ctx, pinDataKey(ns, eh.Height()), buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("persisting pinned namespaces at %d: %w", eh.Height(), err)
+	}
+	return nil
+}
+
+// Get returns the data of the pinned namespace kept for the given height.
+// It fails with ErrNotFound if no data is kept for them.
+func (p *Pins) Get(ctx context.Context, height uint64, ns libshare.Namespace) (shwap.NamespaceData, error) {
+	data, err := p.ds.Get(ctx, pinDataKey(ns, height))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nd := shwap.NamespaceData{}
+	if _, err := nd.ReadFrom(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("unmarshaling namespace %s at %d: %w", ns.String(), height, err)
+	}
+	return nd, nil
+}
+
+func pinKey(ns libshare.Namespace) string {
+	return hex.EncodeToString(ns.Bytes())
+}
+
+func pinDataKey(ns libshare.Namespace, height uint64) datastore.Key {
+	return pinsDataPrefix.ChildString(pinKey(ns)).ChildString(strconv.FormatUint(height, 10))
+}
+
+var _ shwap.Getter = (*PinsGetter)(nil)
+
+// PinsGetter serves the namespace data kept by Pins. It supports GetNamespaceData only, as no
+// other data of the pruned squares is kept. The blob retrieval falls back to GetNamespaceData
+// when the rows are unavailable, so it is served for the pinned namespaces as well.
+type PinsGetter struct {
+	pins *Pins
+}
+
+func NewPinsGetter(pins *Pins) *PinsGetter {
+	return &PinsGetter{pins: pins}
+}
+
+func (g *PinsGetter) GetNamespaceData(
+	ctx context.Context,
+	h *header.ExtendedHeader,
+	ns libshare.Namespace,
+) (shwap.NamespaceData, error) {
+	nd, err := g.pins.Get(ctx, h.Height(), ns)
+	if errors.Is(err, ErrNotFound) {
+		return nil, shwap.ErrNotFound
+	}
+	return nd, err
+}
+
+func (g *PinsGetter) GetSamples(context.Context, *header.ExtendedHeader, []shwap.SampleCoords) ([]shwap.Sample, error) {
+	return nil, shwap.ErrOperationNotSupported
+}
+
+func (g *PinsGetter) GetEDS(context.Context, *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
+	return nil, shwap.ErrOperationNotSupported
+}
+
+func (g *PinsGetter) GetRow(context.Context, *header.ExtendedHeader, int) (shwap.Row, error) {
+	return shwap.Row{}, shwap.ErrOperationNotSupported
+}
+
+func (g *PinsGetter) GetRangeNamespaceData(
+	context.Context,
+	*header.ExtendedHeader,
+	int, int,
+) (shwap.RangeNamespaceData, error) {
+	return shwap.RangeNamespaceData{}, shwap.ErrOperationNotSupported
+}
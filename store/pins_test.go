@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	libshare "github.com/celestiaorg/go-square/v4/share"
+
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+func TestPins(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	configured := libshare.RandomBlobNamespace()
+	pinned := libshare.RandomBlobNamespace()
+	datastore := ds_sync.MutexWrap(ds.NewMapDatastore())
+
+	pins, err := NewPins(ctx, datastore, []libshare.Namespace{configured})
+	require.NoError(t, err)
+	require.NoError(t, pins.Pin(ctx, pinned))
+	require.ErrorIs(t, pins.Unpin(ctx, configured), ErrPinnedInConfig)
+	require.ElementsMatch(t, []libshare.Namespace{configured, pinned}, pins.Pinned())
+
+	edsStore, err := NewStore(paramsNoCache(), t.TempDir())
+	require.NoError(t, err)
+	eds, roots := edstest.RandEDSWithNamespace(t, pinned, 8, 16)
+	eh := headertest.RandExtendedHeaderWithRoot(t, roots)
+	require.NoError(t, edsStore.PutODSQ4(ctx, roots, eh.Height(), eds))
+
+	acc, err := edsStore.GetByHeight(ctx, eh.Height())
+	require.NoError(t, err)
+	require.NoError(t, pins.Extract(ctx, eh, acc))
+	require.NoError(t, acc.Close())
+	require.NoError(t, edsStore.RemoveODSQ4(ctx, eh.Height(), roots.Hash()))
+
+	// pins persist
+	pins, err = NewPins(ctx, datastore, nil)
+	require.NoError(t, err)
+	require.Equal(t, []libshare.Namespace{pinned}, pins.Pinned())
+
+	getter := NewPinsGetter(pins)
+	nd, err := getter.GetNamespaceData(ctx, eh, pinned)
+	require.NoError(t, err)
+	require.Equal(t, 8, nd.Length())
+	require.NoError(t, nd.Verify(roots, pinned))
+
+	// the configured namespace is absent in the square, which is verifiable as well
+	nd, err = getter.GetNamespaceData(ctx, eh, configured)
+	require.NoError(t, err)
+	require.NoError(t, nd.Verify(roots, configured))
+
+	_, err = getter.GetNamespaceData(ctx, eh, libshare.RandomBlobNamespace())
+	require.ErrorIs(t, err, shwap.ErrNotFound)
+	_, err = getter.GetEDS(ctx, eh)
+	require.ErrorIs(t, err, shwap.ErrOperationNotSupported)
+
+	// unpinning removes the data
+	require.NoError(t, pins.Unpin(ctx, pinned))
+	require.Empty(t, pins.Pinned())
+	_, err = getter.GetNamespaceData(ctx, eh, pinned)
+	require.ErrorIs(t, err, shwap.ErrNotFound)
+}
@@ -0,0 +1,387 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+const quarantinePath = blocksPath + "/quarantine"
+
+var (
+	scrubberPrefix   = datastore.NewKey("scrubber")
+	lastScrubbedKey  = datastore.NewKey("last_scrubbed")
+	errCorruptedFile = errors.New("corrupted file")
+)
+
+// ScrubberParams configures the Scrubber.
+type ScrubberParams struct {
+	// Enabled turns on the background verification of the stored files.
+	Enabled bool
+	// Interval is the delay between verifications of two consecutive heights.
+	// It limits the disk IO and CPU spent on the verification.
+	Interval time.Duration
+	// CycleInterval is the delay before the next pass over the stored heights
+	// once the previous pass reached the head.
+	CycleInterval time.Duration
+}
+
+// DefaultScrubberParams returns the default configuration values for the Scrubber.
+func DefaultScrubberParams() *ScrubberParams {
+	return &ScrubberParams{
+		Enabled:       false,
+		Interval:      time.Second,
+		CycleInterval: 24 * time.Hour,
+	}
+}
+
+func (p *ScrubberParams) Validate() error {
+	if p.Interval <= 0 {
+		return errors.New("scrubber interval must be positive")
+	}
+	if p.CycleInterval <= 0 {
+		return errors.New("scrubber cycle interval must be positive")
+	}
+	return nil
+}
+
+// Scrubber walks the stored heights in the background and verifies the stored files against the
+// headers. It recomputes the axis roots out of the stored data and compares them with the DAH of
+// the header. Corrupted files are moved to the quarantine and re-fetched from the network. If the
+// re-fetch fails, the files are moved back, so the height is verified and recovered on the next pass.
+type Scrubber struct {
+	params  *ScrubberParams
+	store   *Store
+	headers libhead.Store[*header.ExtendedHeader]
+	// getter re-fetches the corrupted squares. It must not read from the Store.
+	getter shwap.Getter
+	ds     datastore.Datastore
+
+	lastScrubbed atomic.Uint64
+	metrics      *scrubberMetrics
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewScrubber creates a new Scrubber over the given Store.
+func NewScrubber(
+	params *ScrubberParams,
+	store *Store,
+	headers libhead.Store[*header.ExtendedHeader],
+	getter shwap.Getter,
+	ds datastore.Datastore,
+) *Scrubber {
+	return &Scrubber{
+		params:  params,
+		store:   store,
+		headers: headers,
+		getter:  getter,
+		ds:      namespace.Wrap(ds, scrubberPrefix),
+		doneCh:  make(chan struct{}),
+	}
+}
+
+// Start loads the last scrubbed height and starts the scrubbing loop.
+func (s *Scrubber) Start(ctx context.Context) error {
+	bin, err := s.ds.Get(ctx, lastScrubbedKey)
+	switch {
+	case err == nil:
+		s.lastScrubbed.Store(binary.LittleEndian.Uint64(bin))
+	case !errors.Is(err, datastore.ErrNotFound):
+		return fmt.Errorf("scrubber: loading last scrubbed height: %w", err)
+	}
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run(ctx)
+	return nil
+}
+
+// Stop stops the scrubbing loop and persists the last scrubbed height.
+func (s *Scrubber) Stop(ctx context.Context) error {
+	s.cancel()
+	select {
+	case <-s.doneCh:
+	case <-ctx.Done():
+		return fmt.Errorf("scrubber unable to exit within context deadline")
+	}
+
+	if err := s.metrics.close(); err != nil {
+		log.Warnw("closing scrubber metrics", "err", err)
+	}
+	return s.storeLastScrubbed(ctx)
+}
+
+// LastScrubbed returns the last verified height of the current pass.
+func (s *Scrubber) LastScrubbed() uint64 {
+	return s.lastScrubbed.Load()
+}
+
+func (s *Scrubber) run(ctx context.Context) {
+	defer close(s.doneCh)
+
+	for {
+		err := s.scrubPass(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		delay := s.params.CycleInterval
+		if err != nil {
+			log.Errorw("scrubbing stored files", "err", err)
+			delay = s.params.Interval
+		} else {
+			log.Infow("finished scrubbing stored files", "head", s.lastScrubbed.Load())
+			s.lastScrubbed.Store(0)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// scrubPass verifies all the stored heights from the last scrubbed one up to the head.
+func (s *Scrubber) scrubPass(ctx context.Context) error {
+	tail, err := s.headers.Tail(ctx)
+	if err != nil {
+		return fmt.Errorf("getting tail: %w", err)
+	}
+	head, err := s.headers.Head(ctx)
+	if err != nil {
+		return fmt.Errorf("getting head: %w", err)
+	}
+
+	from := max(s.lastScrubbed.Load()+1, tail.Height())
+	ticker := time.NewTicker(s.params.Interval)
+	defer ticker.Stop()
+	for height := from; height <= head.Height(); height++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if err := s.scrubHeight(ctx, height); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Errorw("scrubbing height", "height", height, "err", err)
+		}
+		s.lastScrubbed.Store(height)
+
+		if height%100 == 0 {
+			if err := s.storeLastScrubbed(ctx); err != nil {
+				log.Warnw("storing last scrubbed height", "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// scrubHeight verifies the file stored for the given height and recovers it if it is corrupted.
+func (s *Scrubber) scrubHeight(ctx context.Context, height uint64) error {
+	has, err := s.store.HasByHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return nil
+	}
+
+	hdr, err := s.headers.GetByHeight(ctx, height)
+	if err != nil {
+		return fmt.Errorf("getting header: %w", err)
+	}
+	if hdr.DAH.Equals(share.EmptyEDSRoots()) {
+		return nil
+	}
+
+	withQ4, err := s.store.HasQ4ByHash(ctx, hdr.DAH.Hash())
+	if err != nil {
+		return err
+	}
+
+	err = s.store.verify(ctx, height, hdr.DAH)
+	if errors.Is(err, ErrNotFound) {
+		// removed in the meantime
+		return nil
+	}
+	corrupted := errors.Is(err, errCorruptedFile)
+	s.metrics.observeScrub(ctx, corrupted)
+	if !corrupted {
+		return err
+	}
+
+	log.Warnw("found corrupted file, moving to quarantine", "height", height, "err", err)
+	if err := s.store.quarantine(height, hdr.DAH.Hash()); err != nil {
+		return fmt.Errorf("quarantining corrupted file: %w", err)
+	}
+
+	err = s.recover(ctx, hdr, withQ4)
+	s.metrics.observeRecover(ctx, err != nil)
+	if err != nil {
+		// bring the files back, so the height is not left without data and is retried
+		// on the next pass
+		if restoreErr := s.store.unquarantine(height, hdr.DAH.Hash()); restoreErr != nil {
+			return errors.Join(
+				fmt.Errorf("recovering corrupted file: %w", err),
+				fmt.Errorf("restoring quarantined file: %w", restoreErr),
+			)
+		}
+		return fmt.Errorf("recovering corrupted file: %w", err)
+	}
+	log.Infow("recovered corrupted file", "height", height)
+	return nil
+}
+
+// recover re-fetches the square from the network and puts it back into the store.
+func (s *Scrubber) recover(ctx context.Context, hdr *header.ExtendedHeader, withQ4 bool) error {
+	square, err := s.getter.GetEDS(ctx, hdr)
+	if err != nil {
+		return fmt.Errorf("fetching EDS: %w", err)
+	}
+
+	if withQ4 {
+		return s.store.PutODSQ4(ctx, hdr.DAH, hdr.Height(), square)
+	}
+	return s.store.PutODS(ctx, hdr.DAH, hdr.Height(), square)
+}
+
+func (s *Scrubber) storeLastScrubbed(ctx context.Context) error {
+	bin := binary.LittleEndian.AppendUint64(nil, s.lastScrubbed.Load())
+	return s.ds.Put(ctx, lastScrubbedKey, bin)
+}
+
+// verify reads the file stored for the given height from disk and checks it against the given
+// roots. It recomputes the roots out of the stored shares and compares the Q4 if it exists.
+// Any mismatch or unreadable data is reported as errCorruptedFile.
+func (s *Store) verify(ctx context.Context, height uint64, roots *share.AxisRoots) error {
+	lock := s.stripLock.byHeight(height)
+	lock.RLock()
+	defer lock.RUnlock()
+
+	// read from disk bypassing the cache
+	acc, err := s.openAccessor(ctx, s.heightToPath(height, odsFileExt))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("%w: opening: %w", errCorruptedFile, err)
+	}
+	defer utils.CloseAndLog(log, "scrubbed accessor", acc)
+
+	storedRoots, err := acc.AxisRoots(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: reading axis roots: %w", errCorruptedFile, err)
+	}
+	if !storedRoots.Equals(roots) {
+		return fmt.Errorf("%w: stored axis roots mismatch", errCorruptedFile)
+	}
+
+	shares, err := acc.Shares(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: reading shares: %w", errCorruptedFile, err)
+	}
+	square, err := eds.Rsmt2DFromShares(shares, len(roots.RowRoots)/2)
+	if err != nil {
+		return fmt.Errorf("%w: extending shares: %w", errCorruptedFile, err)
+	}
+	computedRoots, err := share.NewAxisRoots(square.ExtendedDataSquare)
+	if err != nil {
+		return fmt.Errorf("%w: computing axis roots: %w", errCorruptedFile, err)
+	}
+	if !computedRoots.Equals(roots) {
+		return fmt.Errorf("%w: computed axis roots mismatch", errCorruptedFile)
+	}
+
+	// the upper half of the rows is read from Q4 if it exists
+	size := len(roots.RowRoots)
+	for rowIdx := size / 2; rowIdx < size; rowIdx++ {
+		half, err := acc.AxisHalf(ctx, rsmt2d.Row, rowIdx)
+		if err != nil {
+			return fmt.Errorf("%w: reading row %d: %w", errCorruptedFile, rowIdx, err)
+		}
+		row, err := half.Extended()
+		if err != nil {
+			return fmt.Errorf("%w: extending row %d: %w", errCorruptedFile, rowIdx, err)
+		}
+		for colIdx, shr := range row {
+			if string(shr.ToBytes()) != string(square.GetCell(uint(rowIdx), uint(colIdx))) {
+				return fmt.Errorf("%w: share mismatch at (%d, %d)", errCorruptedFile, rowIdx, colIdx)
+			}
+		}
+	}
+	return nil
+}
+
+// quarantine moves the files of the given height out of the store, so they are neither served
+// nor removed, but are kept for inspection.
+func (s *Store) quarantine(height uint64, datahash share.DataHash) error {
+	lock := s.stripLock.byHashAndHeight(datahash, height)
+	lock.lock()
+	defer lock.unlock()
+
+	dir := filepath.Join(s.basepath, quarantinePath)
+	if err := s.backend.Mkdir(dir); err != nil {
+		return err
+	}
+	if err := s.cache.Remove(height); err != nil {
+		return fmt.Errorf("removing from cache: %w", err)
+	}
+	if err := s.backend.Remove(s.heightToPath(height, odsFileExt)); err != nil {
+		return fmt.Errorf("removing hardlink: %w", err)
+	}
+
+	prefix := datahash.String() + "." + strconv.FormatUint(height, 10)
+	for _, ext := range []string{odsFileExt, q4FileExt, nsIndexFileExt} {
+		err := s.backend.Rename(s.hashToPath(datahash, ext), filepath.Join(dir, prefix+ext))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("moving %s file to quarantine: %w", ext, err)
+		}
+	}
+	log.Infow("moved files to quarantine", "height", height, "hash", datahash.String(), "dir", dir)
+	return nil
+}
+
+// unquarantine moves the quarantined files of the given height back into the store. It is used
+// when the corrupted files couldn't be recovered, so the height keeps its data until they are.
+func (s *Store) unquarantine(height uint64, datahash share.DataHash) error {
+	lock := s.stripLock.byHashAndHeight(datahash, height)
+	lock.lock()
+	defer lock.unlock()
+
+	dir := filepath.Join(s.basepath, quarantinePath)
+	prefix := datahash.String() + "." + strconv.FormatUint(height, 10)
+	for _, ext := range []string{odsFileExt, q4FileExt, nsIndexFileExt} {
+		err := s.backend.Rename(filepath.Join(dir, prefix+ext), s.hashToPath(datahash, ext))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("moving %s file from quarantine: %w", ext, err)
+		}
+	}
+	if err := s.linkHeight(datahash, height); err != nil {
+		return fmt.Errorf("restoring hardlink: %w", err)
+	}
+	log.Infow("moved files back from quarantine", "height", height, "hash", datahash.String())
+	return nil
+}
@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share/shwap"
+)
+
+func TestScrubber(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	edsStore, err := NewStore(paramsNoCache(), t.TempDir())
+	require.NoError(t, err)
+
+	headers := make(map[uint64]*header.ExtendedHeader)
+	squares := make(map[uint64]*rsmt2d.ExtendedDataSquare)
+	for height := uint64(1); height <= 3; height++ {
+		square, roots := randomEDS(t)
+		hdr := headertest.ExtendedHeaderFromEDS(t, height, square)
+		headers[height] = hdr
+		squares[height] = square
+		err = edsStore.PutODSQ4(ctx, roots, height, square)
+		require.NoError(t, err)
+	}
+
+	scrubber := NewScrubber(
+		DefaultScrubberParams(),
+		edsStore,
+		headerStoreStub{headers: headers},
+		edsGetterStub{squares: squares},
+		ds_sync.MutexWrap(ds.NewMapDatastore()),
+	)
+
+	t.Run("valid files", func(t *testing.T) {
+		for height := uint64(1); height <= 3; height++ {
+			err := edsStore.verify(ctx, height, headers[height].DAH)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("missing height", func(t *testing.T) {
+		err := scrubber.scrubHeight(ctx, 10)
+		require.NoError(t, err)
+	})
+
+	t.Run("corrupted ODS", func(t *testing.T) {
+		hdr := headers[2]
+		flipLastByte(t, edsStore.hashToPath(hdr.DAH.Hash(), odsFileExt))
+		err := edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.ErrorIs(t, err, errCorruptedFile)
+
+		err = scrubber.scrubHeight(ctx, hdr.Height())
+		require.NoError(t, err)
+
+		// files are moved to the quarantine
+		entries, err := os.ReadDir(filepath.Join(edsStore.basepath, quarantinePath))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		// and recovered from the getter
+		err = edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.NoError(t, err)
+		hasQ4, err := edsStore.HasQ4ByHash(ctx, hdr.DAH.Hash())
+		require.NoError(t, err)
+		require.True(t, hasQ4)
+	})
+
+	t.Run("corrupted Q4", func(t *testing.T) {
+		hdr := headers[3]
+		flipLastByte(t, edsStore.hashToPath(hdr.DAH.Hash(), q4FileExt))
+		err := edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.ErrorIs(t, err, errCorruptedFile)
+
+		err = scrubber.scrubHeight(ctx, hdr.Height())
+		require.NoError(t, err)
+		err = edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.NoError(t, err)
+	})
+
+	t.Run("failed recovery", func(t *testing.T) {
+		hdr := headers[1]
+		square := squares[hdr.Height()]
+		delete(squares, hdr.Height())
+		flipLastByte(t, edsStore.hashToPath(hdr.DAH.Hash(), odsFileExt))
+
+		err := scrubber.scrubHeight(ctx, hdr.Height())
+		require.Error(t, err)
+
+		// the corrupted file is kept in the store until it is recovered
+		has, err := edsStore.HasByHeight(ctx, hdr.Height())
+		require.NoError(t, err)
+		require.True(t, has)
+		err = edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.ErrorIs(t, err, errCorruptedFile)
+
+		// and recovered on the next attempt
+		squares[hdr.Height()] = square
+		err = scrubber.scrubHeight(ctx, hdr.Height())
+		require.NoError(t, err)
+		err = edsStore.verify(ctx, hdr.Height(), hdr.DAH)
+		require.NoError(t, err)
+	})
+}
+
+// flipLastByte flips the last byte of the file.
+func flipLastByte(t *testing.T, path string) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	require.NoError(t, err)
+	defer f.Close()
+
+	info, err := f.Stat()
+	require.NoError(t, err)
+	b := make([]byte, 1)
+	_, err = f.ReadAt(b, info.Size()-1)
+	require.NoError(t, err)
+	b[0] ^= 0xFF
+	_, err = f.WriteAt(b, info.Size()-1)
+	require.NoError(t, err)
+}
+
+// headerStoreStub implements only the methods of the header store used by the Scrubber.
+type headerStoreStub struct {
+	libhead.Store[*header.ExtendedHeader]
+	headers map[uint64]*header.ExtendedHeader
+}
+
+func (h headerStoreStub) GetByHeight(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+	hdr, ok := h.headers[height]
+	if !ok {
+		return nil, libhead.ErrNotFound
+	}
+	return hdr, nil
+}
+
+// edsGetterStub implements only the methods of the getter used by the Scrubber.
+type edsGetterStub struct {
+	shwap.Getter
+	squares map[uint64]*rsmt2d.ExtendedDataSquare
+}
+
+func (g edsGetterStub) GetEDS(_ context.Context, hdr *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
+	square, ok := g.squares[hdr.Height()]
+	if !ok {
+		return nil, shwap.ErrNotFound
+	}
+	return square, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	logging "github.com/ipfs/go-log/v2"
 
+	libshare "github.com/celestiaorg/go-square/v4/share"
 	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/libs/utils"
@@ -27,6 +28,7 @@ const (
 	heightsPath    = blocksPath + "/heights"
 	odsFileExt     = ".ods"
 	q4FileExt      = ".q4"
+	nsIndexFileExt = ".nsi"
 	defaultDirPerm = 0o755
 )
 
@@ -53,10 +55,35 @@ type Store struct {
 	// stripedLocks is used to synchronize parallel operations
 	stripLock *striplock
 	metrics   *metrics
+	// namespaceIndex enables writing of the sidecar namespace index for the stored files
+	namespaceIndex bool
+	// backend keeps the files of the store
+	backend Backend
+	// cacheReads makes the accessors opened for reads to be kept in the cache.
+	// It is enabled for remote backends, where opening a file takes network round trips.
+	cacheReads bool
+	// fileOpts are applied to every written or opened file
+	fileOpts []file.Option
 }
 
 // NewStore creates a new EDS Store under the given basepath and datastore.
+// The files are kept on the local filesystem, unless the remote backend is configured in the
+// parameters.
 func NewStore(params *Parameters, basePath string) (*Store, error) {
+	if params.S3 != nil {
+		backend, err := NewS3Backend(params.S3)
+		if err != nil {
+			return nil, fmt.Errorf("creating S3 backend: %w", err)
+		}
+		// paths of the remote files are relative to the configured prefix
+		return NewStoreWithBackend(params, "", backend)
+	}
+	return NewStoreWithBackend(params, basePath, LocalBackend{})
+}
+
+// NewStoreWithBackend creates a new EDS Store keeping its files under the given basepath of the
+// given Backend.
+func NewStoreWithBackend(params *Parameters, basePath string, backend Backend) (*Store, error) {
 	err := params.Validate()
 	if err != nil {
 		return nil, err
@@ -64,13 +91,13 @@ func NewStore(params *Parameters, basePath string) (*Store, error) {
 
 	// ensure the blocks dir exists
 	blocksDir := filepath.Join(basePath, blocksPath)
-	if err := mkdir(blocksDir); err != nil {
+	if err := backend.Mkdir(blocksDir); err != nil {
 		return nil, fmt.Errorf("ensuring blocks directory: %w", err)
 	}
 
 	// ensure the heights dir exists
 	heightsDir := filepath.Join(basePath, heightsPath)
-	if err := mkdir(heightsDir); err != nil {
+	if err := backend.Mkdir(heightsDir); err != nil {
 		return nil, fmt.Errorf("ensuring heights directory: %w", err)
 	}
 
@@ -83,9 +110,18 @@ func NewStore(params *Parameters, basePath string) (*Store, error) {
 	}
 
 	store := &Store{
-		basepath:  basePath,
-		cache:     recentCache,
-		stripLock: newStripLock(1024),
+		basepath:       basePath,
+		cache:          recentCache,
+		stripLock:      newStripLock(1024),
+		namespaceIndex: params.NamespaceIndex,
+		backend:        backend,
+		fileOpts:       []file.Option{file.WithFS(backend)},
+	}
+	if _, local := backend.(LocalBackend); !local {
+		store.cacheReads = true
+	}
+	if params.CompressFiles {
+		store.fileOpts = append(store.fileOpts, file.WithCompression())
 	}
 
 	if err := store.populateEmptyFile(); err != nil {
@@ -169,9 +205,28 @@ func (s *Store) put(
 	}
 
 	s.metrics.observePut(ctx, time.Since(tNow), square.Width(), writeQ4, false)
+	s.createNamespaceIndex(square, datahash)
 	return nil
 }
 
+// createNamespaceIndex writes the sidecar namespace index next to the ODS file if enabled.
+// The index is optional, so failures are logged and reads fall back to scanning rows.
+func (s *Store) createNamespaceIndex(square *rsmt2d.ExtendedDataSquare, datahash share.DataHash) {
+	if !s.namespaceIndex {
+		return
+	}
+
+	path := s.hashToPath(datahash, nsIndexFileExt)
+	err := file.CreateNamespaceIndex(path, square, s.fileOpts...)
+	if err == nil || errors.Is(err, os.ErrExist) {
+		return
+	}
+	log.Warnw("failed to create namespace index", "hash", datahash.String(), "err", err)
+	if err := s.backend.Remove(path); err != nil {
+		log.Warnw("failed to remove partial namespace index", "hash", datahash.String(), "err", err)
+	}
+}
+
 func (s *Store) createODSQ4File(
 	square *rsmt2d.ExtendedDataSquare,
 	roots *share.AxisRoots,
@@ -180,7 +235,7 @@ func (s *Store) createODSQ4File(
 	pathODS := s.hashToPath(roots.Hash(), odsFileExt)
 	pathQ4 := s.hashToPath(roots.Hash(), q4FileExt)
 
-	err := file.CreateODSQ4(pathODS, pathQ4, roots, square)
+	err := file.CreateODSQ4(pathODS, pathQ4, roots, square, s.fileOpts...)
 	if err != nil && !errors.Is(err, os.ErrExist) {
 		// ensure we don't have partial writes if any operation fails
 		removeErr := s.removeODSQ4(height, roots.Hash())
@@ -222,7 +277,7 @@ func (s *Store) validateAndRecoverODSQ4(
 	pathODS, pathQ4 string,
 ) error {
 	// Validate the size of the file to ensure it's not corrupted
-	err := file.ValidateODSQ4Size(pathODS, pathQ4, square)
+	err := file.ValidateODSQ4Size(pathODS, pathQ4, square, s.fileOpts...)
 	if err == nil {
 		return nil
 	}
@@ -231,7 +286,7 @@ func (s *Store) validateAndRecoverODSQ4(
 	if err != nil {
 		return fmt.Errorf("removing corrupted ODSQ4 file: %w", err)
 	}
-	err = file.CreateODSQ4(pathODS, pathQ4, roots, square)
+	err = file.CreateODSQ4(pathODS, pathQ4, roots, square, s.fileOpts...)
 	if err != nil {
 		return fmt.Errorf("recreating ODSQ4 file: %w", err)
 	}
@@ -244,7 +299,7 @@ func (s *Store) createODSFile(
 	height uint64,
 ) (bool, error) {
 	pathODS := s.hashToPath(roots.Hash(), odsFileExt)
-	err := file.CreateODS(pathODS, roots, square)
+	err := file.CreateODS(pathODS, roots, square, s.fileOpts...)
 	if err != nil && !errors.Is(err, os.ErrExist) {
 		// ensure we don't have partial writes if any operation fails
 		removeErr := s.removeODS(height, roots.Hash())
@@ -287,7 +342,7 @@ func (s *Store) validateAndRecoverODS(
 	pathODS string,
 ) error {
 	// Validate the size of the file to ensure it's not corrupted
-	err := file.ValidateODSSize(pathODS, square)
+	err := file.ValidateODSSize(pathODS, square, s.fileOpts...)
 	if err == nil {
 		return nil
 	}
@@ -296,7 +351,7 @@ func (s *Store) validateAndRecoverODS(
 	if err != nil {
 		return fmt.Errorf("removing corrupted ODS file: %w", err)
 	}
-	err = file.CreateODS(pathODS, roots, square)
+	err = file.CreateODS(pathODS, roots, square, s.fileOpts...)
 	if err != nil {
 		return fmt.Errorf("recreating ODS file: %w", err)
 	}
@@ -305,15 +360,15 @@ func (s *Store) validateAndRecoverODS(
 
 func (s *Store) linkHeight(datahash share.DataHash, height uint64) error {
 	linktoOds := s.heightToPath(height, odsFileExt)
-	if datahash.IsEmptyEDS() {
+	if sl, ok := s.backend.(symlinker); ok && datahash.IsEmptyEDS() {
 		// empty EDS is always symlinked, because there is limited number of hardlinks
 		// for the same file in some filesystems (ext4)
 		pathOds := s.hashToRelativePath(datahash, odsFileExt)
-		return symlink(pathOds, linktoOds)
+		return sl.Symlink(pathOds, linktoOds)
 	}
 	// create hard link with height as name
 	pathOds := s.hashToPath(datahash, odsFileExt)
-	return hardLink(pathOds, linktoOds)
+	return s.backend.Link(pathOds, linktoOds)
 }
 
 // populateEmptyFile writes fresh empty EDS file on disk.
@@ -323,12 +378,18 @@ func (s *Store) populateEmptyFile() error {
 	pathOds := s.hashToPath(share.EmptyEDSDataHash(), odsFileExt)
 	pathQ4 := s.hashToPath(share.EmptyEDSDataHash(), q4FileExt)
 
-	err := errors.Join(remove(pathOds), remove(pathQ4))
+	err := errors.Join(s.backend.Remove(pathOds), s.backend.Remove(pathQ4))
 	if err != nil {
 		return fmt.Errorf("cleaning old empty EDS file: %w", err)
 	}
 
-	err = file.CreateODSQ4(pathOds, pathQ4, share.EmptyEDSRoots(), eds.EmptyAccessor.ExtendedDataSquare)
+	err = file.CreateODSQ4(
+		pathOds,
+		pathQ4,
+		share.EmptyEDSRoots(),
+		eds.EmptyAccessor.ExtendedDataSquare,
+		file.WithFS(s.backend),
+	)
 	if err != nil {
 		return fmt.Errorf("creating fresh empty EDS file: %w", err)
 	}
@@ -373,6 +434,11 @@ func (s *Store) getByHeight(ctx context.Context, height uint64) (eds.AccessorStr
 	}
 
 	path := s.heightToPath(height, odsFileExt)
+	if s.cacheReads {
+		return s.cache.GetOrLoad(ctx, height, func(ctx context.Context) (eds.AccessorStreamer, error) {
+			return s.openAccessor(ctx, path)
+		})
+	}
 	return s.openAccessor(ctx, path)
 }
 
@@ -380,7 +446,7 @@ func (s *Store) getByHeight(ctx context.Context, height uint64) (eds.AccessorStr
 // It opens ODS file first, reads up its DataHash and constructs the path for Q4
 // This done as Q4 is not indexed(hard-linked) and there is no other way to Q4 by height only.
 func (s *Store) openAccessor(ctx context.Context, path string) (eds.AccessorStreamer, error) {
-	ods, err := file.OpenODS(path)
+	ods, err := file.OpenODS(path, s.fileOpts...)
 	if errors.Is(err, os.ErrNotExist) {
 		return nil, ErrNotFound
 	}
@@ -395,10 +461,41 @@ func (s *Store) openAccessor(ctx context.Context, path string) (eds.AccessorStre
 		return nil, fmt.Errorf("reading datahash: %w", err)
 	}
 	pathQ4 := s.hashToPath(datahash, q4FileExt)
+	ods.UseNamespaceIndex(s.hashToPath(datahash, nsIndexFileExt))
 	odsQ4 := file.ODSWithQ4(ods, pathQ4)
 	return wrapAccessor(odsQ4), nil
 }
 
+// NamespaceShares returns the shares of the namespace at the given height along with the index
+// of the first share in the ODS. The shares are read directly from the ODS file using its namespace
+// index, without building inclusion proofs.
+// file.ErrNoNamespaceIndex is returned if the index was not written for the height.
+func (s *Store) NamespaceShares(
+	ctx context.Context,
+	height uint64,
+	namespace libshare.Namespace,
+) (int, []libshare.Share, error) {
+	lock := s.stripLock.byHeight(height)
+	lock.RLock()
+	defer lock.RUnlock()
+
+	ods, err := file.OpenODS(s.heightToPath(height, odsFileExt), s.fileOpts...)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil, ErrNotFound
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to open ODS: %w", err)
+	}
+	defer utils.CloseAndLog(log, "namespace shares ods", ods)
+
+	datahash, err := ods.DataHash(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("reading datahash: %w", err)
+	}
+	ods.UseNamespaceIndex(s.hashToPath(datahash, nsIndexFileExt))
+	return ods.NamespaceShares(ctx, namespace)
+}
+
 func (s *Store) HasByHash(ctx context.Context, datahash share.DataHash) (bool, error) {
 	if datahash.IsEmptyEDS() {
 		return true, nil
@@ -417,7 +514,7 @@ func (s *Store) HasByHash(ctx context.Context, datahash share.DataHash) (bool, e
 func (s *Store) hasByHash(datahash share.DataHash) (bool, error) {
 	// For now, we assume that if ODS exists, the Q4 exists as well.
 	path := s.hashToPath(datahash, odsFileExt)
-	return exists(path)
+	return exists(s.backend, path)
 }
 
 func (s *Store) HasByHeight(ctx context.Context, height uint64) (bool, error) {
@@ -438,7 +535,7 @@ func (s *Store) hasByHeight(height uint64) (bool, error) {
 
 	// For now, we assume that if ODS exists, the Q4 exists as well.
 	pathODS := s.heightToPath(height, odsFileExt)
-	return exists(pathODS)
+	return exists(s.backend, pathODS)
 }
 
 func (s *Store) HasQ4ByHash(_ context.Context, datahash share.DataHash) (bool, error) {
@@ -447,7 +544,7 @@ func (s *Store) HasQ4ByHash(_ context.Context, datahash share.DataHash) (bool, e
 	defer lock.RUnlock()
 
 	pathQ4File := s.hashToPath(datahash, q4FileExt)
-	return exists(pathQ4File)
+	return exists(s.backend, pathQ4File)
 }
 
 func (s *Store) RemoveODSQ4(ctx context.Context, height uint64, datahash share.DataHash) error {
@@ -459,11 +556,7 @@ func (s *Store) RemoveODSQ4(ctx context.Context, height uint64, datahash share.D
 	// what actually left the filesystem. The hardlink in heights/ shares an
 	// inode with the ODS file in blocks/, so we size only the canonical
 	// blocks/ paths to avoid double-counting.
-	var bytes int64
-	if !datahash.IsEmptyEDS() {
-		bytes = fileSize(s.hashToPath(datahash, odsFileExt)) +
-			fileSize(s.hashToPath(datahash, q4FileExt))
-	}
+	bytes := s.sizeODSQ4(datahash)
 
 	tNow := time.Now()
 	err := s.removeODSQ4(height, datahash)
@@ -487,7 +580,7 @@ func (s *Store) removeODS(height uint64, datahash share.DataHash) error {
 	}
 
 	pathLink := s.heightToPath(height, odsFileExt)
-	if err := remove(pathLink); err != nil {
+	if err := s.backend.Remove(pathLink); err != nil {
 		return fmt.Errorf("removing hardlink: %w", err)
 	}
 
@@ -497,9 +590,14 @@ func (s *Store) removeODS(height uint64, datahash share.DataHash) error {
 	}
 
 	pathODS := s.hashToPath(datahash, odsFileExt)
-	if err := remove(pathODS); err != nil {
+	if err := s.backend.Remove(pathODS); err != nil {
 		return fmt.Errorf("removing ODS file: %w", err)
 	}
+
+	pathIndex := s.hashToPath(datahash, nsIndexFileExt)
+	if err := s.backend.Remove(pathIndex); err != nil {
+		return fmt.Errorf("removing namespace index file: %w", err)
+	}
 	return nil
 }
 
@@ -508,10 +606,7 @@ func (s *Store) RemoveQ4(ctx context.Context, height uint64, datahash share.Data
 	lock.lock()
 	defer lock.unlock()
 
-	var bytes int64
-	if !datahash.IsEmptyEDS() {
-		bytes = fileSize(s.hashToPath(datahash, q4FileExt))
-	}
+	bytes := s.sizeQ4(datahash)
 
 	tNow := time.Now()
 	err := s.removeQ4(height, datahash)
@@ -531,12 +626,46 @@ func (s *Store) removeQ4(height uint64, datahash share.DataHash) error {
 
 	// remove Q4 file
 	pathQ4File := s.hashToPath(datahash, q4FileExt)
-	if err := remove(pathQ4File); err != nil {
+	if err := s.backend.Remove(pathQ4File); err != nil {
 		return fmt.Errorf("removing Q4 file: %w", err)
 	}
 	return nil
 }
 
+// SizeODSQ4 returns the amount of bytes the files of the EDS with the given hash take on the
+// Backend, i.e. the amount of bytes RemoveODSQ4 frees.
+func (s *Store) SizeODSQ4(_ context.Context, datahash share.DataHash) int64 {
+	lock := s.stripLock.byHash(datahash)
+	lock.RLock()
+	defer lock.RUnlock()
+	return s.sizeODSQ4(datahash)
+}
+
+func (s *Store) sizeODSQ4(datahash share.DataHash) int64 {
+	if datahash.IsEmptyEDS() {
+		return 0
+	}
+	return fileSize(s.backend, s.hashToPath(datahash, odsFileExt)) +
+		fileSize(s.backend, s.hashToPath(datahash, q4FileExt)) +
+		fileSize(s.backend, s.hashToPath(datahash, nsIndexFileExt))
+}
+
+// SizeQ4 returns the amount of bytes the Q4 file of the EDS with the given hash takes on the
+// Backend, i.e. the amount of bytes RemoveQ4 frees.
+func (s *Store) SizeQ4(_ context.Context, datahash share.DataHash) int64 {
+	lock := s.stripLock.byHash(datahash)
+	lock.RLock()
+	defer lock.RUnlock()
+	return s.sizeQ4(datahash)
+}
+
+func (s *Store) sizeQ4(datahash share.DataHash) int64 {
+	if datahash.IsEmptyEDS() {
+		return 0
+	}
+	return fileSize(s.backend, s.hashToPath(datahash, q4FileExt))
+}
+
 func (s *Store) hashToPath(datahash share.DataHash, ext string) string {
 	return filepath.Join(s.basepath, blocksPath, datahash.String()) + ext
 }
@@ -588,18 +717,6 @@ func symlink(filepath, linkpath string) error {
 	return nil
 }
 
-func exists(path string) (bool, error) {
-	_, err := os.Stat(path)
-	switch {
-	case err == nil:
-		return true, nil
-	case errors.Is(err, os.ErrNotExist):
-		return false, nil
-	default:
-		return false, fmt.Errorf("checking file existence '%s': %w", path, err)
-	}
-}
-
 func remove(path string) error {
 	err := os.Remove(path)
 	if err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -607,13 +724,3 @@ func remove(path string) error {
 	}
 	return nil
 }
-
-// fileSize returns the on-disk size of a file, or 0 if it's missing/unstatable.
-// Used purely for the bytes-reclaimed metric — best-effort, not load-bearing.
-func fileSize(path string) int64 {
-	info, err := os.Stat(path)
-	if err != nil {
-		return 0
-	}
-	return info.Size()
-}
@@ -2,11 +2,21 @@ package store
 
 import (
 	"errors"
+	"fmt"
 )
 
 type Parameters struct {
 	// RecentBlocksCacheSize is the size of the cache for recent blocks.
 	RecentBlocksCacheSize int
+	// NamespaceIndex enables writing of the sidecar namespace index next to each stored ODS file.
+	// The index allows reading the shares of a namespace without scanning whole rows.
+	NamespaceIndex bool
+	// CompressFiles enables writing of the ODS files in the compressed format.
+	// Files written before in the uncompressed format stay readable.
+	CompressFiles bool
+	// S3 configures the store to keep the files in an S3-compatible object storage instead of
+	// the local filesystem.
+	S3 *S3Parameters `toml:",omitempty"`
 }
 
 // DefaultParameters returns the default configuration values for the EDS store parameters.
@@ -20,5 +30,10 @@ func (p *Parameters) Validate() error {
 	if p.RecentBlocksCacheSize < 0 {
 		return errors.New("recent eds cache size cannot be negative")
 	}
+	if p.S3 != nil {
+		if err := p.S3.Validate(); err != nil {
+			return fmt.Errorf("s3 backend: %w", err)
+		}
+	}
 	return nil
 }
@@ -427,6 +427,39 @@ func TestEDSStore(t *testing.T) {
 			require.NoError(t, err)
 		})
 	})
+
+	t.Run("namespace index", func(t *testing.T) {
+		dir := t.TempDir()
+		params := paramsNoCache()
+		params.NamespaceIndex = true
+		edsStore, err := NewStore(params, dir)
+		require.NoError(t, err)
+
+		ns := libshare.RandomNamespace()
+		eds, roots := edstest.RandEDSWithNamespace(t, ns, 5, 4)
+		height := height.Add(1)
+		err = edsStore.PutODS(ctx, roots, height, eds)
+		require.NoError(t, err)
+
+		pathIndex := edsStore.hashToPath(roots.Hash(), nsIndexFileExt)
+		has, err := exists(edsStore.backend, pathIndex)
+		require.NoError(t, err)
+		require.True(t, has)
+
+		from, shares, err := edsStore.NamespaceShares(ctx, height, ns)
+		require.NoError(t, err)
+		require.Len(t, shares, 5)
+		require.Equal(t, eds.FlattenedODS()[from:from+5], libshare.ToBytes(shares))
+
+		_, _, err = edsStore.NamespaceShares(ctx, height+1, ns)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		err = edsStore.RemoveODSQ4(ctx, height, roots.Hash())
+		require.NoError(t, err)
+		has, err = exists(edsStore.backend, pathIndex)
+		require.NoError(t, err)
+		require.False(t, has)
+	})
 }
 
 func corruptFile(path string) error {